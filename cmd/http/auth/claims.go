@@ -1,6 +1,40 @@
 package auth
 
+import "strings"
+
+// ScopeAdmin grants access to the resources of every customer.
+const ScopeAdmin = "admin"
+
+// TokenClaims are the claims of the bearer tokens. Subject is the ID of the customer the
+// token was issued to, Scope is a space separated list of scopes.
 type TokenClaims struct {
+	Subject   string
 	Scope     string
 	RequestID string
 }
+
+func (c TokenClaims) HasScope(scope string) bool {
+	for _, granted := range strings.Fields(c.Scope) {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (c TokenClaims) IsAdmin() bool {
+	return c.HasScope(ScopeAdmin)
+}
+
+// CanAccessCustomer reports whether the token can read and write the resources of the customer.
+func (c TokenClaims) CanAccessCustomer(customerID string) bool {
+	return c.IsAdmin() || (c.Subject != "" && c.Subject == customerID)
+}
+
+// CustomerScope is the customer the queries of the token are restricted to, empty for admins.
+func (c TokenClaims) CustomerScope() string {
+	if c.IsAdmin() {
+		return ""
+	}
+	return c.Subject
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+	"strings"
+)
+
+type TokenVerifier interface {
+	Verify(token string) (TokenClaims, error)
+}
+
+type claimsKey struct{}
+
+func WithClaims(ctx context.Context, claims TokenClaims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims of the token the request was authenticated with.
+func ClaimsFromContext(ctx context.Context) (TokenClaims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(TokenClaims)
+	return claims, ok
+}
+
+// RequireToken answers 401 unless the request carries a valid bearer token with a subject,
+// the claims are available to the handler through ClaimsFromContext.
+func RequireToken(verifier TokenVerifier, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found || token == "" {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			problem.WriteStatus(w, r, http.StatusUnauthorized, "Header Authorization is required")
+			return
+		}
+
+		claims, err := verifier.Verify(token)
+		if err != nil || claims.Subject == "" {
+			w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+			problem.WriteStatus(w, r, http.StatusUnauthorized, "invalid token")
+			return
+		}
+
+		h(w, r.WithContext(WithClaims(r.Context(), claims)))
+	}
+}
+
+// RequestClaims returns the claims of the request and answers 401 when the handler was
+// not wrapped by RequireToken.
+func RequestClaims(w http.ResponseWriter, r *http.Request) (TokenClaims, bool) {
+	claims, ok := ClaimsFromContext(r.Context())
+	if !ok {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		problem.WriteStatus(w, r, http.StatusUnauthorized, "Header Authorization is required")
+		return TokenClaims{}, false
+	}
+	return claims, true
+}
@@ -0,0 +1,100 @@
+package auth_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/auth"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeVerifier map[string]auth.TokenClaims
+
+func (f fakeVerifier) Verify(token string) (auth.TokenClaims, error) {
+	claims, ok := f[token]
+	if !ok {
+		return auth.TokenClaims{}, errors.New("invalid token")
+	}
+	return claims, nil
+}
+
+func TestRequireToken(t *testing.T) {
+	customerID := "5f3c2b1a-0d9e-4c8b-a7f6-e5d4c3b2a190"
+	verifier := fakeVerifier{
+		"customer":   {Subject: customerID, Scope: "orders:read orders:write"},
+		"no-subject": {Scope: "admin"},
+	}
+
+	testCases := []struct {
+		name           string
+		authorization  string
+		expectedStatus int
+		expectedClaims *auth.TokenClaims
+	}{
+		{
+			name:           "Success - claims reach the handler",
+			authorization:  "Bearer customer",
+			expectedStatus: http.StatusOK,
+			expectedClaims: &auth.TokenClaims{Subject: customerID, Scope: "orders:read orders:write"},
+		},
+		{
+			name:           "Failure - 401 missing header",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Failure - 401 not a bearer token",
+			authorization:  "Basic dXNlcjpwYXNz",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Failure - 401 invalid token",
+			authorization:  "Bearer forged",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Failure - 401 token without subject",
+			authorization:  "Bearer no-subject",
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var received *auth.TokenClaims
+			handler := auth.RequireToken(verifier, func(w http.ResponseWriter, r *http.Request) {
+				claims, ok := auth.ClaimsFromContext(r.Context())
+				assert.True(t, ok)
+				received = &claims
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/api/orders", nil)
+			if tc.authorization != "" {
+				request.Header.Set("Authorization", tc.authorization)
+			}
+			recorder := httptest.NewRecorder()
+
+			// Act
+			handler(recorder, request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Equal(t, tc.expectedClaims, received)
+		})
+	}
+}
+
+func TestTokenClaims_CanAccessCustomer(t *testing.T) {
+	customer := auth.TokenClaims{Subject: "5f3c2b1a-0d9e-4c8b-a7f6-e5d4c3b2a190", Scope: "orders:read"}
+	admin := auth.TokenClaims{Subject: "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", Scope: "orders:read admin"}
+
+	assert.True(t, customer.CanAccessCustomer(customer.Subject))
+	assert.False(t, customer.CanAccessCustomer(admin.Subject))
+	assert.True(t, admin.CanAccessCustomer(customer.Subject))
+	assert.Equal(t, customer.Subject, customer.CustomerScope())
+	assert.Empty(t, admin.CustomerScope())
+	assert.False(t, auth.TokenClaims{Scope: "administrator"}.IsAdmin())
+}
@@ -1,51 +1,282 @@
 package config
 
-import "os"
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	// EnvironmentDev relaxes the validation for a local run, e.g. it accepts the default JWT secret.
+	EnvironmentDev        = "dev"
+	EnvironmentProduction = "production"
+
+	// DefaultJWTSecret is only accepted in dev mode, see Config.Validate.
+	DefaultJWTSecret = "secret"
+)
 
 type MySQL struct {
-	Host              string
-	Port              int
-	User              string
-	Password          string
-	DBName            string
-	MaxOpenConnection int
-	MaxIdleConnection int
+	Host              string        `yaml:"host" env:"MY_SQL_HOST"`
+	Port              int           `yaml:"port" env:"MY_SQL_PORT"`
+	User              string        `yaml:"user" env:"MY_SQL_USER"`
+	Password          string        `yaml:"password" env:"MY_SQL_PASSWORD" secret:"true"`
+	DBName            string        `yaml:"db_name" env:"MY_SQL_DB"`
+	MaxOpenConnection int           `yaml:"max_open_connections" env:"MY_SQL_MAX_OPEN_CONNECTIONS"`
+	MaxIdleConnection int           `yaml:"max_idle_connections" env:"MY_SQL_MAX_IDLE_CONNECTIONS"`
+	ConnMaxLifetime   time.Duration `yaml:"conn_max_lifetime" env:"MY_SQL_CONN_MAX_LIFETIME"`
 }
 
 type JWT struct {
-	Secret string
+	Secret string `yaml:"secret" env:"JWT_SECRET" secret:"true"`
+}
+
+type Inventory struct {
+	WebhookURL string `yaml:"webhook_url" env:"INVENTORY_WEBHOOK_URL"`
+}
+
+// Pricing selects the exchange rate provider, the rates are fetched from ExchangeRatesURL
+// when it is set and read from ExchangeRatesFile otherwise. A failed refresh of the fetched
+// rates is retried after ExchangeRatesRetry, the stale rates are used up to
+// ExchangeRatesMaxStale. SchedulerInterval is how often the scheduled prices are checked.
+type Pricing struct {
+	ExchangeRatesFile     string        `yaml:"exchange_rates_file" env:"EXCHANGE_RATES_FILE"`
+	ExchangeRatesURL      string        `yaml:"exchange_rates_url" env:"EXCHANGE_RATES_URL"`
+	ExchangeRatesTTL      time.Duration `yaml:"exchange_rates_ttl" env:"EXCHANGE_RATES_TTL"`
+	ExchangeRatesRetry    time.Duration `yaml:"exchange_rates_retry" env:"EXCHANGE_RATES_RETRY"`
+	ExchangeRatesMaxStale time.Duration `yaml:"exchange_rates_max_stale" env:"EXCHANGE_RATES_MAX_STALE"`
+	SchedulerInterval     time.Duration `yaml:"scheduler_interval" env:"PRICE_SCHEDULER_INTERVAL"`
+}
+
+// Tax points to the tax rules file, see tax.Rules for its format.
+type Tax struct {
+	RulesFile string `yaml:"rules_file" env:"TAX_RULES_FILE"`
+}
+
+// Cart sets how long a cart lives without changes and how often the expired carts are deleted.
+type Cart struct {
+	TTL            time.Duration `yaml:"ttl" env:"CART_TTL"`
+	ExpiryInterval time.Duration `yaml:"expiry_interval" env:"CART_EXPIRY_INTERVAL"`
+}
+
+// Payment selects the payment gateway, "fake" is the only one for now and FakeMode sets the
+// outcome of its authorisations: succeed, decline or timeout. The orders pending for longer
+// than PendingTimeout are compensated, and the refunds completed, by background jobs every
+// ReaperInterval.
+type Payment struct {
+	Gateway        string        `yaml:"gateway" env:"PAYMENT_GATEWAY"`
+	FakeMode       string        `yaml:"fake_mode" env:"PAYMENT_FAKE_MODE"`
+	PendingTimeout time.Duration `yaml:"pending_timeout" env:"PAYMENT_PENDING_TIMEOUT"`
+	ReaperInterval time.Duration `yaml:"reaper_interval" env:"PAYMENT_REAPER_INTERVAL"`
+}
+
+// Invoice points to the directory of the invoice templates, invoice.html.tmpl and
+// invoice.txt.tmpl replace the embedded templates when they are there.
+type Invoice struct {
+	TemplatesDir string `yaml:"templates_dir" env:"INVOICE_TEMPLATES_DIR"`
+}
+
+// Log sets the output of the service logs, Format is json or text and Level one of debug,
+// info, warn or error.
+type Log struct {
+	Format string `yaml:"format" env:"LOG_FORMAT"`
+	Level  string `yaml:"level" env:"LOG_LEVEL"`
+}
+
+// Tracing selects the exporter of the spans: otlp, stdout or none. Endpoint is the OTLP/HTTP
+// collector URL, the OTEL_EXPORTER_OTLP_* variables apply when it is empty.
+type Tracing struct {
+	Exporter    string `yaml:"exporter" env:"TRACING_EXPORTER"`
+	Endpoint    string `yaml:"endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT"`
+	ServiceName string `yaml:"service_name" env:"OTEL_SERVICE_NAME"`
+}
+
+// Health sets the timeout of each readiness check and how long its result is reused by the
+// next probes.
+type Health struct {
+	CheckTimeout time.Duration `yaml:"check_timeout" env:"HEALTH_CHECK_TIMEOUT"`
+	CacheTTL     time.Duration `yaml:"cache_ttl" env:"HEALTH_CACHE_TTL"`
 }
 
+// Server sets the limits of the HTTP server: the timeouts of each connection, the size of the
+// headers and of the bodies (MaxImportBytes for the product imports), and how long the requests
+// in flight and the background jobs get to complete once the service is asked to stop, the
+// server keeps serving for DrainDelay of it after the readiness probe fails. The
+// streamed imports and exports replace the read and write timeouts with ImportTimeout and
+// ExportTimeout.
+// TLSCertFile and TLSKeyFile serve HTTPS when both are set, HTTP2 enables HTTP/2 over TLS and
+// over cleartext (h2c) otherwise.
+type Server struct {
+	ReadTimeout       time.Duration `yaml:"read_timeout" env:"SERVER_READ_TIMEOUT"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout" env:"SERVER_READ_HEADER_TIMEOUT"`
+	WriteTimeout      time.Duration `yaml:"write_timeout" env:"SERVER_WRITE_TIMEOUT"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout" env:"SERVER_IDLE_TIMEOUT"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+	DrainDelay        time.Duration `yaml:"drain_delay" env:"SERVER_DRAIN_DELAY"`
+	MaxHeaderBytes    int           `yaml:"max_header_bytes" env:"SERVER_MAX_HEADER_BYTES"`
+	MaxBodyBytes      int64         `yaml:"max_body_bytes" env:"SERVER_MAX_BODY_BYTES"`
+	MaxImportBytes    int64         `yaml:"max_import_bytes" env:"SERVER_MAX_IMPORT_BYTES"`
+	ImportTimeout     time.Duration `yaml:"import_timeout" env:"SERVER_IMPORT_TIMEOUT"`
+	ExportTimeout     time.Duration `yaml:"export_timeout" env:"SERVER_EXPORT_TIMEOUT"`
+	TLSCertFile       string        `yaml:"tls_cert_file" env:"TLS_CERT_FILE"`
+	TLSKeyFile        string        `yaml:"tls_key_file" env:"TLS_KEY_FILE"`
+	HTTP2             bool          `yaml:"http2" env:"SERVER_HTTP2"`
+}
+
+// CORSPolicy sets the cross-origin requests a route group answers. An allowed origin is
+// matched exactly, e.g. https://shop.example.com, or by a wildcard on its subdomains, e.g.
+// https://*.example.com, "*" allows any origin. MaxAge is how long a browser caches the
+// answer of a preflight.
+type CORSPolicy struct {
+	AllowedOrigins   []string      `yaml:"allowed_origins" env:"ALLOWED_ORIGINS"`
+	AllowedMethods   []string      `yaml:"allowed_methods" env:"ALLOWED_METHODS"`
+	AllowedHeaders   []string      `yaml:"allowed_headers" env:"ALLOWED_HEADERS"`
+	ExposedHeaders   []string      `yaml:"exposed_headers" env:"EXPOSED_HEADERS"`
+	AllowCredentials bool          `yaml:"allow_credentials" env:"ALLOW_CREDENTIALS"`
+	MaxAge           time.Duration `yaml:"max_age" env:"MAX_AGE"`
+}
+
+// CORS holds a policy per route group: Catalog for the public catalog (products, categories
+// and inventory) and Account for the routes behind a token (orders, customers, carts and
+// promotions).
+type CORS struct {
+	Catalog CORSPolicy `yaml:"catalog" env:"CORS_CATALOG_"`
+	Account CORSPolicy `yaml:"account" env:"CORS_ACCOUNT_"`
+}
+
+// RateLimit sets the token buckets of the API routes, see limiter.Middleware. A client is the
+// subject of its token, its API key (one of APIKeys sent in X-API-Key) or its IP, read from
+// X-Forwarded-For only behind the TrustedProxies (IPs or CIDRs). Store is memory, each replica
+// enforcing the whole limit, or mysql, shared by the replicas through a pool of its own of
+// MaxConnections. Routes override Default.
+type RateLimit struct {
+	Enabled        bool       `yaml:"enabled" env:"RATE_LIMIT_ENABLED"`
+	Store          string     `yaml:"store" env:"RATE_LIMIT_STORE"`
+	MaxConnections int        `yaml:"max_connections" env:"RATE_LIMIT_MAX_CONNECTIONS"`
+	TrustedProxies []string   `yaml:"trusted_proxies" env:"RATE_LIMIT_TRUSTED_PROXIES"`
+	APIKeys        []string   `yaml:"api_keys" env:"RATE_LIMIT_API_KEYS" secret:"true"`
+	Default        Rate       `yaml:"default" env:"RATE_LIMIT_DEFAULT"`
+	Routes         RouteRates `yaml:"routes" env:"RATE_LIMIT_ROUTES"`
+}
+
+// Config is loaded in layers by Load, see the tags of the fields: yaml names the key in the
+// config file and the flag (server.read_timeout is -server.read-timeout), env the variable.
+// The secrets are redacted by Redacted and may be read from the file named by the variable
+// with a _FILE suffix, e.g. MY_SQL_PASSWORD_FILE.
 type Config struct {
-	Port   string
-	JWT    JWT
-	Domain string
+	Environment string `yaml:"environment" env:"APP_ENV"`
+	Port        string `yaml:"port" env:"PORT"`
+	JWT         JWT    `yaml:"jwt"`
+	Domain      string `yaml:"domain" env:"DOMAIN"`
 
-	MySQL MySQL
+	MySQL     MySQL     `yaml:"mysql"`
+	Inventory Inventory `yaml:"inventory"`
+	Pricing   Pricing   `yaml:"pricing"`
+	Tax       Tax       `yaml:"tax"`
+	Cart      Cart      `yaml:"cart"`
+	Payment   Payment   `yaml:"payment"`
+	Invoice   Invoice   `yaml:"invoice"`
+	Log       Log       `yaml:"log"`
+	Tracing   Tracing   `yaml:"tracing"`
+	Health    Health    `yaml:"health"`
+	Server    Server    `yaml:"server"`
+	CORS      CORS      `yaml:"cors"`
+	RateLimit RateLimit `yaml:"rate_limit"`
 }
 
-func LoadConfig() Config {
+// Default is the first layer of the config, the values a local run needs with the
+// docker-compose database.
+func Default() Config {
 	return Config{
-		Port:   getEnv("PORT", ":8000"),
-		Domain: getEnv("DOMAIN", "http://localhost:8000"),
+		Environment: EnvironmentProduction,
+		Port:        ":8000",
+		Domain:      "http://localhost:8000",
 		JWT: JWT{
-			Secret: getEnv("JWT_SECRET", "secret"),
+			Secret: DefaultJWTSecret,
 		},
 		MySQL: MySQL{
-			Host:              getEnv("MY_SQL_HOST", "localhost"),
+			Host:              "localhost",
 			Port:              3306,
-			User:              getEnv("MY_SQL_USER", "user"),
-			Password:          getEnv("MY_SQL_PASSWORD", "password"),
-			DBName:            getEnv("MY_SQL_DB", "products_catalog_db"),
+			User:              "user",
+			Password:          "password",
+			DBName:            "products_catalog_db",
 			MaxOpenConnection: 10,
 			MaxIdleConnection: 5,
+			ConnMaxLifetime:   5 * time.Minute,
+		},
+		Pricing: Pricing{
+			ExchangeRatesFile:     "config/exchange_rates.json",
+			ExchangeRatesTTL:      time.Hour,
+			ExchangeRatesRetry:    time.Minute,
+			ExchangeRatesMaxStale: 24 * time.Hour,
+			SchedulerInterval:     30 * time.Second,
+		},
+		Tax: Tax{
+			RulesFile: "config/tax_rules.json",
+		},
+		Cart: Cart{
+			TTL:            72 * time.Hour,
+			ExpiryInterval: 10 * time.Minute,
+		},
+		Payment: Payment{
+			Gateway:        "fake",
+			FakeMode:       "succeed",
+			PendingTimeout: 15 * time.Minute,
+			ReaperInterval: time.Minute,
+		},
+		Invoice: Invoice{
+			TemplatesDir: "config/invoices",
+		},
+		Log: Log{
+			Format: "json",
+			Level:  "info",
+		},
+		Tracing: Tracing{
+			Exporter:    "none",
+			ServiceName: "products-catalog",
+		},
+		Health: Health{
+			CheckTimeout: 2 * time.Second,
+			CacheTTL:     5 * time.Second,
+		},
+		Server: Server{
+			ReadTimeout:       15 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+			ShutdownTimeout:   30 * time.Second,
+			DrainDelay:        10 * time.Second,
+			MaxHeaderBytes:    1 << 20,
+			MaxBodyBytes:      1 << 20,
+			MaxImportBytes:    32 << 20,
+			ImportTimeout:     10 * time.Minute,
+			ExportTimeout:     10 * time.Minute,
+			HTTP2:             true,
+		},
+		CORS: CORS{
+			Catalog: defaultCORSPolicy(),
+			Account: defaultCORSPolicy(),
+		},
+		RateLimit: RateLimit{
+			Enabled:        true,
+			Store:          "memory",
+			MaxConnections: 4,
+			Default:        Rate{Requests: 300, Period: time.Minute},
+			// the writes holding a transaction are the ones able to starve the pool
+			Routes: RouteRates{
+				"POST /api/orders":          {Requests: 10, Period: time.Minute},
+				"POST /api/carts/":          {Requests: 60, Period: time.Minute, Burst: 20},
+				"POST /api/products/import": {Requests: 5, Period: time.Minute},
+			},
 		},
 	}
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// defaultCORSPolicy allows the frontend of a local run.
+func defaultCORSPolicy() CORSPolicy {
+	return CORSPolicy{
+		AllowedOrigins: []string{"http://localhost:3000"},
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete},
+		AllowedHeaders: []string{"Content-Type", "Authorization", "Project-ID"},
+		ExposedHeaders: []string{"X-Request-ID", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy", "Retry-After"},
+		MaxAge:         10 * time.Minute,
 	}
-	return defaultValue
 }
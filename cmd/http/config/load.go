@@ -0,0 +1,249 @@
+package config
+
+import (
+	"encoding"
+	"errors"
+	"flag"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConfigFileEnv names the config file when the -config flag is not given.
+const ConfigFileEnv = "CONFIG_FILE"
+
+// Load builds the config in layers, each one overriding the previous: Default, the YAML (or
+// JSON) config file, the environment and the flags in args. lookupEnv is os.LookupEnv outside
+// the tests. A value that does not parse as the type of its field fails the load, it is
+// never replaced by the default.
+func Load(args []string, lookupEnv func(key string) (string, bool)) (Config, error) {
+	cfg := Default()
+
+	flags := flag.NewFlagSet("products-catalog", flag.ContinueOnError)
+	configFile := flags.String("config", "", "YAML or JSON config file, "+ConfigFileEnv+" when not set")
+	var settings []flagSetting
+	for _, f := range fields(&cfg) {
+		flags.Var(&flagValue{field: f, settings: &settings}, flagName(f.path), fmt.Sprintf("%s (env %s)", f.path, f.env))
+	}
+	if err := flags.Parse(args); err != nil {
+		return Config{}, err
+	}
+	if flags.NArg() > 0 {
+		return Config{}, fmt.Errorf("unexpected argument %q", flags.Arg(0))
+	}
+
+	if *configFile == "" {
+		*configFile, _ = lookupEnv(ConfigFileEnv)
+	}
+	if *configFile != "" {
+		if err := loadFile(&cfg, *configFile); err != nil {
+			return Config{}, err
+		}
+	}
+
+	var errs []error
+	for _, f := range fields(&cfg) {
+		if err := loadEnv(f, lookupEnv); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return Config{}, err
+	}
+
+	byPath := map[string]field{}
+	for _, f := range fields(&cfg) {
+		byPath[f.path] = f
+	}
+	for _, setting := range settings {
+		// the values were parsed by flagValue.Set, they cannot fail here
+		_ = setValue(byPath[setting.path].value, setting.raw)
+	}
+	return cfg, nil
+}
+
+// loadFile decodes the config file over cfg, the keys missing in the file keep their value
+// and an unknown key fails the load. JSON is read as YAML.
+func loadFile(cfg *Config, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening config file: %w", err)
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("error reading config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// loadEnv sets the field from its variable, an empty variable is not set. A secret is read
+// from the file named by the variable with a _FILE suffix when it is set instead.
+func loadEnv(f field, lookupEnv func(key string) (string, bool)) error {
+	raw, ok := lookupEnv(f.env)
+	ok = ok && raw != ""
+
+	if f.secret {
+		if path, fileOK := lookupEnv(f.env + "_FILE"); fileOK && path != "" {
+			if ok {
+				return fmt.Errorf("%s and %s_FILE are both set", f.env, f.env)
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("%s_FILE: %w", f.env, err)
+			}
+			// the editors and the secret stores end the file with a newline
+			raw, ok = strings.TrimRight(string(content), "\r\n"), true
+		}
+	}
+
+	if !ok {
+		return nil
+	}
+	if err := setValue(f.value, raw); err != nil {
+		return fmt.Errorf("%s: %w", f.env, err)
+	}
+	return nil
+}
+
+// field is a setting of the config, a leaf of its struct tree.
+type field struct {
+	path   string
+	env    string
+	secret bool
+	value  reflect.Value
+}
+
+// fields lists the settings of cfg in the order of its struct, the values point into cfg.
+func fields(cfg *Config) []field {
+	var list []field
+	// the env tag of a struct prefixes the variables of its fields, see CORS
+	var walk func(value reflect.Value, prefix string, envPrefix string)
+	walk = func(value reflect.Value, prefix string, envPrefix string) {
+		for i := 0; i < value.NumField(); i++ {
+			structField := value.Type().Field(i)
+			path := prefix + structField.Tag.Get("yaml")
+			if structField.Type.Kind() == reflect.Struct && !isText(structField.Type) {
+				walk(value.Field(i), path+".", envPrefix+structField.Tag.Get("env"))
+				continue
+			}
+			list = append(list, field{
+				path:   path,
+				env:    envPrefix + structField.Tag.Get("env"),
+				secret: structField.Tag.Get("secret") == "true",
+				value:  value.Field(i),
+			})
+		}
+	}
+	walk(reflect.ValueOf(cfg).Elem(), "", "")
+	return list
+}
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// isText reports if the setting is parsed by its own UnmarshalText, e.g. Rate.
+func isText(t reflect.Type) bool {
+	return reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// isSet reports if a secret holds a value to redact.
+func isSet(value reflect.Value) bool {
+	if value.Kind() == reflect.Slice {
+		return value.Len() > 0
+	}
+	return value.String() != ""
+}
+
+// setValue parses raw as the type of value: a duration such as 30s, an integer, a boolean, a
+// string, a list of strings separated by commas or lines, or the text of an encoding.TextUnmarshaler.
+func setValue(value reflect.Value, raw string) error {
+	switch {
+	case isText(value.Type()):
+		return value.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw))
+	case value.Type() == durationType:
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", raw)
+		}
+		value.SetInt(int64(duration))
+	case value.Kind() == reflect.Int || value.Kind() == reflect.Int64:
+		number, err := strconv.ParseInt(raw, 10, value.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		value.SetInt(number)
+	case value.Kind() == reflect.Bool:
+		boolean, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", raw)
+		}
+		value.SetBool(boolean)
+	case value.Kind() == reflect.String:
+		value.SetString(raw)
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.String:
+		list := []string{}
+		// a list read from a file may hold an item per line
+		for _, item := range strings.FieldsFunc(raw, func(r rune) bool { return r == ',' || r == '\n' }) {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		value.Set(reflect.ValueOf(list))
+	default:
+		return fmt.Errorf("unsupported type %s", value.Type())
+	}
+	return nil
+}
+
+// flagName is the flag of a setting, server.read_timeout is -server.read-timeout.
+func flagName(path string) string {
+	return strings.ReplaceAll(path, "_", "-")
+}
+
+type flagSetting struct {
+	path string
+	raw  string
+}
+
+// flagValue checks the value of a flag when it is parsed and keeps it for the last layer,
+// the flags are parsed first because they may name the config file.
+type flagValue struct {
+	field    field
+	settings *[]flagSetting
+}
+
+func (v *flagValue) String() string {
+	if v == nil || !v.field.value.IsValid() {
+		return ""
+	}
+	// the usage prints the value as the default of the flag
+	if v.field.secret && isSet(v.field.value) {
+		return RedactedValue
+	}
+	if list, ok := v.field.value.Interface().([]string); ok {
+		return strings.Join(list, ",")
+	}
+	return fmt.Sprint(v.field.value.Interface())
+}
+
+func (v *flagValue) Set(raw string) error {
+	if err := setValue(reflect.New(v.field.value.Type()).Elem(), raw); err != nil {
+		return err
+	}
+	*v.settings = append(*v.settings, flagSetting{path: v.field.path, raw: raw})
+	return nil
+}
+
+func (v *flagValue) IsBoolFlag() bool {
+	return v.field.value.Kind() == reflect.Bool
+}
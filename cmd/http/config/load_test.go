@@ -0,0 +1,253 @@
+package config_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/cmd/http/config"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func envOf(values map[string]string) func(key string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := values[key]
+		return value, ok
+	}
+}
+
+func TestLoad(t *testing.T) {
+	yamlFile := writeFile(t, "config.yaml", `
+environment: dev
+mysql:
+  port: 3307
+  max_open_connections: 20
+server:
+  read_timeout: 20s
+  http2: false
+`)
+	jsonFile := writeFile(t, "config.json", `{"mysql": {"port": 3308}, "cart": {"ttl": "1h"}}`)
+	secretFile := writeFile(t, "mysql_password", "from-file\n")
+
+	testCases := []struct {
+		name          string
+		args          []string
+		env           map[string]string
+		assertConfig  func(t *testing.T, cfg config.Config)
+		expectedError string
+	}{
+		{
+			name: "Success - defaults",
+			assertConfig: func(t *testing.T, cfg config.Config) {
+				assert.Equal(t, config.Default(), cfg)
+			},
+		},
+		{
+			name: "Success - yaml file over the defaults",
+			args: []string{"-config", yamlFile},
+			assertConfig: func(t *testing.T, cfg config.Config) {
+				assert.Equal(t, config.EnvironmentDev, cfg.Environment)
+				assert.Equal(t, 3307, cfg.MySQL.Port)
+				assert.Equal(t, 20, cfg.MySQL.MaxOpenConnection)
+				assert.Equal(t, 5, cfg.MySQL.MaxIdleConnection)
+				assert.Equal(t, 20*time.Second, cfg.Server.ReadTimeout)
+				assert.False(t, cfg.Server.HTTP2)
+			},
+		},
+		{
+			name: "Success - json file named by the env",
+			env:  map[string]string{config.ConfigFileEnv: jsonFile},
+			assertConfig: func(t *testing.T, cfg config.Config) {
+				assert.Equal(t, 3308, cfg.MySQL.Port)
+				assert.Equal(t, time.Hour, cfg.Cart.TTL)
+			},
+		},
+		{
+			name: "Success - env over the file, flags over the env",
+			args: []string{"-config", yamlFile, "-mysql.max-open-connections", "30", "-server.http2"},
+			env:  map[string]string{"MY_SQL_PORT": "3309", "MY_SQL_MAX_OPEN_CONNECTIONS": "25", "SERVER_READ_TIMEOUT": "1m"},
+			assertConfig: func(t *testing.T, cfg config.Config) {
+				assert.Equal(t, 3309, cfg.MySQL.Port)
+				assert.Equal(t, 30, cfg.MySQL.MaxOpenConnection)
+				assert.Equal(t, time.Minute, cfg.Server.ReadTimeout)
+				assert.True(t, cfg.Server.HTTP2)
+			},
+		},
+		{
+			name: "Success - lists from the env and the flags",
+			args: []string{"-cors.account.allowed-origins", "https://account.example.com"},
+			env:  map[string]string{"CORS_CATALOG_ALLOWED_ORIGINS": "https://shop.example.com, https://*.example.com"},
+			assertConfig: func(t *testing.T, cfg config.Config) {
+				assert.Equal(t, []string{"https://shop.example.com", "https://*.example.com"}, cfg.CORS.Catalog.AllowedOrigins)
+				assert.Equal(t, []string{"https://account.example.com"}, cfg.CORS.Account.AllowedOrigins)
+				assert.Equal(t, config.Default().CORS.Account.AllowedMethods, cfg.CORS.Account.AllowedMethods)
+			},
+		},
+		{
+			name: "Success - rates from the env",
+			env: map[string]string{
+				"RATE_LIMIT_DEFAULT": "100/1m burst 150",
+				"RATE_LIMIT_ROUTES":  "POST  /api/orders=5/1m; /api/products/import=off",
+			},
+			assertConfig: func(t *testing.T, cfg config.Config) {
+				assert.Equal(t, config.Rate{Requests: 100, Period: time.Minute, Burst: 150}, cfg.RateLimit.Default)
+				assert.Equal(t, config.Rate{Requests: 5, Period: time.Minute}, cfg.RateLimit.Routes["POST /api/orders"])
+				assert.Equal(t, config.Rate{}, cfg.RateLimit.Routes["/api/products/import"])
+				assert.Equal(t, config.Default().RateLimit.Routes["POST /api/carts/"], cfg.RateLimit.Routes["POST /api/carts/"])
+			},
+		},
+		{
+			name:          "Failure - invalid rate",
+			env:           map[string]string{"RATE_LIMIT_ROUTES": "POST /api/orders=10 per minute"},
+			expectedError: `RATE_LIMIT_ROUTES: rate "10 per minute" must be <requests>/<period> [burst <requests>] or off`,
+		},
+		{
+			name: "Success - secret read from a file",
+			env:  map[string]string{"MY_SQL_PASSWORD_FILE": secretFile},
+			assertConfig: func(t *testing.T, cfg config.Config) {
+				assert.Equal(t, "from-file", cfg.MySQL.Password)
+			},
+		},
+		{
+			name:          "Failure - secret set twice",
+			env:           map[string]string{"MY_SQL_PASSWORD": "password", "MY_SQL_PASSWORD_FILE": secretFile},
+			expectedError: "MY_SQL_PASSWORD and MY_SQL_PASSWORD_FILE are both set",
+		},
+		{
+			name:          "Failure - invalid env value",
+			env:           map[string]string{"MY_SQL_PORT": "mysql", "CART_TTL": "3 days"},
+			expectedError: `MY_SQL_PORT: invalid integer "mysql"` + "\n" + `CART_TTL: invalid duration "3 days"`,
+		},
+		{
+			name:          "Failure - invalid flag value",
+			args:          []string{"-server.idle-timeout", "forever"},
+			expectedError: `invalid duration "forever"`,
+		},
+		{
+			name:          "Failure - unknown key in the file",
+			args:          []string{"-config", writeFile(t, "unknown.yaml", "mysql:\n  hostname: db\n")},
+			expectedError: "field hostname not found",
+		},
+		{
+			name:          "Failure - missing file",
+			args:          []string{"-config", filepath.Join(t.TempDir(), "missing.yaml")},
+			expectedError: "error opening config file",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			cfg, err := config.Load(tc.args, envOf(tc.env))
+
+			// Assert
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			tc.assertConfig(t, cfg)
+		})
+	}
+}
+
+func TestPrint_RedactsSecrets(t *testing.T) {
+	// Arrange
+	cfg := config.Default()
+	cfg.JWT.Secret = "jwt-secret"
+	cfg.MySQL.Password = "mysql-password"
+	var output bytes.Buffer
+
+	// Act
+	err := config.Print(&output, cfg)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotContains(t, output.String(), "jwt-secret")
+	assert.NotContains(t, output.String(), "mysql-password")
+	assert.Contains(t, output.String(), "password: '[REDACTED]'")
+	assert.Contains(t, output.String(), "read_timeout: 15s")
+	assert.Equal(t, "jwt-secret", cfg.JWT.Secret)
+
+	// the output is a valid config file
+	printed, err := config.Load([]string{"-config", writeFile(t, "printed.yaml", output.String())}, envOf(nil))
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Server, printed.Server)
+}
+
+func TestParseRate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		text          string
+		expected      config.Rate
+		expectedError string
+	}{
+		{
+			name:     "Success - requests per period",
+			text:     "10/1m",
+			expected: config.Rate{Requests: 10, Period: time.Minute},
+		},
+		{
+			name:     "Success - with a burst",
+			text:     " 60/1m burst 20 ",
+			expected: config.Rate{Requests: 60, Period: time.Minute, Burst: 20},
+		},
+		{
+			name: "Success - off",
+			text: "off",
+		},
+		{
+			name:          "Failure - missing period",
+			text:          "10",
+			expectedError: `rate "10" must be <requests>/<period> [burst <requests>] or off`,
+		},
+		{
+			name:          "Failure - requests not positive",
+			text:          "0/1m",
+			expectedError: `rate "0/1m": requests must be a positive integer`,
+		},
+		{
+			name:          "Failure - invalid period",
+			text:          "10/minute",
+			expectedError: `rate "10/minute": period must be a positive duration`,
+		},
+		{
+			name:          "Failure - invalid burst",
+			text:          "10/1m burst many",
+			expectedError: `rate "10/1m burst many": burst must be a positive integer`,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			rate, err := config.ParseRate(tc.text)
+
+			// Assert
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, rate)
+			// the text form reads back to the same rate
+			parsed, err := config.ParseRate(rate.String())
+			require.NoError(t, err)
+			assert.Equal(t, rate, parsed)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"gopkg.in/yaml.v3"
+	"io"
+	"reflect"
+)
+
+// RedactedValue is the placeholder of a secret that is set, see Config.Redacted.
+const RedactedValue = "[REDACTED]"
+
+// Redacted returns a copy of the config with the secrets that are set replaced by RedactedValue,
+// safe to print or log.
+func (c Config) Redacted() Config {
+	for _, f := range fields(&c) {
+		if !f.secret || !isSet(f.value) {
+			continue
+		}
+		if f.value.Kind() == reflect.Slice {
+			f.value.Set(reflect.ValueOf([]string{RedactedValue}))
+		} else {
+			f.value.SetString(RedactedValue)
+		}
+	}
+	return c
+}
+
+// Print writes the config as YAML with the secrets redacted, the output is a valid config
+// file once the secrets are filled in again.
+func Print(w io.Writer, cfg Config) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(cfg.Redacted()); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rateOff is the text of the rate without limit.
+const rateOff = "off"
+
+// Rate is a limit of requests per client, written <requests>/<period> such as 10/1m and
+// optionally followed by the burst: 10/1m burst 20. The burst is the requests by default,
+// "off" is no limit.
+type Rate struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+func ParseRate(text string) (Rate, error) {
+	text = strings.TrimSpace(text)
+	if text == rateOff {
+		return Rate{}, nil
+	}
+
+	fields := strings.Fields(text)
+	if len(fields) != 1 && (len(fields) != 3 || fields[1] != "burst") {
+		return Rate{}, fmt.Errorf("rate %q must be <requests>/<period> [burst <requests>] or off", text)
+	}
+	requests, period, found := strings.Cut(fields[0], "/")
+	if !found {
+		return Rate{}, fmt.Errorf("rate %q must be <requests>/<period> [burst <requests>] or off", text)
+	}
+
+	var rate Rate
+	var err error
+	if rate.Requests, err = strconv.Atoi(requests); err != nil || rate.Requests < 1 {
+		return Rate{}, fmt.Errorf("rate %q: requests must be a positive integer", text)
+	}
+	if rate.Period, err = time.ParseDuration(period); err != nil || rate.Period <= 0 {
+		return Rate{}, fmt.Errorf("rate %q: period must be a positive duration", text)
+	}
+	if len(fields) == 3 {
+		if rate.Burst, err = strconv.Atoi(fields[2]); err != nil || rate.Burst < 1 {
+			return Rate{}, fmt.Errorf("rate %q: burst must be a positive integer", text)
+		}
+	}
+	return rate, nil
+}
+
+func (r Rate) String() string {
+	if r.Requests == 0 {
+		return rateOff
+	}
+	text := fmt.Sprintf("%d/%s", r.Requests, r.Period)
+	if r.Burst > 0 {
+		text += fmt.Sprintf(" burst %d", r.Burst)
+	}
+	return text
+}
+
+func (r Rate) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Rate) UnmarshalText(text []byte) error {
+	rate, err := ParseRate(string(text))
+	if err != nil {
+		return err
+	}
+	*r = rate
+	return nil
+}
+
+// RouteRates are the rates of the routes keyed by their pattern, preceded by a method to only
+// limit that method: "POST /api/orders" or "/api/orders". As text it is a list of
+// <route>=<rate> separated by ";", the routes are added to the ones already set.
+type RouteRates map[string]Rate
+
+func (r RouteRates) String() string {
+	routes := make([]string, 0, len(r))
+	for route, rate := range r {
+		routes = append(routes, route+"="+rate.String())
+	}
+	slices.Sort(routes)
+	return strings.Join(routes, "; ")
+}
+
+func (r *RouteRates) UnmarshalText(text []byte) error {
+	if *r == nil {
+		*r = RouteRates{}
+	}
+	for _, entry := range strings.Split(string(text), ";") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		route, rate, found := strings.Cut(entry, "=")
+		if !found {
+			return fmt.Errorf("route rate %q must be <route>=<rate>", strings.TrimSpace(entry))
+		}
+		parsed, err := ParseRate(rate)
+		if err != nil {
+			return err
+		}
+		(*r)[strings.Join(strings.Fields(route), " ")] = parsed
+	}
+	return nil
+}
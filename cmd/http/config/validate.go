@@ -0,0 +1,172 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/netip"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Validate reports every setting the service cannot start with, joined in a single error. The
+// settings are named after their environment variable.
+func (c Config) Validate() error {
+	var errs []error
+
+	if c.Environment != EnvironmentDev && c.Environment != EnvironmentProduction {
+		errs = append(errs, fmt.Errorf("APP_ENV %q must be %s or %s", c.Environment, EnvironmentDev, EnvironmentProduction))
+	}
+	switch {
+	case c.JWT.Secret == "":
+		errs = append(errs, errors.New("JWT_SECRET must be set"))
+	case c.JWT.Secret == DefaultJWTSecret && c.Environment != EnvironmentDev:
+		errs = append(errs, fmt.Errorf("JWT_SECRET must be changed from the default outside %s mode", EnvironmentDev))
+	}
+
+	if c.MySQL.Port < 1 || c.MySQL.Port > 65535 {
+		errs = append(errs, fmt.Errorf("MY_SQL_PORT %d must be between 1 and 65535", c.MySQL.Port))
+	}
+	if c.MySQL.MaxOpenConnection < 1 {
+		errs = append(errs, fmt.Errorf("MY_SQL_MAX_OPEN_CONNECTIONS must be positive, got %d", c.MySQL.MaxOpenConnection))
+	}
+	if c.MySQL.MaxIdleConnection < 0 || c.MySQL.MaxIdleConnection > c.MySQL.MaxOpenConnection {
+		errs = append(errs, fmt.Errorf("MY_SQL_MAX_IDLE_CONNECTIONS %d must be between 0 and MY_SQL_MAX_OPEN_CONNECTIONS", c.MySQL.MaxIdleConnection))
+	}
+
+	if _, _, err := net.SplitHostPort(c.Port); err != nil {
+		errs = append(errs, fmt.Errorf("PORT %q must be [host]:port: %w", c.Port, err))
+	}
+
+	for _, timeout := range []struct {
+		name  string
+		value time.Duration
+	}{
+		{"MY_SQL_CONN_MAX_LIFETIME", c.MySQL.ConnMaxLifetime},
+		{"EXCHANGE_RATES_TTL", c.Pricing.ExchangeRatesTTL},
+		{"EXCHANGE_RATES_RETRY", c.Pricing.ExchangeRatesRetry},
+		{"EXCHANGE_RATES_MAX_STALE", c.Pricing.ExchangeRatesMaxStale},
+		{"PRICE_SCHEDULER_INTERVAL", c.Pricing.SchedulerInterval},
+		{"CART_TTL", c.Cart.TTL},
+		{"CART_EXPIRY_INTERVAL", c.Cart.ExpiryInterval},
+		{"PAYMENT_PENDING_TIMEOUT", c.Payment.PendingTimeout},
+		{"PAYMENT_REAPER_INTERVAL", c.Payment.ReaperInterval},
+		{"HEALTH_CHECK_TIMEOUT", c.Health.CheckTimeout},
+		{"HEALTH_CACHE_TTL", c.Health.CacheTTL},
+		{"SERVER_READ_TIMEOUT", c.Server.ReadTimeout},
+		{"SERVER_READ_HEADER_TIMEOUT", c.Server.ReadHeaderTimeout},
+		{"SERVER_WRITE_TIMEOUT", c.Server.WriteTimeout},
+		{"SERVER_IDLE_TIMEOUT", c.Server.IdleTimeout},
+		{"SERVER_IMPORT_TIMEOUT", c.Server.ImportTimeout},
+		{"SERVER_EXPORT_TIMEOUT", c.Server.ExportTimeout},
+		{"SHUTDOWN_TIMEOUT", c.Server.ShutdownTimeout},
+	} {
+		if timeout.value <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be a positive duration, got %s", timeout.name, timeout.value))
+		}
+	}
+	if c.Pricing.ExchangeRatesMaxStale < c.Pricing.ExchangeRatesTTL {
+		errs = append(errs, fmt.Errorf("EXCHANGE_RATES_MAX_STALE %s is shorter than EXCHANGE_RATES_TTL %s", c.Pricing.ExchangeRatesMaxStale, c.Pricing.ExchangeRatesTTL))
+	}
+	if c.Server.DrainDelay < 0 {
+		errs = append(errs, fmt.Errorf("SERVER_DRAIN_DELAY must not be negative, got %s", c.Server.DrainDelay))
+	}
+	if c.Server.ShutdownTimeout > 0 && c.Server.DrainDelay >= c.Server.ShutdownTimeout {
+		errs = append(errs, fmt.Errorf("SERVER_DRAIN_DELAY %s must be shorter than SHUTDOWN_TIMEOUT %s", c.Server.DrainDelay, c.Server.ShutdownTimeout))
+	}
+	if c.Server.ReadTimeout > 0 && c.Server.ReadHeaderTimeout > c.Server.ReadTimeout {
+		errs = append(errs, fmt.Errorf("SERVER_READ_HEADER_TIMEOUT %s exceeds SERVER_READ_TIMEOUT %s", c.Server.ReadHeaderTimeout, c.Server.ReadTimeout))
+	}
+
+	for _, size := range []struct {
+		name  string
+		value int64
+	}{
+		{"SERVER_MAX_HEADER_BYTES", int64(c.Server.MaxHeaderBytes)},
+		{"SERVER_MAX_BODY_BYTES", c.Server.MaxBodyBytes},
+		{"SERVER_MAX_IMPORT_BYTES", c.Server.MaxImportBytes},
+	} {
+		if size.value <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be a positive number of bytes, got %d", size.name, size.value))
+		}
+	}
+
+	if (c.Server.TLSCertFile == "") != (c.Server.TLSKeyFile == "") {
+		errs = append(errs, errors.New("TLS_CERT_FILE and TLS_KEY_FILE must be set together"))
+	}
+	for _, file := range []string{c.Server.TLSCertFile, c.Server.TLSKeyFile} {
+		if file == "" {
+			continue
+		}
+		if _, err := os.Stat(file); err != nil {
+			errs = append(errs, fmt.Errorf("TLS file: %w", err))
+		}
+	}
+
+	for _, policy := range []struct {
+		name   string
+		policy CORSPolicy
+	}{
+		{"CORS_CATALOG", c.CORS.Catalog},
+		{"CORS_ACCOUNT", c.CORS.Account},
+	} {
+		for _, origin := range policy.policy.AllowedOrigins {
+			if err := validateOrigin(origin); err != nil {
+				errs = append(errs, fmt.Errorf("%s_ALLOWED_ORIGINS: %w", policy.name, err))
+			}
+			if origin == "*" && policy.policy.AllowCredentials {
+				errs = append(errs, fmt.Errorf("%s_ALLOW_CREDENTIALS cannot be set with any origin (*) allowed", policy.name))
+			}
+		}
+		if policy.policy.MaxAge < 0 {
+			errs = append(errs, fmt.Errorf("%s_MAX_AGE must not be negative, got %s", policy.name, policy.policy.MaxAge))
+		}
+	}
+
+	if c.RateLimit.Store != "memory" && c.RateLimit.Store != "mysql" {
+		errs = append(errs, fmt.Errorf("RATE_LIMIT_STORE %q must be memory or mysql", c.RateLimit.Store))
+	}
+	if c.RateLimit.Store == "mysql" && c.RateLimit.MaxConnections < 1 {
+		errs = append(errs, fmt.Errorf("RATE_LIMIT_MAX_CONNECTIONS must be positive, got %d", c.RateLimit.MaxConnections))
+	}
+	for _, proxy := range c.RateLimit.TrustedProxies {
+		if _, err := netip.ParsePrefix(proxy); err != nil {
+			if _, err := netip.ParseAddr(proxy); err != nil {
+				errs = append(errs, fmt.Errorf("RATE_LIMIT_TRUSTED_PROXIES: %q must be an IP or a CIDR", proxy))
+			}
+		}
+	}
+	for _, route := range slices.Sorted(maps.Keys(c.RateLimit.Routes)) {
+		if !strings.Contains(route, "/") {
+			errs = append(errs, fmt.Errorf("RATE_LIMIT_ROUTES: route %q must be a pattern such as POST /api/orders", route))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateOrigin accepts "*", an origin such as https://shop.example.com:8443 or one with a
+// wildcard on the subdomains such as https://*.example.com.
+func validateOrigin(origin string) error {
+	if origin == "*" {
+		return nil
+	}
+	parsed, err := url.Parse(origin)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" ||
+		parsed.Path != "" || parsed.RawQuery != "" || parsed.Fragment != "" || parsed.User != nil {
+		return fmt.Errorf("origin %q must be scheme://host[:port]", origin)
+	}
+	if strings.Contains(strings.TrimPrefix(parsed.Host, "*."), "*") {
+		return fmt.Errorf("origin %q may only start its host with the wildcard *.", origin)
+	}
+	return nil
+}
+
+// TLS reports if the server is served over HTTPS.
+func (s Server) TLS() bool {
+	return s.TLSCertFile != "" && s.TLSKeyFile != ""
+}
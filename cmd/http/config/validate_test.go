@@ -0,0 +1,206 @@
+package config_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/config"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	certFile := filepath.Join(t.TempDir(), "cert.pem")
+	assert.NoError(t, os.WriteFile(certFile, []byte("certificate"), 0o600))
+
+	testCases := []struct {
+		name          string
+		update        func(cfg *config.Config)
+		expectedError []string
+	}{
+		{
+			name:   "Success - defaults",
+			update: func(cfg *config.Config) {},
+		},
+		{
+			name: "Success - secret changed in production",
+			update: func(cfg *config.Config) {
+				cfg.Environment = config.EnvironmentProduction
+				cfg.JWT.Secret = "a-long-random-secret"
+			},
+		},
+		{
+			name: "Failure - default secret in production",
+			update: func(cfg *config.Config) {
+				cfg.Environment = config.EnvironmentProduction
+			},
+			expectedError: []string{"JWT_SECRET must be changed from the default outside dev mode"},
+		},
+		{
+			name: "Failure - empty secret in dev",
+			update: func(cfg *config.Config) {
+				cfg.JWT.Secret = ""
+			},
+			expectedError: []string{"JWT_SECRET must be set"},
+		},
+		{
+			name: "Failure - unknown environment",
+			update: func(cfg *config.Config) {
+				cfg.Environment = "staging"
+			},
+			expectedError: []string{`APP_ENV "staging" must be dev or production`},
+		},
+		{
+			name: "Failure - mysql port and pool",
+			update: func(cfg *config.Config) {
+				cfg.MySQL.Port = 70000
+				cfg.MySQL.MaxIdleConnection = 20
+			},
+			expectedError: []string{
+				"MY_SQL_PORT 70000 must be between 1 and 65535",
+				"MY_SQL_MAX_IDLE_CONNECTIONS 20 must be between 0 and MY_SQL_MAX_OPEN_CONNECTIONS",
+			},
+		},
+		{
+			name: "Success - cors origins",
+			update: func(cfg *config.Config) {
+				cfg.CORS.Catalog.AllowedOrigins = []string{"*"}
+				cfg.CORS.Account.AllowedOrigins = []string{"https://shop.example.com", "https://*.example.com:8443"}
+			},
+		},
+		{
+			name: "Failure - invalid cors origins",
+			update: func(cfg *config.Config) {
+				cfg.CORS.Catalog.AllowedOrigins = []string{"shop.example.com", "https://shop.example.com/"}
+				cfg.CORS.Account.AllowedOrigins = []string{"https://shop.*.com", "*"}
+				cfg.CORS.Account.AllowCredentials = true
+			},
+			expectedError: []string{
+				`CORS_CATALOG_ALLOWED_ORIGINS: origin "shop.example.com" must be scheme://host[:port]`,
+				`CORS_CATALOG_ALLOWED_ORIGINS: origin "https://shop.example.com/" must be scheme://host[:port]`,
+				`CORS_ACCOUNT_ALLOWED_ORIGINS: origin "https://shop.*.com" may only start its host with the wildcard`,
+				"CORS_ACCOUNT_ALLOW_CREDENTIALS cannot be set with any origin (*) allowed",
+			},
+		},
+		{
+			name: "Success - rate limit with a shared store",
+			update: func(cfg *config.Config) {
+				cfg.RateLimit.Store = "mysql"
+				cfg.RateLimit.TrustedProxies = []string{"10.0.0.0/8", "192.0.2.1", "::1"}
+			},
+		},
+		{
+			name: "Failure - invalid rate limit",
+			update: func(cfg *config.Config) {
+				cfg.RateLimit.Store = "redis"
+				cfg.RateLimit.TrustedProxies = []string{"10.0.0.0/33", "proxy.internal"}
+				cfg.RateLimit.Routes = config.RouteRates{"orders": {Requests: 1, Period: time.Minute}}
+			},
+			expectedError: []string{
+				`RATE_LIMIT_STORE "redis" must be memory or mysql`,
+				`RATE_LIMIT_TRUSTED_PROXIES: "10.0.0.0/33" must be an IP or a CIDR`,
+				`RATE_LIMIT_TRUSTED_PROXIES: "proxy.internal" must be an IP or a CIDR`,
+				`RATE_LIMIT_ROUTES: route "orders" must be a pattern such as POST /api/orders`,
+			},
+		},
+		{
+			name: "Failure - shared store without connections",
+			update: func(cfg *config.Config) {
+				cfg.RateLimit.Store = "mysql"
+				cfg.RateLimit.MaxConnections = 0
+			},
+			expectedError: []string{"RATE_LIMIT_MAX_CONNECTIONS must be positive, got 0"},
+		},
+		{
+			name: "Failure - exchange rates stale for less than their ttl",
+			update: func(cfg *config.Config) {
+				cfg.Pricing.ExchangeRatesMaxStale = 30 * time.Minute
+			},
+			expectedError: []string{"EXCHANGE_RATES_MAX_STALE 30m0s is shorter than EXCHANGE_RATES_TTL 1h0m0s"},
+		},
+		{
+			name: "Failure - invalid port",
+			update: func(cfg *config.Config) {
+				cfg.Port = "8000"
+			},
+			expectedError: []string{`PORT "8000" must be [host]:port`},
+		},
+		{
+			name: "Failure - timeouts and sizes not positive",
+			update: func(cfg *config.Config) {
+				cfg.Server.WriteTimeout = 0
+				cfg.Server.MaxBodyBytes = -1
+			},
+			expectedError: []string{
+				"SERVER_WRITE_TIMEOUT must be a positive duration",
+				"SERVER_MAX_BODY_BYTES must be a positive number of bytes",
+			},
+		},
+		{
+			name: "Failure - header timeout exceeds read timeout",
+			update: func(cfg *config.Config) {
+				cfg.Server.ReadTimeout = time.Second
+				cfg.Server.ReadHeaderTimeout = 2 * time.Second
+			},
+			expectedError: []string{"SERVER_READ_HEADER_TIMEOUT 2s exceeds SERVER_READ_TIMEOUT 1s"},
+		},
+		{
+			name: "Success - drain delay disabled",
+			update: func(cfg *config.Config) {
+				cfg.Server.DrainDelay = 0
+			},
+		},
+		{
+			name: "Failure - drain delay negative",
+			update: func(cfg *config.Config) {
+				cfg.Server.DrainDelay = -time.Second
+			},
+			expectedError: []string{"SERVER_DRAIN_DELAY must not be negative, got -1s"},
+		},
+		{
+			name: "Failure - drain delay exceeds shutdown timeout",
+			update: func(cfg *config.Config) {
+				cfg.Server.DrainDelay = 30 * time.Second
+			},
+			expectedError: []string{"SERVER_DRAIN_DELAY 30s must be shorter than SHUTDOWN_TIMEOUT 30s"},
+		},
+		{
+			name: "Failure - certificate without key",
+			update: func(cfg *config.Config) {
+				cfg.Server.TLSCertFile = certFile
+			},
+			expectedError: []string{"TLS_CERT_FILE and TLS_KEY_FILE must be set together"},
+		},
+		{
+			name: "Failure - missing key file",
+			update: func(cfg *config.Config) {
+				cfg.Server.TLSCertFile = certFile
+				cfg.Server.TLSKeyFile = filepath.Join(t.TempDir(), "key.pem")
+			},
+			expectedError: []string{"TLS file", "key.pem"},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			cfg := config.Default()
+			cfg.Environment = config.EnvironmentDev
+			tc.update(&cfg)
+
+			// Act
+			err := cfg.Validate()
+
+			// Assert
+			if len(tc.expectedError) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, expected := range tc.expectedError {
+				assert.ErrorContains(t, err, expected)
+			}
+		})
+	}
+}
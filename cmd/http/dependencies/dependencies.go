@@ -1,44 +1,223 @@
 package dependencies
 
 import (
+	"context"
 	"fmt"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"microservice-products-catalog/cmd/http/auth"
 	"microservice-products-catalog/cmd/http/config"
+	"microservice-products-catalog/cmd/http/handlers/probes"
 	"microservice-products-catalog/cmd/http/handlers/reader"
 	"microservice-products-catalog/cmd/http/handlers/writer"
+	"microservice-products-catalog/cmd/http/limiter"
+	"microservice-products-catalog/internal/infraestructure/exchange"
+	"microservice-products-catalog/internal/infraestructure/health"
+	"microservice-products-catalog/internal/infraestructure/invoice"
+	"microservice-products-catalog/internal/infraestructure/metrics"
 	my_sql "microservice-products-catalog/internal/infraestructure/my-sql"
+	"microservice-products-catalog/internal/infraestructure/notifier"
+	"microservice-products-catalog/internal/infraestructure/payment"
+	"microservice-products-catalog/internal/infraestructure/ratelimit"
 	"microservice-products-catalog/internal/infraestructure/security/jwt"
+	"microservice-products-catalog/internal/infraestructure/tax"
+	"microservice-products-catalog/internal/infraestructure/tracing"
+	"microservice-products-catalog/internal/service/cart"
+	"microservice-products-catalog/internal/service/category"
+	"microservice-products-catalog/internal/service/customer"
+	"microservice-products-catalog/internal/service/inventory"
 	"microservice-products-catalog/internal/service/order"
+	"microservice-products-catalog/internal/service/pricing"
 	"microservice-products-catalog/internal/service/product"
+	"microservice-products-catalog/internal/service/promotion"
 	"time"
 )
 
+// PriceScheduler applies the scheduled prices in the background, see product.Service.RunPriceScheduler.
+type PriceScheduler interface {
+	RunPriceScheduler(ctx context.Context, interval time.Duration)
+}
+
+// CartExpiry deletes the expired carts in the background, see cart.Service.RunCartExpiry.
+type CartExpiry interface {
+	RunCartExpiry(ctx context.Context, interval time.Duration)
+}
+
+// PendingOrderReaper compensates the orders left pending in the background, see
+// order.Service.RunPendingOrderReaper.
+type PendingOrderReaper interface {
+	RunPendingOrderReaper(ctx context.Context, interval time.Duration, timeout time.Duration)
+}
+
+// PendingRefundReconciler completes the refunds left pending in the background, see
+// order.Service.RunPendingRefundReconciler.
+type PendingRefundReconciler interface {
+	RunPendingRefundReconciler(ctx context.Context, interval time.Duration, timeout time.Duration)
+}
+
 type Dependencies struct {
-	TokenGenerator reader.TokenGenerator
-	WriterHandler  writer.WriteHandler
-	ReaderHandler  reader.ReaderHandler
+	TokenGenerator   reader.TokenGenerator
+	TokenVerifier    auth.TokenVerifier
+	WriterHandler    writer.WriteHandler
+	ReaderHandler    reader.ReaderHandler
+	PriceScheduler   PriceScheduler
+	CartExpiry       CartExpiry
+	OrderReaper      PendingOrderReaper
+	RefundReconciler PendingRefundReconciler
+	Logger           *slog.Logger
+	Metrics          *metrics.Metrics
+	TracerProvider   *sdktrace.TracerProvider
+	Tracer           trace.Tracer
+	ProbeHandler     probes.ProbeHandler
+	Health           *health.Checker
+	Repository       *my_sql.Repository
+	CORS             config.CORS
+	RateLimiter      *limiter.Middleware
 }
 
-func InitDependencies(cfg config.Config) Dependencies {
+// InitDependencies builds the layers of the service from cfg. When a component cannot be built
+// the error is returned and what was already opened, the MySQL pool and the tracer provider,
+// is released.
+func InitDependencies(cfg config.Config, logger *slog.Logger) (dep Dependencies, err error) {
 	// repository layer
-	mySQLRepo, err := my_sql.NewRepository(cfg)
+	mySQLRepo, err := my_sql.NewRepository(cfg, logger)
 	if err != nil {
-		panic(fmt.Sprintf("failed to connect mysql: %s", err.Error()))
+		return Dependencies{}, fmt.Errorf("failed to connect mysql: %w", err)
 	}
-	txManager := my_sql.NewTxManager(mySQLRepo.DB())
+	defer func() {
+		if err != nil {
+			mySQLRepo.Close()
+		}
+	}()
+
+	// the transactions, the pool and the orders placed are measured by decorators, see metrics.Metrics
+	serviceMetrics := metrics.New()
+	sqlDB, err := mySQLRepo.DB().DB()
+	if err != nil {
+		return Dependencies{}, fmt.Errorf("failed to get mysql pool: %w", err)
+	}
+	serviceMetrics.RegisterDB(sqlDB, cfg.MySQL.DBName)
+
+	// the spans of the routes, services, transactions and queries, see tracing.NewProvider
+	tracerProvider, err := tracing.NewProvider(context.Background(), cfg.Tracing.Exporter, cfg.Tracing.Endpoint, cfg.Tracing.ServiceName)
+	if err != nil {
+		return Dependencies{}, fmt.Errorf("failed to init tracing: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+			defer cancel()
+			_ = tracerProvider.Shutdown(ctx)
+		}
+	}()
+	tracer := tracerProvider.Tracer("microservice-products-catalog")
+	if err := mySQLRepo.DB().Use(tracing.NewGormPlugin(tracer)); err != nil {
+		return Dependencies{}, fmt.Errorf("failed to init query tracing: %w", err)
+	}
+
+	txManager := tracing.NewTxManager(metrics.NewTxManager(my_sql.NewTxManager(mySQLRepo.DB()), serviceMetrics), tracer)
 
 	tokenGenerator := jwt.NewTokenGenerator(cfg.JWT.Secret, 15*time.Minute)
+	tokenVerifier := jwt.NewVerifier(cfg.JWT.Secret)
+
+	var stockNotifier inventory.Notifier = notifier.NewLogNotifier()
+	if cfg.Inventory.WebhookURL != "" {
+		stockNotifier = notifier.NewWebhookNotifier(cfg.Inventory.WebhookURL, 5*time.Second)
+	}
+
+	var exchangeRateProvider pricing.ExchangeRateProvider
+	if cfg.Pricing.ExchangeRatesURL != "" {
+		exchangeRateProvider = exchange.NewHTTPProvider(
+			cfg.Pricing.ExchangeRatesURL,
+			cfg.Pricing.ExchangeRatesTTL,
+			cfg.Pricing.ExchangeRatesRetry,
+			cfg.Pricing.ExchangeRatesMaxStale,
+			5*time.Second,
+		)
+	} else {
+		staticProvider, err := exchange.NewStaticProvider(cfg.Pricing.ExchangeRatesFile)
+		if err != nil {
+			return Dependencies{}, fmt.Errorf("failed to load exchange rates: %w", err)
+		}
+		exchangeRateProvider = staticProvider
+	}
+
+	taxCalculator, err := tax.NewFileCalculator(cfg.Tax.RulesFile)
+	if err != nil {
+		return Dependencies{}, fmt.Errorf("failed to load tax rules: %w", err)
+	}
+
+	invoiceRenderer, err := invoice.NewRenderer(cfg.Invoice.TemplatesDir)
+	if err != nil {
+		return Dependencies{}, fmt.Errorf("failed to load invoice templates: %w", err)
+	}
+
+	var paymentGateway order.PaymentGateway
+	switch cfg.Payment.Gateway {
+	case "fake":
+		fakeGateway, err := payment.NewFakeGateway(payment.FakeMode(cfg.Payment.FakeMode))
+		if err != nil {
+			return Dependencies{}, fmt.Errorf("failed to init payment gateway: %w", err)
+		}
+		paymentGateway = fakeGateway
+	default:
+		return Dependencies{}, fmt.Errorf("unknown payment gateway %q", cfg.Payment.Gateway)
+	}
 
 	// service layer
-	productsService := product.NewService(mySQLRepo, txManager)
-	ordersService := order.NewService(mySQLRepo, txManager, productsService)
+	pricingService := pricing.NewService(exchangeRateProvider)
+	inventoryService := inventory.NewService(mySQLRepo, stockNotifier)
+	productsService := product.NewInstrumentedService(product.NewService(mySQLRepo, txManager, inventoryService), tracer)
+	promotionsService := promotion.NewService(mySQLRepo, txManager)
+	ordersService := order.NewInstrumentedService(
+		order.NewService(mySQLRepo, txManager, productsService, inventoryService, pricingService, promotionsService, taxCalculator, paymentGateway),
+		serviceMetrics,
+		tracer,
+	)
+	categoriesService := category.NewService(mySQLRepo, txManager, productsService)
+	customersService := customer.NewService(mySQLRepo, txManager)
+	cartsService := cart.NewService(mySQLRepo, txManager, productsService, ordersService, cfg.Cart.TTL)
 
-	// handler layer
-	writerHandler := writer.NewWriteHandler(productsService, ordersService)
-	readerHandler := reader.NewReaderHandler(productsService, ordersService, tokenGenerator)
+	healthChecker := health.NewChecker(
+		cfg.Health.CheckTimeout,
+		cfg.Health.CacheTTL,
+		health.DatabaseCheck("mysql", mySQLRepo),
+		health.MigrationsCheck(mySQLRepo, my_sql.SchemaVersion),
+	)
 
-	return Dependencies{
-		WriterHandler: *writerHandler,
-		ReaderHandler: *readerHandler,
+	// the buckets are shared by the replicas in mysql, on a pool of their own, see config.RateLimit
+	var rateLimitStore ratelimit.Store = ratelimit.NewMemoryStore()
+	if cfg.RateLimit.Store == "mysql" {
+		rateLimitStore = my_sql.NewRateLimitStore(mySQLRepo.RateLimitDB())
 	}
+	rateLimiter := limiter.New(ratelimit.NewLimiter(rateLimitStore, time.Now), tokenVerifier, cfg.RateLimit)
+
+	// handler layer
+	writerHandler := writer.NewWriteHandler(productsService, ordersService, categoriesService, promotionsService, customersService, cartsService)
+	writerHandler.MaxBodyBytes = cfg.Server.MaxBodyBytes
+	writerHandler.MaxImportBytes = cfg.Server.MaxImportBytes
+	writerHandler.ImportTimeout = cfg.Server.ImportTimeout
+	probeHandler := probes.NewProbeHandler(healthChecker)
+	readerHandler := reader.NewReaderHandler(productsService, ordersService, inventoryService, categoriesService, pricingService, promotionsService, customersService, cartsService, tokenGenerator, invoiceRenderer)
+	readerHandler.ExportTimeout = cfg.Server.ExportTimeout
 
+	return Dependencies{
+		TokenVerifier:    tokenVerifier,
+		WriterHandler:    *writerHandler,
+		ReaderHandler:    *readerHandler,
+		PriceScheduler:   productsService,
+		CartExpiry:       cartsService,
+		OrderReaper:      ordersService,
+		RefundReconciler: ordersService,
+		Logger:           logger,
+		Metrics:          serviceMetrics,
+		TracerProvider:   tracerProvider,
+		Tracer:           tracer,
+		ProbeHandler:     *probeHandler,
+		Health:           healthChecker,
+		Repository:       mySQLRepo,
+		CORS:             cfg.CORS,
+		RateLimiter:      rateLimiter,
+	}, nil
 }
@@ -0,0 +1,15 @@
+package dto
+
+// CreateCategoryRequest creates a root category when ParentID is not set.
+type CreateCategoryRequest struct {
+	Name        string  `json:"name" validate:"required,max=255"`
+	Description string  `json:"description"`
+	ParentID    *string `json:"parent_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// UpdateCategoryRequest moves the category to the root of the tree when ParentID is an empty string.
+type UpdateCategoryRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Description *string `json:"description,omitempty"`
+	ParentID    *string `json:"parent_id,omitempty" validate:"omitempty,uuid"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+// CreateCustomerRequest registers the customer of the token, ID can only be chosen by admins
+// and defaults to the subject of the token (a generated one for admins).
+type CreateCustomerRequest struct {
+	ID    string `json:"id,omitempty" validate:"omitempty,uuid"`
+	Email string `json:"email" validate:"required,email,max=255"`
+	Name  string `json:"name" validate:"required,max=255"`
+}
+
+type UpdateCustomerRequest struct {
+	Email *string `json:"email,omitempty" validate:"omitempty,email,max=255"`
+	Name  *string `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+}
@@ -1,6 +1,40 @@
 package dto
 
 type CreateOrderRequest struct {
-	ProductID string `json:"product_id"`
-	Quantity  int    `json:"quantity"`
+	// CustomerID places the order on behalf of another customer, it requires an admin token
+	// and defaults to the subject of the token.
+	CustomerID string `json:"customer_id,omitempty"`
+	ProductID  string `json:"product_id"`
+	VariantID  string `json:"variant_id,omitempty"`
+	Quantity   int    `json:"quantity"`
+	// Currency of the total, the base currency when empty.
+	Currency string `json:"currency,omitempty"`
+	// CouponCode is redeemed on top of the automatic promotions.
+	CouponCode string `json:"coupon_code,omitempty"`
+	// Region is the tax region of the destination, empty for the default region.
+	Region string `json:"region,omitempty"`
+}
+
+// CreateRefundRequest refunds Quantity units of an order, Restock puts them back in stock.
+type CreateRefundRequest struct {
+	Quantity int    `json:"quantity" validate:"min=1"`
+	Reason   string `json:"reason" validate:"required,max=255"`
+	Restock  bool   `json:"restock"`
+}
+
+// AddCartItemRequest adds Quantity units to the cart, VariantID is required for products with variants.
+type AddCartItemRequest struct {
+	ProductID string `json:"product_id" validate:"required,uuid"`
+	VariantID string `json:"variant_id,omitempty" validate:"omitempty,uuid"`
+	Quantity  int    `json:"quantity" validate:"min=1"`
+}
+
+type UpdateCartItemRequest struct {
+	Quantity int `json:"quantity" validate:"min=1"`
+}
+
+// CheckoutCartRequest holds the options applied to every order of the cart.
+type CheckoutCartRequest struct {
+	Currency string `json:"currency,omitempty"`
+	Region   string `json:"region,omitempty"`
 }
@@ -1,16 +1,57 @@
 package dto
 
+import (
+	"microservice-products-catalog/internal/domain"
+	"time"
+)
+
 // CreateProductRequest Ensure to add the necessaries validations to DTO.
+// Prices accept {"amount": "12.21", "currency": "USD"} or a decimal string, bare JSON numbers are
+// deprecated and still accepted during the migration of the float-based clients.
+// ReorderPoint and ReorderQuantity are optional, a zero reorder point disables the low-stock alerts.
 type CreateProductRequest struct {
-	Name        string  `json:"name" validate:"required"`
-	Description string  `json:"description" validate:"required"`
-	Price       float64 `json:"price" validate:"required,min=0.1"`
-	Stock       int     `json:"stock" validate:"required,min=0"`
+	Name            string       `json:"name" validate:"required"`
+	Description     string       `json:"description" validate:"required"`
+	Price           domain.Money `json:"price" validate:"required,min=10"`
+	Stock           int          `json:"stock" validate:"required,min=0"`
+	ReorderPoint    int          `json:"reorder_point" validate:"min=0"`
+	ReorderQuantity int          `json:"reorder_quantity" validate:"min=0"`
+	ExternalSKU     string       `json:"external_sku" validate:"omitempty,max=64"`
 }
 
 type UpdateProductRequest struct {
-	Name        *string  `json:"name,omitempty" validate:"omitempty,min=1"`
-	Description *string  `json:"description,omitempty" validate:"omitempty,min=1"`
-	Price       *float64 `json:"price,omitempty" validate:"omitempty,min=0.1"`
-	Stock       *int     `json:"stock,omitempty" validate:"omitempty,min=0"`
+	Name            *string       `json:"name,omitempty" validate:"omitempty,min=1"`
+	Description     *string       `json:"description,omitempty" validate:"omitempty,min=1"`
+	Price           *domain.Money `json:"price,omitempty" validate:"omitempty,min=10"`
+	Stock           *int          `json:"stock,omitempty" validate:"omitempty,min=0"`
+	ReorderPoint    *int          `json:"reorder_point,omitempty" validate:"omitempty,min=0"`
+	ReorderQuantity *int          `json:"reorder_quantity,omitempty" validate:"omitempty,min=0"`
+	ExternalSKU     *string       `json:"external_sku,omitempty" validate:"omitempty,min=1,max=64"`
+}
+
+type CreateVariantRequest struct {
+	SKU     string            `json:"sku" validate:"required,max=64"`
+	Options map[string]string `json:"options"`
+	Price   *domain.Money     `json:"price,omitempty" validate:"omitempty,min=10"`
+	Stock   int               `json:"stock" validate:"min=0"`
+}
+
+type UpdateVariantRequest struct {
+	SKU     *string           `json:"sku,omitempty" validate:"omitempty,min=1,max=64"`
+	Options map[string]string `json:"options,omitempty"`
+	Price   *domain.Money     `json:"price,omitempty" validate:"omitempty,min=10"`
+	Stock   *int              `json:"stock,omitempty" validate:"omitempty,min=0"`
+}
+
+// SetProductPriceRequest is the list price of a product, the currency comes from the path.
+type SetProductPriceRequest struct {
+	Amount string `json:"amount" validate:"required"`
+}
+
+// CreateScheduledPriceRequest changes the base price at EffectiveAt, with EndsAt the previous
+// price comes back at EndsAt.
+type CreateScheduledPriceRequest struct {
+	Price       domain.Money `json:"price" validate:"required,min=10"`
+	EffectiveAt time.Time    `json:"effective_at" validate:"required"`
+	EndsAt      *time.Time   `json:"ends_at,omitempty"`
 }
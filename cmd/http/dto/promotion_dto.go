@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"microservice-products-catalog/internal/domain"
+	"time"
+)
+
+// CreatePromotionRequest fields depend on the type: percentage needs Percentage, fixed_amount
+// needs Amount and buy_x_get_y needs BuyQuantity and GetQuantity. Promotions without
+// CouponCode apply automatically, Active defaults to true.
+type CreatePromotionRequest struct {
+	Name        string        `json:"name" validate:"required,max=255"`
+	Type        string        `json:"type" validate:"required,oneof=percentage fixed_amount buy_x_get_y"`
+	Percentage  int           `json:"percentage" validate:"min=0,max=100"`
+	Amount      *domain.Money `json:"amount,omitempty" validate:"omitempty,min=1"`
+	BuyQuantity int           `json:"buy_quantity" validate:"min=0"`
+	GetQuantity int           `json:"get_quantity" validate:"min=0"`
+	ProductID   *string       `json:"product_id,omitempty" validate:"omitempty,uuid"`
+	CouponCode  *string       `json:"coupon_code,omitempty" validate:"omitempty,min=3,max=32,alphanum"`
+	UsageLimit  *int          `json:"usage_limit,omitempty" validate:"omitempty,min=1"`
+	StartsAt    *time.Time    `json:"starts_at,omitempty"`
+	EndsAt      *time.Time    `json:"ends_at,omitempty"`
+	Active      *bool         `json:"active,omitempty"`
+}
+
+// UpdatePromotionRequest cannot change the type, product or coupon code of a promotion.
+type UpdatePromotionRequest struct {
+	Name        *string       `json:"name,omitempty" validate:"omitempty,min=1,max=255"`
+	Percentage  *int          `json:"percentage,omitempty" validate:"omitempty,min=1,max=100"`
+	Amount      *domain.Money `json:"amount,omitempty" validate:"omitempty,min=1"`
+	BuyQuantity *int          `json:"buy_quantity,omitempty" validate:"omitempty,min=1"`
+	GetQuantity *int          `json:"get_quantity,omitempty" validate:"omitempty,min=1"`
+	UsageLimit  *int          `json:"usage_limit,omitempty" validate:"omitempty,min=1"`
+	StartsAt    *time.Time    `json:"starts_at,omitempty"`
+	EndsAt      *time.Time    `json:"ends_at,omitempty"`
+	Active      *bool         `json:"active,omitempty"`
+}
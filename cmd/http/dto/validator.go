@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"gopkg.in/go-playground/validator.v9"
+	"microservice-products-catalog/internal/domain"
+	"reflect"
+	"strings"
+)
+
+// MinorUnits is the amount a Money field is validated by, its own type tells the money
+// fields apart in the validation errors.
+type MinorUnits int64
+
+// NewValidator returns the validator used for the DTOs. Money fields are validated by
+// their amount in minor units, so `min=10` means at least 0.10. The errors name the fields
+// after their JSON names.
+func NewValidator() *validator.Validate {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			return field.Name
+		}
+		return name
+	})
+	validate.RegisterCustomTypeFunc(func(field reflect.Value) any {
+		if money, ok := field.Interface().(domain.Money); ok {
+			return MinorUnits(money.Amount)
+		}
+		return nil
+	}, domain.Money{})
+	return validate
+}
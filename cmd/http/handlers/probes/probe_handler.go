@@ -0,0 +1,49 @@
+package probes
+
+import (
+	"context"
+	"encoding/json"
+	"microservice-products-catalog/internal/infraestructure/health"
+	"net/http"
+)
+
+type ReadinessChecker interface {
+	Check(ctx context.Context) health.Report
+}
+
+type ProbeHandler struct {
+	Checker ReadinessChecker
+}
+
+func NewProbeHandler(checker ReadinessChecker) *ProbeHandler {
+	return &ProbeHandler{
+		Checker: checker,
+	}
+}
+
+// HandleLiveness serves GET /healthz, it answers 200 while the process serves requests and
+// does not check the dependencies: a database outage must not get the service restarted.
+func (h *ProbeHandler) HandleLiveness(w http.ResponseWriter, r *http.Request) {
+	writeReport(w, http.StatusOK, health.Report{Status: health.StatusOK})
+}
+
+// HandleReadiness serves GET /readyz, 200 when every check is ok and 503 otherwise or while
+// the service is shutting down.
+func (h *ProbeHandler) HandleReadiness(w http.ResponseWriter, r *http.Request) {
+	report := h.Checker.Check(r.Context())
+
+	status := http.StatusOK
+	if !report.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+	writeReport(w, status, report)
+}
+
+func writeReport(w http.ResponseWriter, status int, report health.Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		return
+	}
+}
@@ -0,0 +1,79 @@
+package probes_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/handlers/probes"
+	"microservice-products-catalog/internal/infraestructure/health"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type fakeChecker struct {
+	report health.Report
+}
+
+func (f fakeChecker) Check(ctx context.Context) health.Report {
+	return f.report
+}
+
+func TestHandleReadiness(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		report               health.Report
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name: "Success - 200 when every check is ok",
+			report: health.Report{Status: health.StatusReady, Checks: map[string]health.CheckResult{
+				"mysql": {Status: health.StatusOK, Latency: "1.2ms"},
+			}},
+			expectedStatus:       http.StatusOK,
+			expectedBodyContains: `"mysql":{"status":"ok","latency":"1.2ms"`,
+		},
+		{
+			name: "Failure - 503 when a check fails",
+			report: health.Report{Status: health.StatusNotReady, Checks: map[string]health.CheckResult{
+				"migrations": {Status: health.StatusFailing, Latency: "3ms", Error: "schema version is 1, 2 is required"},
+			}},
+			expectedStatus:       http.StatusServiceUnavailable,
+			expectedBodyContains: `"error":"schema version is 1, 2 is required"`,
+		},
+		{
+			name:                 "Failure - 503 while shutting down",
+			report:               health.Report{Status: health.StatusShuttingDown},
+			expectedStatus:       http.StatusServiceUnavailable,
+			expectedBodyContains: `{"status":"shutting_down"}`,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := probes.NewProbeHandler(fakeChecker{report: tc.report})
+			recorder := httptest.NewRecorder()
+
+			// Act
+			handler.HandleReadiness(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+			assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+		})
+	}
+}
+
+func TestHandleLiveness(t *testing.T) {
+	handler := probes.NewProbeHandler(fakeChecker{report: health.Report{Status: health.StatusNotReady}})
+	recorder := httptest.NewRecorder()
+
+	handler.HandleLiveness(recorder, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, recorder.Body.String())
+}
@@ -0,0 +1,231 @@
+package reader
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	exportFormatCSV    = "csv"
+	exportFormatNDJSON = "ndjson"
+)
+
+// exportColumn is a field that can be selected with ?fields=, name is the JSON name of the field.
+type exportColumn[T any] struct {
+	name  string
+	value func(T) any
+}
+
+// exporter writes the rows of an export as they are produced. The status and the
+// headers are only sent with the first row, so an error before it can still be
+// answered with a proper status code.
+type exporter[T any] struct {
+	w        http.ResponseWriter
+	r        *http.Request
+	format   string
+	filename string
+	gzip     bool
+	columns  []exportColumn[T]
+	logger   *slog.Logger
+
+	out     io.Writer
+	gz      *gzip.Writer
+	csv     *csv.Writer
+	started bool
+}
+
+// newExporter reads the format and the fields of the export. A large export takes longer than
+// the server write timeout allows, a positive timeout replaces it.
+func newExporter[T any](w http.ResponseWriter, r *http.Request, name string, available []exportColumn[T], timeout time.Duration) (*exporter[T], error) {
+	query := r.URL.Query()
+
+	format := query.Get("format")
+	if format == "" {
+		format = exportFormatCSV
+	}
+	if format != exportFormatCSV && format != exportFormatNDJSON {
+		return nil, fmt.Errorf("format must be one of: csv, ndjson")
+	}
+
+	columns, err := selectExportColumns(query.Get("fields"), available)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := logging.FromContext(r.Context())
+	if timeout > 0 {
+		if err := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(timeout)); err != nil {
+			logger.Warn("error extending the export deadline", "error", err)
+		}
+	}
+
+	return &exporter[T]{
+		w:        w,
+		r:        r,
+		format:   format,
+		filename: fmt.Sprintf("%s-%s.%s", name, time.Now().Format("20060102"), format),
+		gzip:     acceptsGzip(r),
+		columns:  columns,
+		logger:   logger,
+	}, nil
+}
+
+func selectExportColumns[T any](fields string, available []exportColumn[T]) ([]exportColumn[T], error) {
+	if fields == "" {
+		return available, nil
+	}
+
+	names := make([]string, 0, len(available))
+	for _, column := range available {
+		names = append(names, column.name)
+	}
+
+	var columns []exportColumn[T]
+	for _, field := range strings.Split(fields, ",") {
+		field = strings.TrimSpace(field)
+		found := false
+		for _, column := range available {
+			if column.name == field {
+				columns = append(columns, column)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown field %q, must be one of: %s", field, strings.Join(names, ", "))
+		}
+	}
+	return columns, nil
+}
+
+func acceptsGzip(r *http.Request) bool {
+	for _, encoding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		encoding, _, _ = strings.Cut(strings.TrimSpace(encoding), ";")
+		if encoding == "gzip" {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *exporter[T]) start() error {
+	if e.started {
+		return nil
+	}
+	e.started = true
+
+	if e.format == exportFormatCSV {
+		e.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	} else {
+		e.w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", e.filename))
+	e.w.Header().Set("Vary", "Accept-Encoding")
+
+	e.out = e.w
+	if e.gzip {
+		e.w.Header().Set("Content-Encoding", "gzip")
+		e.gz = gzip.NewWriter(e.w)
+		e.out = e.gz
+	}
+	e.w.WriteHeader(http.StatusOK)
+
+	if e.format == exportFormatCSV {
+		e.csv = csv.NewWriter(e.out)
+		header := make([]string, 0, len(e.columns))
+		for _, column := range e.columns {
+			header = append(header, column.name)
+		}
+		return e.csv.Write(header)
+	}
+	return nil
+}
+
+func (e *exporter[T]) write(row T) error {
+	if err := e.start(); err != nil {
+		return err
+	}
+
+	if e.format == exportFormatCSV {
+		record := make([]string, 0, len(e.columns))
+		for _, column := range e.columns {
+			record = append(record, csvValue(column.value(row)))
+		}
+		return e.csv.Write(record)
+	}
+
+	// the object is built by hand to keep the order of the selected fields
+	var line bytes.Buffer
+	line.WriteByte('{')
+	for i, column := range e.columns {
+		if i > 0 {
+			line.WriteByte(',')
+		}
+		key, _ := json.Marshal(column.name)
+		value, err := json.Marshal(column.value(row))
+		if err != nil {
+			return err
+		}
+		line.Write(key)
+		line.WriteByte(':')
+		line.Write(value)
+	}
+	line.WriteString("}\n")
+	_, err := e.out.Write(line.Bytes())
+	return err
+}
+
+// finish flushes the pending output, an empty export still answers the headers (and the CSV header row).
+func (e *exporter[T]) finish() error {
+	if err := e.start(); err != nil {
+		return err
+	}
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	}
+	if e.gz != nil {
+		return e.gz.Close()
+	}
+	return nil
+}
+
+// fail answers the error when nothing was sent yet, otherwise the connection is
+// aborted so the client does not take a truncated export as a complete one.
+func (e *exporter[T]) fail(err error, message string) {
+	e.logger.Error(message, "error", err)
+	if e.started {
+		panic(http.ErrAbortHandler)
+	}
+	problem.WriteStatus(e.w, e.r, http.StatusInternalServerError, message)
+}
+
+func csvValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case *string:
+		if v == nil {
+			return ""
+		}
+		return *v
+	case time.Time:
+		return v.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,109 @@
+package reader
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var orderExportColumns = []exportColumn[domain.Order]{
+	{name: "id", value: func(o domain.Order) any { return o.ID }},
+	{name: "customer_id", value: func(o domain.Order) any { return o.CustomerID }},
+	{name: "product_id", value: func(o domain.Order) any { return o.ProductID }},
+	{name: "variant_id", value: func(o domain.Order) any { return o.VariantID }},
+	{name: "quantity", value: func(o domain.Order) any { return o.Quantity }},
+	{name: "subtotal", value: func(o domain.Order) any { return o.Subtotal.Decimal() }},
+	{name: "tax", value: func(o domain.Order) any { return o.Tax.Decimal() }},
+	{name: "total", value: func(o domain.Order) any { return o.Total.Decimal() }},
+	{name: "currency", value: func(o domain.Order) any { return o.Total.Currency }},
+	{name: "tax_region", value: func(o domain.Order) any { return o.TaxRegion }},
+	{name: "created_at", value: func(o domain.Order) any { return o.Date }},
+}
+
+// HandleExportOrders exports the orders the token can read, see HandleGetOrders.
+func (h *ReaderHandler) HandleExportOrders(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+
+	filter, err := parseOrderFilter(r, claims)
+	if err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, fmt.Sprintf("error parsing filters: %s", err))
+		return
+	}
+
+	export, err := newExporter(w, r, "orders", orderExportColumns, h.ExportTimeout)
+	if err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, fmt.Sprintf("error parsing export options: %s", err))
+		return
+	}
+
+	err = h.OrderService.ExportOrders(r.Context(), filter, export.write)
+	if err == nil {
+		err = export.finish()
+	}
+	if err != nil {
+		export.fail(err, "error exporting orders")
+		return
+	}
+}
+
+// parseOrderFilter reads product_id, limit and the from/to range, the dates can be
+// given as RFC 3339 timestamps or as plain dates (2006-01-02). The orders of a customer
+// token are restricted to its own, customer_id is only honored for admins.
+func parseOrderFilter(request *http.Request, claims auth.TokenClaims) (domain.OrderFilter, error) {
+	filter := domain.OrderFilter{CustomerID: claims.CustomerScope()}
+	query := request.URL.Query()
+
+	if customerID := query.Get("customer_id"); customerID != "" && claims.IsAdmin() {
+		if _, err := uuid.Parse(customerID); err != nil {
+			return filter, fmt.Errorf("customer_id must be UUID")
+		}
+		filter.CustomerID = customerID
+	}
+
+	if productID := query.Get("product_id"); productID != "" {
+		if _, err := uuid.Parse(productID); err != nil {
+			return filter, fmt.Errorf("product_id must be UUID")
+		}
+		filter.ProductID = productID
+	}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return filter, fmt.Errorf("limit must be a positive integer")
+		}
+		filter.Limit = limit
+	}
+
+	for _, param := range []struct {
+		name string
+		dst  **time.Time
+	}{{"from", &filter.From}, {"to", &filter.To}} {
+		value := query.Get(param.name)
+		if value == "" {
+			continue
+		}
+		date, err := parseFilterDate(value)
+		if err != nil {
+			return filter, fmt.Errorf("%s must be a RFC 3339 timestamp or a 2006-01-02 date", param.name)
+		}
+		*param.dst = &date
+	}
+
+	return filter, nil
+}
+
+func parseFilterDate(value string) (time.Time, error) {
+	if date, err := time.Parse(time.RFC3339, value); err == nil {
+		return date, nil
+	}
+	return time.ParseInLocation(time.DateOnly, value, time.Local)
+}
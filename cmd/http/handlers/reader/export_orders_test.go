@@ -0,0 +1,108 @@
+package reader_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/handlers/reader"
+	"microservice-products-catalog/cmd/http/handlers/reader/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandleExportOrders(t *testing.T) {
+	productID := "076e76d6-fc3e-4f95-a024-1b4984e76060"
+	date := time.Date(2026, time.September, 30, 23, 59, 0, 0, time.UTC)
+	mockOrders := []domain.Order{
+		{ID: "18eb9153-a00c-466d-8f38-f149806b054e", ProductID: productID, Quantity: 3, Subtotal: domain.NewMoney(3330, domain.BaseCurrency), Tax: domain.NewMoney(333, domain.BaseCurrency), Total: domain.NewMoney(3663, domain.BaseCurrency), TaxRegion: "US-NY", Date: date},
+	}
+
+	from := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		request        *http.Request
+		setupMock      func(mock *mocks.MockOrderService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:    "Success - 200 CSV filtered by month and product",
+			request: withClaims(httptest.NewRequest(http.MethodGet, "/api/orders/export?from=2026-09-01&to=2026-10-01T00:00:00Z&product_id="+productID, nil), adminClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					ExportOrders(gomock.Any(), domain.OrderFilter{ProductID: productID, From: &from, To: &to}, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, filter domain.OrderFilter, fn func(domain.Order) error) error {
+						for _, order := range mockOrders {
+							if err := fn(order); err != nil {
+								return err
+							}
+						}
+						return nil
+					}).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: "id,customer_id,product_id,variant_id,quantity,subtotal,tax,total,currency,tax_region,created_at\n" +
+				"18eb9153-a00c-466d-8f38-f149806b054e,,076e76d6-fc3e-4f95-a024-1b4984e76060,,3,33.30,3.33,36.63,USD,US-NY,2026-09-30T23:59:00Z\n",
+		},
+		{
+			name:    "Success - 200 empty export keeps the header",
+			request: withClaims(httptest.NewRequest(http.MethodGet, "/api/orders/export?fields=id,total", nil), adminClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().ExportOrders(gomock.Any(), domain.OrderFilter{}, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "id,total\n",
+		},
+		{
+			name:    "Success - 200 customer token only exports its own orders",
+			request: withClaims(httptest.NewRequest(http.MethodGet, "/api/orders/export?fields=id", nil), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().ExportOrders(gomock.Any(), domain.OrderFilter{CustomerID: customerClaims.Subject}, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "id\n",
+		},
+		{
+			name:           "Failure - 400 invalid date",
+			request:        withClaims(httptest.NewRequest(http.MethodGet, "/api/orders/export?from=yesterday", nil), adminClaims),
+			setupMock:      func(mock *mocks.MockOrderService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"type":"about:blank","title":"Bad Request","status":400,"detail":"error parsing filters: from must be a RFC 3339 timestamp or a 2006-01-02 date","instance":"/api/orders/export"}` + "\n",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTokenGenerator := mocks.NewMockTokenGenerator(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockInventoryService := mocks.NewMockInventoryService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPricingService := mocks.NewMockPricingService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockOrderService)
+
+			readerHandler := reader.NewReaderHandler(mockProductService, mockOrderService, mockInventoryService, mockCategoryService, mockPricingService, mockPromotionService, mockCustomerService, mockCartService, mockTokenGenerator, nil)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			readerHandler.HandleExportOrders(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Equal(t, tc.expectedBody, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package reader
+
+import (
+	"fmt"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strconv"
+)
+
+var productExportColumns = []exportColumn[domain.Product]{
+	{name: "id", value: func(p domain.Product) any { return p.ID }},
+	{name: "name", value: func(p domain.Product) any { return p.Name }},
+	{name: "description", value: func(p domain.Product) any { return p.Description }},
+	{name: "price", value: func(p domain.Product) any { return p.Price.Decimal() }},
+	{name: "currency", value: func(p domain.Product) any { return p.Price.Currency }},
+	{name: "stock", value: func(p domain.Product) any { return p.Stock }},
+	{name: "reorder_point", value: func(p domain.Product) any { return p.ReorderPoint }},
+	{name: "reorder_quantity", value: func(p domain.Product) any { return p.ReorderQuantity }},
+	{name: "external_sku", value: func(p domain.Product) any { return p.ExternalSKU }},
+}
+
+func (h *ReaderHandler) HandleExportProducts(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseProductFilter(r)
+	if err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, fmt.Sprintf("error parsing filters: %s", err))
+		return
+	}
+
+	export, err := newExporter(w, r, "products", productExportColumns, h.ExportTimeout)
+	if err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, fmt.Sprintf("error parsing export options: %s", err))
+		return
+	}
+
+	err = h.ProductService.ExportProducts(r.Context(), filter, export.write)
+	if err == nil {
+		err = export.finish()
+	}
+	if err != nil {
+		export.fail(err, "error exporting products")
+		return
+	}
+}
+
+func parseProductFilter(request *http.Request) (domain.ProductFilter, error) {
+	var filter domain.ProductFilter
+
+	if limitStr := request.URL.Query().Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return filter, fmt.Errorf("limit must be a positive integer")
+		}
+		filter.Limit = limit
+	}
+	return filter, nil
+}
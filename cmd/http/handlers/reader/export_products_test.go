@@ -0,0 +1,191 @@
+package reader_test
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"microservice-products-catalog/cmd/http/handlers/reader"
+	"microservice-products-catalog/cmd/http/handlers/reader/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandleExportProducts(t *testing.T) {
+	sku := "GOPH-001"
+	mockProducts := []domain.Product{
+		{ID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Name: "Gopher", Description: "Realistic replic, for the Gopher animal", Price: domain.NewMoney(1221, domain.BaseCurrency), Stock: 50, ExternalSKU: &sku},
+		{ID: "a8b9c123-d456-7890-1234-56789abcdef0", Name: "Rusty", Description: "Realistic replic for the Rusty animal", Price: domain.NewMoney(2190, domain.BaseCurrency), Stock: 10},
+	}
+
+	streamProducts := func(ctx context.Context, filter domain.ProductFilter, fn func(domain.Product) error) error {
+		for _, product := range mockProducts {
+			if err := fn(product); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	testCases := []struct {
+		name                string
+		request             *http.Request
+		setupRequest        func(req *http.Request)
+		setupMock           func(mock *mocks.MockProductService)
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+		gzipped             bool
+	}{
+		{
+			name:    "Success - 200 CSV with every field",
+			request: httptest.NewRequest(http.MethodGet, "/api/products/export", nil),
+			setupMock: func(mock *mocks.MockProductService) {
+				mock.EXPECT().ExportProducts(gomock.Any(), domain.ProductFilter{}, gomock.Any()).DoAndReturn(streamProducts).Times(1)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody: "id,name,description,price,currency,stock,reorder_point,reorder_quantity,external_sku\n" +
+				"076e76d6-fc3e-4f95-a024-1b4984e76060,Gopher,\"Realistic replic, for the Gopher animal\",12.21,USD,50,0,0,GOPH-001\n" +
+				"a8b9c123-d456-7890-1234-56789abcdef0,Rusty,Realistic replic for the Rusty animal,21.90,USD,10,0,0,\n",
+		},
+		{
+			name:    "Success - 200 NDJSON with selected fields and limit",
+			request: httptest.NewRequest(http.MethodGet, "/api/products/export?format=ndjson&fields=name,stock&limit=2", nil),
+			setupMock: func(mock *mocks.MockProductService) {
+				mock.EXPECT().ExportProducts(gomock.Any(), domain.ProductFilter{Limit: 2}, gomock.Any()).DoAndReturn(streamProducts).Times(1)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/x-ndjson",
+			expectedBody:        "{\"name\":\"Gopher\",\"stock\":50}\n{\"name\":\"Rusty\",\"stock\":10}\n",
+		},
+		{
+			name:    "Success - 200 gzip when accepted",
+			request: httptest.NewRequest(http.MethodGet, "/api/products/export?fields=name", nil),
+			setupRequest: func(req *http.Request) {
+				req.Header.Set("Accept-Encoding", "br, gzip;q=0.8")
+			},
+			setupMock: func(mock *mocks.MockProductService) {
+				mock.EXPECT().ExportProducts(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(streamProducts).Times(1)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody:        "name\nGopher\nRusty\n",
+			gzipped:             true,
+		},
+		{
+			name:           "Failure - 400 unknown field",
+			request:        httptest.NewRequest(http.MethodGet, "/api/products/export?fields=name,cost", nil),
+			setupMock:      func(mock *mocks.MockProductService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"type":"about:blank","title":"Bad Request","status":400,"detail":"error parsing export options: unknown field \"cost\", must be one of: id, name, description, price, currency, stock, reorder_point, reorder_quantity, external_sku","instance":"/api/products/export"}` + "\n",
+		},
+		{
+			name:           "Failure - 400 unknown format",
+			request:        httptest.NewRequest(http.MethodGet, "/api/products/export?format=xlsx", nil),
+			setupMock:      func(mock *mocks.MockProductService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"type":"about:blank","title":"Bad Request","status":400,"detail":"error parsing export options: format must be one of: csv, ndjson","instance":"/api/products/export"}` + "\n",
+		},
+		{
+			name:    "Failure - 500 error before the first row",
+			request: httptest.NewRequest(http.MethodGet, "/api/products/export", nil),
+			setupMock: func(mock *mocks.MockProductService) {
+				mock.EXPECT().ExportProducts(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("database is down")).Times(1)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"error exporting products","instance":"/api/products/export"}` + "\n",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTokenGenerator := mocks.NewMockTokenGenerator(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockInventoryService := mocks.NewMockInventoryService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPricingService := mocks.NewMockPricingService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockProductService)
+
+			readerHandler := reader.NewReaderHandler(mockProductService, mockOrderService, mockInventoryService, mockCategoryService, mockPricingService, mockPromotionService, mockCustomerService, mockCartService, mockTokenGenerator, nil)
+			recorder := httptest.NewRecorder()
+			if tc.setupRequest != nil {
+				tc.setupRequest(tc.request)
+			}
+
+			// Act
+			readerHandler.HandleExportProducts(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			body := recorder.Body.String()
+			if tc.gzipped {
+				assert.Equal(t, "gzip", recorder.Header().Get("Content-Encoding"))
+				gz, err := gzip.NewReader(recorder.Body)
+				require.NoError(t, err)
+				raw, err := io.ReadAll(gz)
+				require.NoError(t, err)
+				body = string(raw)
+			}
+			assert.Equal(t, tc.expectedBody, body)
+			if tc.expectedContentType != "" {
+				assert.Equal(t, tc.expectedContentType, recorder.Header().Get("Content-Type"))
+				assert.Contains(t, recorder.Header().Get("Content-Disposition"), "attachment; filename=\"products-")
+			}
+		})
+	}
+}
+
+func TestHandleExportProducts_SlowExport(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	mockProductService := mocks.NewMockProductService(ctrl)
+	mockProductService.EXPECT().
+		ExportProducts(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ domain.ProductFilter, fn func(domain.Product) error) error {
+			for _, name := range []string{"Gopher", "Rusty", "Ferris"} {
+				time.Sleep(100 * time.Millisecond)
+				if err := fn(domain.Product{Name: name}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Times(1)
+
+	readerHandler := reader.NewReaderHandler(mockProductService, mocks.NewMockOrderService(ctrl), mocks.NewMockInventoryService(ctrl), mocks.NewMockCategoryService(ctrl), mocks.NewMockPricingService(ctrl), mocks.NewMockPromotionService(ctrl), mocks.NewMockCustomerService(ctrl), mocks.NewMockCartService(ctrl), mocks.NewMockTokenGenerator(ctrl), nil)
+	readerHandler.ExportTimeout = 5 * time.Second
+
+	// the rows take longer to be produced than the server write timeout
+	server := httptest.NewUnstartedServer(http.HandlerFunc(readerHandler.HandleExportProducts))
+	server.Config.WriteTimeout = 100 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	// Act
+	response, err := http.Get(server.URL + "/api/products/export?fields=name")
+
+	// Assert
+	require.NoError(t, err)
+	defer response.Body.Close()
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "name\nGopher\nRusty\nFerris\n", string(body))
+}
@@ -0,0 +1,38 @@
+package reader
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+	"strings"
+)
+
+// HandleGetCart serves GET /api/carts/{id} with the current price and stock of the items, the
+// carts of other customers are not found unless the token is an admin one.
+func (h *ReaderHandler) HandleGetCart(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+
+	cartID := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/carts/"), "/")
+	if _, err := uuid.Parse(cartID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid cart id format, must be UUID")
+		return
+	}
+
+	view, err := h.CartService.GetCart(r.Context(), cartID, claims.CustomerScope())
+	if err != nil {
+		problem.Error(w, r, "error fetching cart", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(view); err != nil {
+		return
+	}
+}
@@ -0,0 +1,24 @@
+package reader
+
+import (
+	"encoding/json"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+)
+
+// HandleGetCategories renders the whole category tree, roots first and every
+// category nested under its parent.
+func (h *ReaderHandler) HandleGetCategories(w http.ResponseWriter, r *http.Request) {
+	tree, err := h.CategoryService.GetCategoryTree(r.Context())
+	if err != nil {
+		problem.Error(w, r, "error fetching categories", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(tree); err != nil {
+		return
+	}
+}
@@ -0,0 +1,31 @@
+package reader
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+	"strings"
+)
+
+func (h *ReaderHandler) HandleGetCategoryByID(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	categoryID := parts[len(parts)-1]
+	if _, err := uuid.Parse(categoryID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid category id format, must be UUID")
+		return
+	}
+
+	category, err := h.CategoryService.GetCategoryByID(r.Context(), categoryID)
+	if err != nil {
+		problem.Error(w, r, "error fetching category", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(category); err != nil {
+		return
+	}
+}
@@ -0,0 +1,56 @@
+package reader
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// HandleGetCategoryProducts serves GET /api/categories/{id}/products, the products of
+// the subcategories are included with ?include_descendants=true.
+func (h *ReaderHandler) HandleGetCategoryProducts(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	if len(parts) < 2 {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid category id")
+		return
+	}
+
+	categoryID := parts[len(parts)-2]
+	if _, err := uuid.Parse(categoryID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid category id format, must be UUID")
+		return
+	}
+
+	includeDescendants := false
+	if value := r.URL.Query().Get("include_descendants"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			problem.WriteStatus(w, r, http.StatusBadRequest, "include_descendants must be a boolean")
+			return
+		}
+		includeDescendants = parsed
+	}
+
+	limit, err := parseLimit(r)
+	if err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, fmt.Sprintf("error parsing limit: %s", err))
+		return
+	}
+
+	products, err := h.CategoryService.GetCategoryProducts(r.Context(), categoryID, includeDescendants, limit)
+	if err != nil {
+		problem.Error(w, r, "error fetching category products", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(products); err != nil {
+		return
+	}
+}
@@ -0,0 +1,123 @@
+package reader_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/cmd/http/handlers/reader"
+	"microservice-products-catalog/cmd/http/handlers/reader/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleGetCategoryProducts(t *testing.T) {
+	categoryID := uuid.New().String()
+	mockProducts := []domain.Product{
+		{ID: uuid.New().String(), Name: "Gopher", Description: "Realistic replic", Price: domain.NewMoney(1221, domain.BaseCurrency), Stock: 50},
+	}
+	path := fmt.Sprintf("/api/categories/%s/products", categoryID)
+
+	testCases := []struct {
+		name                 string
+		setupMock            func(mock *mocks.MockCategoryService)
+		request              *http.Request
+		expectedStatus       int
+		expectedBodyContains string
+		expectedJSONResponse []domain.Product
+	}{
+		{
+			name: "Success - 200 direct products by default",
+			setupMock: func(mock *mocks.MockCategoryService) {
+				mock.EXPECT().GetCategoryProducts(gomock.Any(), categoryID, false, 10).Return(mockProducts, nil).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodGet, path, nil),
+			expectedStatus:       http.StatusOK,
+			expectedJSONResponse: mockProducts,
+		},
+		{
+			name: "Success - 200 including descendants",
+			setupMock: func(mock *mocks.MockCategoryService) {
+				mock.EXPECT().GetCategoryProducts(gomock.Any(), categoryID, true, 5).Return(mockProducts, nil).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodGet, path+"?include_descendants=true&limit=5", nil),
+			expectedStatus:       http.StatusOK,
+			expectedJSONResponse: mockProducts,
+		},
+		{
+			name:                 "Failure - 400 invalid include_descendants",
+			setupMock:            func(mock *mocks.MockCategoryService) {},
+			request:              httptest.NewRequest(http.MethodGet, path+"?include_descendants=maybe", nil),
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: "include_descendants must be a boolean",
+		},
+		{
+			name:                 "Failure - 400 invalid category id",
+			setupMock:            func(mock *mocks.MockCategoryService) {},
+			request:              httptest.NewRequest(http.MethodGet, "/api/categories/-1/products", nil),
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: "invalid category id format, must be UUID",
+		},
+		{
+			name: "Failure - 404 category not found",
+			setupMock: func(mock *mocks.MockCategoryService) {
+				mock.EXPECT().GetCategoryProducts(gomock.Any(), categoryID, false, 10).Return(nil, domain.ErrCategoryNotFound).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodGet, path, nil),
+			expectedStatus:       http.StatusNotFound,
+			expectedBodyContains: "category not found",
+		},
+		{
+			name: "Failure - 500 Internal Server Error",
+			setupMock: func(mock *mocks.MockCategoryService) {
+				mock.EXPECT().GetCategoryProducts(gomock.Any(), categoryID, false, 10).Return(nil, errors.New("database is down")).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodGet, path, nil),
+			expectedStatus:       http.StatusInternalServerError,
+			expectedBodyContains: "error fetching category products",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTokenGenerator := mocks.NewMockTokenGenerator(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockInventoryService := mocks.NewMockInventoryService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPricingService := mocks.NewMockPricingService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockCategoryService)
+
+			readerHandler := reader.NewReaderHandler(mockProductService, mockOrderService, mockInventoryService, mockCategoryService, mockPricingService, mockPromotionService, mockCustomerService, mockCartService, mockTokenGenerator, nil)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			readerHandler.HandleGetCategoryProducts(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedBodyContains != "" {
+				assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+			}
+			if tc.expectedJSONResponse != nil {
+				expectedJSON, err := json.Marshal(tc.expectedJSONResponse)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(expectedJSON), recorder.Body.String())
+				assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package reader
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// HandleGetCustomers lists every customer, it is reserved to admin tokens.
+func (h *ReaderHandler) HandleGetCustomers(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+	if !claims.IsAdmin() {
+		problem.WriteStatus(w, r, http.StatusForbidden, "listing customers requires the admin scope")
+		return
+	}
+
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		var err error
+		if limit, err = strconv.Atoi(limitStr); err != nil || limit <= 0 {
+			problem.WriteStatus(w, r, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+	}
+
+	customers, err := h.CustomerService.GetCustomers(r.Context(), limit)
+	if err != nil {
+		problem.Error(w, r, "error fetching customers", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(customers); err != nil {
+		return
+	}
+}
+
+// HandleGetCustomerByID serves GET /api/customers/{id}, a customer can only read itself.
+func (h *ReaderHandler) HandleGetCustomerByID(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := authorizeCustomerPath(w, r, 1)
+	if !ok {
+		return
+	}
+
+	customer, err := h.CustomerService.GetCustomerByID(r.Context(), customerID)
+	if err != nil {
+		problem.Error(w, r, "error fetching customer", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(customer); err != nil {
+		return
+	}
+}
+
+// HandleGetCustomerOrders serves GET /api/customers/{id}/orders with the filters of HandleGetOrders.
+func (h *ReaderHandler) HandleGetCustomerOrders(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := authorizeCustomerPath(w, r, 2)
+	if !ok {
+		return
+	}
+
+	// the customer is looked up so an unknown one is a 404 and not an empty list
+	if _, err := h.CustomerService.GetCustomerByID(r.Context(), customerID); err != nil {
+		problem.Error(w, r, "error fetching customer orders", err)
+		return
+	}
+
+	claims, _ := auth.ClaimsFromContext(r.Context())
+	filter, err := parseOrderFilter(r, claims)
+	if err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, fmt.Sprintf("error parsing filters: %s", err))
+		return
+	}
+	filter.CustomerID = customerID
+
+	orders, err := h.OrderService.GetOrders(r.Context(), filter)
+	if err != nil {
+		problem.Error(w, r, "error fetching customer orders", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(orders); err != nil {
+		return
+	}
+}
+
+// authorizeCustomerPath reads the customer id of /api/customers/{id}[/...], fromEnd is the
+// position of the id counted from the end of the path. Tokens of other customers get a 403.
+func authorizeCustomerPath(w http.ResponseWriter, r *http.Request, fromEnd int) (string, bool) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return "", false
+	}
+
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	if len(parts) < fromEnd {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid customer path")
+		return "", false
+	}
+
+	customerID := parts[len(parts)-fromEnd]
+	if _, err := uuid.Parse(customerID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid customer id format, must be UUID")
+		return "", false
+	}
+	if !claims.CanAccessCustomer(customerID) {
+		problem.WriteStatus(w, r, http.StatusForbidden, "the token cannot access this customer")
+		return "", false
+	}
+	return customerID, true
+}
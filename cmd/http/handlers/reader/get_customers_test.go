@@ -0,0 +1,114 @@
+package reader_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/handlers/reader"
+	"microservice-products-catalog/cmd/http/handlers/reader/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleGetCustomerOrders(t *testing.T) {
+	customerID := customerClaims.Subject
+	orders := []domain.Order{{ID: "18eb9153-a00c-466d-8f38-f149806b054e", CustomerID: &customerID, Quantity: 1}}
+
+	testCases := []struct {
+		name                 string
+		request              *http.Request
+		setupMock            func(customers *mocks.MockCustomerService, orders *mocks.MockOrderService)
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name:    "Success - 200 customer reads its own orders",
+			request: withClaims(httptest.NewRequest(http.MethodGet, "/api/customers/"+customerID+"/orders", nil), customerClaims),
+			setupMock: func(customers *mocks.MockCustomerService, o *mocks.MockOrderService) {
+				customers.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).Times(1)
+				o.EXPECT().GetOrders(gomock.Any(), domain.OrderFilter{CustomerID: customerID}).Return(orders, nil).Times(1)
+			},
+			expectedStatus:       http.StatusOK,
+			expectedBodyContains: `"customer_id":"` + customerID + `"`,
+		},
+		{
+			name:    "Success - 200 admin reads the orders of any customer",
+			request: withClaims(httptest.NewRequest(http.MethodGet, "/api/customers/"+customerID+"/orders?limit=5", nil), adminClaims),
+			setupMock: func(customers *mocks.MockCustomerService, o *mocks.MockOrderService) {
+				customers.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).Times(1)
+				o.EXPECT().GetOrders(gomock.Any(), domain.OrderFilter{CustomerID: customerID, Limit: 5}).Return(orders, nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:                 "Failure - 403 orders of another customer",
+			request:              withClaims(httptest.NewRequest(http.MethodGet, "/api/customers/"+adminClaims.Subject+"/orders", nil), customerClaims),
+			setupMock:            func(customers *mocks.MockCustomerService, o *mocks.MockOrderService) {},
+			expectedStatus:       http.StatusForbidden,
+			expectedBodyContains: "the token cannot access this customer",
+		},
+		{
+			name:    "Failure - 404 customer not found",
+			request: withClaims(httptest.NewRequest(http.MethodGet, "/api/customers/"+customerID+"/orders", nil), customerClaims),
+			setupMock: func(customers *mocks.MockCustomerService, o *mocks.MockOrderService) {
+				customers.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(nil, domain.ErrCustomerNotFound).Times(1)
+			},
+			expectedStatus:       http.StatusNotFound,
+			expectedBodyContains: domain.ErrCustomerNotFound.Error(),
+		},
+		{
+			name:                 "Failure - 400 invalid customer id",
+			request:              withClaims(httptest.NewRequest(http.MethodGet, "/api/customers/me/orders", nil), customerClaims),
+			setupMock:            func(customers *mocks.MockCustomerService, o *mocks.MockOrderService) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: "invalid customer id format, must be UUID",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTokenGenerator := mocks.NewMockTokenGenerator(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockInventoryService := mocks.NewMockInventoryService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPricingService := mocks.NewMockPricingService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockCustomerService, mockOrderService)
+
+			readerHandler := reader.NewReaderHandler(mockProductService, mockOrderService, mockInventoryService, mockCategoryService, mockPricingService, mockPromotionService, mockCustomerService, mockCartService, mockTokenGenerator, nil)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			readerHandler.HandleGetCustomerOrders(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+		})
+	}
+}
+
+func TestHandleGetCustomers_RequiresAdmin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCustomerService := mocks.NewMockCustomerService(ctrl)
+	readerHandler := reader.NewReaderHandler(nil, nil, nil, nil, nil, nil, mockCustomerService, nil, nil, nil)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	readerHandler.HandleGetCustomers(recorder, withClaims(httptest.NewRequest(http.MethodGet, "/api/customers", nil), customerClaims))
+
+	// Assert
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
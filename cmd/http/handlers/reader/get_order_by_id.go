@@ -0,0 +1,39 @@
+package reader
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+	"strings"
+)
+
+// HandleGetOrderByID serves GET /api/orders/{id}, the orders of other customers are not found
+// unless the token is an admin one.
+func (h *ReaderHandler) HandleGetOrderByID(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	orderID := parts[len(parts)-1]
+	if _, err := uuid.Parse(orderID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid order id format, must be UUID")
+		return
+	}
+
+	order, err := h.OrderService.GetOrderByID(r.Context(), orderID, claims.CustomerScope())
+	if err != nil {
+		problem.Error(w, r, "error fetching order", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(order); err != nil {
+		return
+	}
+}
@@ -0,0 +1,103 @@
+package reader
+
+import (
+	"bytes"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/infraestructure/invoice"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// HandleGetOrderInvoice serves GET /api/orders/{id}/invoice as HTML or plain text following the
+// Accept header, HTML when the client accepts both. The orders of other customers are not
+// found unless the token is an admin one.
+func (h *ReaderHandler) HandleGetOrderInvoice(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+
+	// /api/orders/{id}/invoice
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	orderID := parts[len(parts)-2]
+	if _, err := uuid.Parse(orderID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid order id format, must be UUID")
+		return
+	}
+
+	w.Header().Set("Vary", "Accept")
+	mediaType, ok := negotiateInvoiceType(r.Header.Get("Accept"))
+	if !ok {
+		problem.WriteStatus(w, r, http.StatusNotAcceptable, "the invoice is only available as text/html or text/plain")
+		return
+	}
+
+	orderInvoice, err := h.OrderService.GetInvoice(r.Context(), orderID, claims.CustomerScope())
+	if err != nil {
+		problem.Error(w, r, "error fetching invoice", err)
+		return
+	}
+
+	// rendered before the status is sent, so a template error is still a 500
+	var body bytes.Buffer
+	if err := h.InvoiceRenderer.Render(&body, mediaType, *orderInvoice); err != nil {
+		problem.Error(w, r, "error rendering invoice", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", mediaType+"; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = body.WriteTo(w)
+}
+
+// negotiateInvoiceType picks the invoice media type for the Accept header, HTML when both are
+// accepted with the same quality. The second result is false when neither is acceptable.
+func negotiateInvoiceType(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return invoice.MediaTypeHTML, true
+	}
+
+	best, bestQuality := "", 0.0
+	for _, mediaType := range []string{invoice.MediaTypeHTML, invoice.MediaTypeText} {
+		if quality := acceptQuality(accept, mediaType); quality > bestQuality {
+			best, bestQuality = mediaType, quality
+		}
+	}
+	return best, bestQuality > 0
+}
+
+// acceptQuality is the quality of the most specific range of the Accept header matching mediaType.
+func acceptQuality(accept string, mediaType string) float64 {
+	quality, specificity := 0.0, -1
+	for _, part := range strings.Split(accept, ",") {
+		fields := strings.Split(part, ";")
+		mediaRange := strings.ToLower(strings.TrimSpace(fields[0]))
+
+		match := -1
+		switch {
+		case mediaRange == mediaType:
+			match = 2
+		case strings.HasSuffix(mediaRange, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(mediaRange, "*")):
+			match = 1
+		case mediaRange == "*/*":
+			match = 0
+		}
+		if match <= specificity {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(param), "q="); ok {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+		quality, specificity = q, match
+	}
+	return quality
+}
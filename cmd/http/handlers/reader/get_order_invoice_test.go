@@ -0,0 +1,118 @@
+package reader_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"microservice-products-catalog/cmd/http/handlers/reader"
+	"microservice-products-catalog/cmd/http/handlers/reader/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleGetOrderInvoice(t *testing.T) {
+	orderID := "18eb9153-a00c-466d-8f38-f149806b054e"
+	path := "/api/orders/" + orderID + "/invoice"
+	invoice := &domain.Invoice{Number: "INV-000042"}
+
+	request := func(accept string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		return req
+	}
+
+	testCases := []struct {
+		name                string
+		accept              string
+		setupMock           func(orders *mocks.MockOrderService, renderer *mocks.MockInvoiceRenderer)
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name: "Success - 200 HTML without Accept",
+			setupMock: func(orders *mocks.MockOrderService, renderer *mocks.MockInvoiceRenderer) {
+				orders.EXPECT().GetInvoice(gomock.Any(), orderID, customerClaims.Subject).Return(invoice, nil).Times(1)
+				renderer.EXPECT().Render(gomock.Any(), "text/html", *invoice).DoAndReturn(render("<h1>INV-000042</h1>")).Times(1)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedBody:        "<h1>INV-000042</h1>",
+		},
+		{
+			name:   "Success - 200 plain text preferred by quality",
+			accept: "text/html;q=0.5, text/plain",
+			setupMock: func(orders *mocks.MockOrderService, renderer *mocks.MockInvoiceRenderer) {
+				orders.EXPECT().GetInvoice(gomock.Any(), orderID, customerClaims.Subject).Return(invoice, nil).Times(1)
+				renderer.EXPECT().Render(gomock.Any(), "text/plain", *invoice).DoAndReturn(render("INVOICE INV-000042")).Times(1)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/plain; charset=utf-8",
+			expectedBody:        "INVOICE INV-000042",
+		},
+		{
+			name:   "Success - 200 HTML for a browser Accept",
+			accept: "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			setupMock: func(orders *mocks.MockOrderService, renderer *mocks.MockInvoiceRenderer) {
+				orders.EXPECT().GetInvoice(gomock.Any(), orderID, customerClaims.Subject).Return(invoice, nil).Times(1)
+				renderer.EXPECT().Render(gomock.Any(), "text/html", *invoice).DoAndReturn(render("<h1>INV-000042</h1>")).Times(1)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedBody:        "<h1>INV-000042</h1>",
+		},
+		{
+			name:           "Failure - 406 neither HTML nor text",
+			accept:         "application/pdf, text/html;q=0",
+			setupMock:      func(orders *mocks.MockOrderService, renderer *mocks.MockInvoiceRenderer) {},
+			expectedStatus: http.StatusNotAcceptable,
+			expectedBody:   "the invoice is only available as text/html or text/plain",
+		},
+		{
+			name: "Failure - 404 order without invoice",
+			setupMock: func(orders *mocks.MockOrderService, renderer *mocks.MockInvoiceRenderer) {
+				orders.EXPECT().GetInvoice(gomock.Any(), orderID, customerClaims.Subject).Return(nil, domain.ErrInvoiceNotFound).Times(1)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   domain.ErrInvoiceNotFound.Error(),
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockInvoiceRenderer := mocks.NewMockInvoiceRenderer(ctrl)
+			tc.setupMock(mockOrderService, mockInvoiceRenderer)
+
+			readerHandler := reader.NewReaderHandler(nil, mockOrderService, nil, nil, nil, nil, nil, nil, nil, mockInvoiceRenderer)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			readerHandler.HandleGetOrderInvoice(recorder, withClaims(request(tc.accept), customerClaims))
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tc.expectedBody)
+			if tc.expectedContentType != "" {
+				assert.Equal(t, tc.expectedContentType, recorder.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func render(body string) func(w io.Writer, mediaType string, invoice domain.Invoice) error {
+	return func(w io.Writer, _ string, _ domain.Invoice) error {
+		_, err := io.WriteString(w, body)
+		return err
+	}
+}
@@ -5,47 +5,43 @@ import (
 	"fmt"
 	"github.com/google/uuid"
 	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/problem"
 	"net/http"
 )
 
+// HandleGetOrders lists the orders of the authenticated customer, admins see the orders of
+// every customer and can narrow them with customer_id.
 func (h *ReaderHandler) HandleGetOrders(w http.ResponseWriter, r *http.Request) {
-	/*if r.Method != http.MethodGet {
-		w.Header().Set("Allow", http.MethodGet)
-		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
 		return
-	}*/
-
-	/*token := r.Header.Get("Authorization")
-	if token == "" {
-		w.WriteHeader(http.StatusUnauthorized)
-		_, err := w.Write([]byte("Header Authorization is required"))
-		if err != nil {
-			return
-		}
-		return
-	}*/
+	}
 
 	// nextCursor := r.URL.Query().Get("next_cursor") // TODO implement pagination
 
-	products, err := h.OrderService.GetOrders(r.Context())
+	filter, err := parseOrderFilter(r, claims)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		_, err = w.Write([]byte(fmt.Sprintf("error getting products: %s", err)))
-		if err != nil {
-			return
-		}
+		problem.WriteStatus(w, r, http.StatusBadRequest, fmt.Sprintf("error parsing filters: %s", err))
+		return
+	}
+
+	products, err := h.OrderService.GetOrders(r.Context(), filter)
+	if err != nil {
+		problem.Error(w, r, "error fetching orders", err)
 		return
 	}
 
+	// the refreshed token keeps the subject and the scopes of the one the request came with
 	tok, err := h.TokenGenerator.Generate(
 		r.Context(),
 		auth.TokenClaims{
-			Scope:     "orders:read",
+			Subject:   claims.Subject,
+			Scope:     claims.Scope,
 			RequestID: uuid.NewString(),
 		},
 	)
 	if err != nil {
-		http.Error(w, "error generating token", http.StatusInternalServerError)
+		problem.WriteStatus(w, r, http.StatusInternalServerError, "error generating token")
 		return
 	}
 
@@ -0,0 +1,67 @@
+package reader
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+	"strings"
+)
+
+// HandleGetPriceHistory serves GET /api/products/{id}/price-history, the latest change first.
+func (h *ReaderHandler) HandleGetPriceHistory(w http.ResponseWriter, r *http.Request) {
+	productID, ok := parseProductSubresourcePath(w, r)
+	if !ok {
+		return
+	}
+
+	history, err := h.ProductService.GetPriceHistory(r.Context(), productID)
+	if err != nil {
+		problem.Error(w, r, "error fetching price history", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(history); err != nil {
+		return
+	}
+}
+
+// HandleGetScheduledPrices serves GET /api/products/{id}/scheduled-prices.
+func (h *ReaderHandler) HandleGetScheduledPrices(w http.ResponseWriter, r *http.Request) {
+	productID, ok := parseProductSubresourcePath(w, r)
+	if !ok {
+		return
+	}
+
+	scheduledPrices, err := h.ProductService.GetScheduledPrices(r.Context(), productID)
+	if err != nil {
+		problem.Error(w, r, "error fetching scheduled prices", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(scheduledPrices); err != nil {
+		return
+	}
+}
+
+// parseProductSubresourcePath reads the product id of /api/products/{id}/{subresource}.
+func parseProductSubresourcePath(w http.ResponseWriter, r *http.Request) (string, bool) {
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	if len(parts) < 2 {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product path")
+		return "", false
+	}
+
+	productID := parts[len(parts)-2]
+	if _, err := uuid.Parse(productID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product id format, must be UUID")
+		return "", false
+	}
+	return productID, true
+}
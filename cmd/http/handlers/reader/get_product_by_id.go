@@ -2,9 +2,8 @@ package reader
 
 import (
 	"encoding/json"
-	"errors"
-	"fmt"
 	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/problem"
 	"microservice-products-catalog/internal/domain"
 	"net/http"
 	"strings"
@@ -13,43 +12,40 @@ import (
 func (h *ReaderHandler) HandleGetProductByID(w http.ResponseWriter, r *http.Request) {
 	parts := strings.Split(r.URL.Path, "/")
 	if len(parts) < 3 {
-		//http.Error(w, "invalid product id", http.StatusBadRequest)
-		//return
-		w.WriteHeader(http.StatusBadRequest)
-		_, err := w.Write([]byte(fmt.Sprintf("error reading body")))
-		if err != nil {
-			return
-		}
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product path")
 		return
 	}
 
 	productID := parts[len(parts)-1]
 	if productID == "" {
-		http.Error(w, "invalid product id", http.StatusBadRequest)
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product id")
 		return
 	}
 
 	if _, err := uuid.Parse(productID); err != nil {
-		http.Error(w, "invalid product id format, must be UUID", http.StatusBadRequest)
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product id format, must be UUID")
+		return
+	}
+
+	currency, err := parseCurrency(r)
+	if err != nil {
+		problem.Error(w, r, "error parsing currency", err)
 		return
 	}
 
 	product, err := h.ProductService.GetProductByID(r.Context(), productID)
 	if err != nil {
-		if errors.Is(domain.ErrProductNotFound, err) {
-			w.WriteHeader(http.StatusNotFound)
-			_, err := w.Write([]byte(fmt.Sprintf("error fetching product: %s", err.Error())))
-			if err != nil {
-				return
-			}
-			return
-		}
-		w.WriteHeader(http.StatusInternalServerError)
-		_, err := w.Write([]byte(fmt.Sprintf("error fetching product: %s", err.Error())))
-		if err != nil {
+		problem.Error(w, r, "error fetching product", err)
+		return
+	}
+
+	if currency != "" {
+		products := []domain.Product{*product}
+		if err := h.PricingService.ConvertProducts(r.Context(), products, currency); err != nil {
+			problem.Error(w, r, "error converting prices", err)
 			return
 		}
-		return
+		product = &products[0]
 	}
 
 	w.Header().Set("Content-Type", "application/json")
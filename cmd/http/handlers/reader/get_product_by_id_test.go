@@ -76,7 +76,7 @@ func TestHandleGetProductByID(t *testing.T) {
 				nil,
 			),
 			expectedStatus:       http.StatusNotFound,
-			expectedBodyContains: "error fetching product",
+			expectedBodyContains: `"type":"/problems/product-not-found"`,
 		},
 		{
 			name: "Failure - 500 Internal Server Error",
@@ -112,6 +112,12 @@ func TestHandleGetProductByID(t *testing.T) {
 
 			mockOrderService := mocks.NewMockOrderService(ctrl)
 			mockProductService := mocks.NewMockProductService(ctrl)
+			mockInventoryService := mocks.NewMockInventoryService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPricingService := mocks.NewMockPricingService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockProductService)
@@ -120,7 +126,14 @@ func TestHandleGetProductByID(t *testing.T) {
 			handler := reader.NewReaderHandler(
 				mockProductService,
 				mockOrderService,
+				mockInventoryService,
+				mockCategoryService,
+				mockPricingService,
+				mockPromotionService,
+				mockCustomerService,
+				mockCartService,
 				mockTokenGenerator,
+				nil,
 			)
 
 			recorder := httptest.NewRecorder()
@@ -2,9 +2,12 @@ package reader
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
 	"net/http"
 	"strconv"
 )
@@ -30,11 +33,13 @@ func (h *ReaderHandler) HandleGetProducts(w http.ResponseWriter, r *http.Request
 
 	limit, err := parseLimit(r)
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		_, err = w.Write([]byte(fmt.Sprintf("error parsing limit: %s", err)))
-		if err != nil {
-			return
-		}
+		problem.WriteStatus(w, r, http.StatusBadRequest, fmt.Sprintf("error parsing limit: %s", err))
+		return
+	}
+
+	currency, err := parseCurrency(r)
+	if err != nil {
+		problem.Error(w, r, "error parsing currency", err)
 		return
 	}
 
@@ -42,12 +47,15 @@ func (h *ReaderHandler) HandleGetProducts(w http.ResponseWriter, r *http.Request
 
 	products, err := h.ProductService.GetProducts(r.Context(), limit)
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		_, err = w.Write([]byte(fmt.Sprintf("error getting products: %s", err)))
-		if err != nil {
+		problem.Error(w, r, "error fetching products", err)
+		return
+	}
+
+	if currency != "" {
+		if err := h.PricingService.ConvertProducts(r.Context(), products, currency); err != nil {
+			problem.Error(w, r, "error converting prices", err)
 			return
 		}
-		return
 	}
 
 	tok, err := h.TokenGenerator.Generate(
@@ -58,7 +66,7 @@ func (h *ReaderHandler) HandleGetProducts(w http.ResponseWriter, r *http.Request
 		},
 	)
 	if err != nil {
-		http.Error(w, "error generating token", http.StatusInternalServerError)
+		problem.WriteStatus(w, r, http.StatusInternalServerError, "error generating token")
 		return
 	}
 
@@ -90,12 +98,22 @@ func parseLimit(request *http.Request) (int, error) {
 
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil {
-		return 0, fmt.Errorf("limit must be an integer: %w", err)
+		return 0, errors.New("limit must be an integer")
 	}
 
 	if limit <= 0 {
-		return 0, fmt.Errorf("limit must be a positive number")
+		return 0, errors.New("limit must be a positive number")
 	}
 
 	return limit, nil
 }
+
+// parseCurrency reads the optional currency query parameter, empty means the prices are
+// returned in the currency they are stored in.
+func parseCurrency(request *http.Request) (string, error) {
+	currency := request.URL.Query().Get("currency")
+	if currency == "" {
+		return "", nil
+	}
+	return domain.ParseCurrency(currency)
+}
@@ -0,0 +1,42 @@
+package reader
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+	"strings"
+)
+
+// HandleGetPromotionByID requires an admin token, see HandleGetPromotions.
+func (h *ReaderHandler) HandleGetPromotionByID(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+	if !claims.IsAdmin() {
+		problem.WriteStatus(w, r, http.StatusForbidden, "promotions require an admin token")
+		return
+	}
+
+	parts := strings.Split(r.URL.Path, "/")
+	promotionID := parts[len(parts)-1]
+	if _, err := uuid.Parse(promotionID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid promotion id format, must be UUID")
+		return
+	}
+
+	promotion, err := h.PromotionService.GetPromotionByID(r.Context(), promotionID)
+	if err != nil {
+		problem.Error(w, r, "error fetching promotion", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(promotion); err != nil {
+		return
+	}
+}
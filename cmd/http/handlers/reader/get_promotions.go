@@ -0,0 +1,34 @@
+package reader
+
+import (
+	"encoding/json"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+)
+
+// HandleGetPromotions lists the promotions with their coupon codes, an admin token is
+// required so the codes are not public.
+func (h *ReaderHandler) HandleGetPromotions(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+	if !claims.IsAdmin() {
+		problem.WriteStatus(w, r, http.StatusForbidden, "promotions require an admin token")
+		return
+	}
+
+	promotions, err := h.PromotionService.GetPromotions(r.Context())
+	if err != nil {
+		problem.Error(w, r, "error fetching promotions", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	if err := json.NewEncoder(w).Encode(promotions); err != nil {
+		return
+	}
+}
@@ -0,0 +1,79 @@
+package reader_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/handlers/reader"
+	"microservice-products-catalog/cmd/http/handlers/reader/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleGetPromotions(t *testing.T) {
+	code := "SUMMER10"
+	mockPromotions := []domain.Promotion{{ID: "8a7b6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d", Name: "Summer", Type: domain.PromotionPercentage, CouponCode: &code, Active: true}}
+
+	testCases := []struct {
+		name                 string
+		setupMock            func(mock *mocks.MockPromotionService)
+		request              *http.Request
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name: "Success - 200 admin lists the coupon codes",
+			setupMock: func(mock *mocks.MockPromotionService) {
+				mock.EXPECT().GetPromotions(gomock.Any()).Return(mockPromotions, nil).Times(1)
+			},
+			request:              withClaims(httptest.NewRequest(http.MethodGet, "/api/promotions", nil), adminClaims),
+			expectedStatus:       http.StatusOK,
+			expectedBodyContains: `"coupon_code":"SUMMER10"`,
+		},
+		{
+			name:                 "Failure - 401 without token",
+			setupMock:            func(mock *mocks.MockPromotionService) {},
+			request:              httptest.NewRequest(http.MethodGet, "/api/promotions", nil),
+			expectedStatus:       http.StatusUnauthorized,
+			expectedBodyContains: "Header Authorization is required",
+		},
+		{
+			name:                 "Failure - 403 customer token",
+			setupMock:            func(mock *mocks.MockPromotionService) {},
+			request:              withClaims(httptest.NewRequest(http.MethodGet, "/api/promotions", nil), customerClaims),
+			expectedStatus:       http.StatusForbidden,
+			expectedBodyContains: "promotions require an admin token",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTokenGenerator := mocks.NewMockTokenGenerator(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockInventoryService := mocks.NewMockInventoryService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPricingService := mocks.NewMockPricingService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockPromotionService)
+
+			readerHandler := reader.NewReaderHandler(mockProductService, mockOrderService, mockInventoryService, mockCategoryService, mockPricingService, mockPromotionService, mockCustomerService, mockCartService, mockTokenGenerator, nil)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			readerHandler.HandleGetPromotions(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+		})
+	}
+}
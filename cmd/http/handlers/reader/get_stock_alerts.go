@@ -0,0 +1,48 @@
+package reader
+
+import (
+	"encoding/json"
+	"fmt"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+)
+
+func (h *ReaderHandler) HandleGetStockAlerts(w http.ResponseWriter, r *http.Request) {
+	onlyOpen, err := parseAlertStatus(r)
+	if err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, fmt.Sprintf("error parsing status: %s", err))
+		return
+	}
+
+	alerts, err := h.InventoryService.GetStockAlerts(r.Context(), onlyOpen)
+	if err != nil {
+		problem.Error(w, r, "error fetching stock alerts", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	alertsResponse, err := json.Marshal(alerts)
+	if err != nil {
+		_, err = w.Write([]byte(err.Error()))
+		if err != nil {
+			return
+		}
+	}
+	_, err = w.Write(alertsResponse)
+	if err != nil {
+		return
+	}
+}
+
+// parseAlertStatus returns true when only the open alerts were requested, which is the default.
+func parseAlertStatus(request *http.Request) (bool, error) {
+	switch request.URL.Query().Get("status") {
+	case "", "open":
+		return true, nil
+	case "all":
+		return false, nil
+	default:
+		return false, fmt.Errorf("status must be one of: open, all")
+	}
+}
@@ -0,0 +1,105 @@
+package reader_test
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/cmd/http/handlers/reader"
+	"microservice-products-catalog/cmd/http/handlers/reader/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandleGetStockAlerts(t *testing.T) {
+	mockAlerts := []domain.StockAlert{
+		{ID: uuid.New().String(), ProductID: uuid.New().String(), Stock: 3, ReorderPoint: 5, ReorderQuantity: 20, CreatedAt: time.Now()},
+	}
+
+	testCases := []struct {
+		name                 string
+		setupMock            func(mock *mocks.MockInventoryService)
+		request              *http.Request
+		expectedStatus       int
+		expectedBodyContains string
+		expectedJSONResponse []domain.StockAlert
+	}{
+		{
+			name: "Success - 200 open alerts by default",
+			setupMock: func(mock *mocks.MockInventoryService) {
+				mock.EXPECT().GetStockAlerts(gomock.Any(), true).Return(mockAlerts, nil).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodGet, "/api/inventory/alerts", nil),
+			expectedStatus:       http.StatusOK,
+			expectedJSONResponse: mockAlerts,
+		},
+		{
+			name: "Success - 200 all alerts",
+			setupMock: func(mock *mocks.MockInventoryService) {
+				mock.EXPECT().GetStockAlerts(gomock.Any(), false).Return(mockAlerts, nil).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodGet, "/api/inventory/alerts?status=all", nil),
+			expectedStatus:       http.StatusOK,
+			expectedJSONResponse: mockAlerts,
+		},
+		{
+			name:                 "Failure - 400 invalid status",
+			setupMock:            func(mock *mocks.MockInventoryService) {},
+			request:              httptest.NewRequest(http.MethodGet, "/api/inventory/alerts?status=closed", nil),
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: "error parsing status",
+		},
+		{
+			name: "Failure - 500 Internal Server Error",
+			setupMock: func(mock *mocks.MockInventoryService) {
+				mock.EXPECT().GetStockAlerts(gomock.Any(), true).Return(nil, errors.New("database is down")).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodGet, "/api/inventory/alerts", nil),
+			expectedStatus:       http.StatusInternalServerError,
+			expectedBodyContains: `"detail":"error fetching stock alerts"`,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTokenGenerator := mocks.NewMockTokenGenerator(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockInventoryService := mocks.NewMockInventoryService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPricingService := mocks.NewMockPricingService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockInventoryService)
+
+			readerHandler := reader.NewReaderHandler(mockProductService, mockOrderService, mockInventoryService, mockCategoryService, mockPricingService, mockPromotionService, mockCustomerService, mockCartService, mockTokenGenerator, nil)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			readerHandler.HandleGetStockAlerts(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedBodyContains != "" {
+				assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+			}
+			if tc.expectedJSONResponse != nil {
+				expectedJSON, err := json.Marshal(tc.expectedJSONResponse)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(expectedJSON), recorder.Body.String())
+				assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
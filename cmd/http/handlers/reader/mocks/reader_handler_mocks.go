@@ -6,6 +6,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	auth "microservice-products-catalog/cmd/http/auth"
 	domain "microservice-products-catalog/internal/domain"
 	reflect "reflect"
@@ -74,6 +75,35 @@ func (m *MockProductService) EXPECT() *MockProductServiceMockRecorder {
 	return m.recorder
 }
 
+// ExportProducts mocks base method.
+func (m *MockProductService) ExportProducts(ctx context.Context, filter domain.ProductFilter, fn func(domain.Product) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportProducts", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportProducts indicates an expected call of ExportProducts.
+func (mr *MockProductServiceMockRecorder) ExportProducts(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportProducts", reflect.TypeOf((*MockProductService)(nil).ExportProducts), ctx, filter, fn)
+}
+
+// GetPriceHistory mocks base method.
+func (m *MockProductService) GetPriceHistory(ctx context.Context, productID string) ([]domain.PriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceHistory", ctx, productID)
+	ret0, _ := ret[0].([]domain.PriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockProductServiceMockRecorder) GetPriceHistory(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockProductService)(nil).GetPriceHistory), ctx, productID)
+}
+
 // GetProductByID mocks base method.
 func (m *MockProductService) GetProductByID(ctx context.Context, id string) (*domain.Product, error) {
 	m.ctrl.T.Helper()
@@ -104,6 +134,21 @@ func (mr *MockProductServiceMockRecorder) GetProducts(ctx, limit interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockProductService)(nil).GetProducts), ctx, limit)
 }
 
+// GetScheduledPrices mocks base method.
+func (m *MockProductService) GetScheduledPrices(ctx context.Context, productID string) ([]domain.ScheduledPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledPrices", ctx, productID)
+	ret0, _ := ret[0].([]domain.ScheduledPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledPrices indicates an expected call of GetScheduledPrices.
+func (mr *MockProductServiceMockRecorder) GetScheduledPrices(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledPrices", reflect.TypeOf((*MockProductService)(nil).GetScheduledPrices), ctx, productID)
+}
+
 // MockOrderService is a mock of OrderService interface.
 type MockOrderService struct {
 	ctrl     *gomock.Controller
@@ -127,17 +172,385 @@ func (m *MockOrderService) EXPECT() *MockOrderServiceMockRecorder {
 	return m.recorder
 }
 
+// ExportOrders mocks base method.
+func (m *MockOrderService) ExportOrders(ctx context.Context, filter domain.OrderFilter, fn func(domain.Order) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportOrders", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportOrders indicates an expected call of ExportOrders.
+func (mr *MockOrderServiceMockRecorder) ExportOrders(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOrders", reflect.TypeOf((*MockOrderService)(nil).ExportOrders), ctx, filter, fn)
+}
+
+// GetInvoice mocks base method.
+func (m *MockOrderService) GetInvoice(ctx context.Context, orderID, customerID string) (*domain.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoice", ctx, orderID, customerID)
+	ret0, _ := ret[0].(*domain.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoice indicates an expected call of GetInvoice.
+func (mr *MockOrderServiceMockRecorder) GetInvoice(ctx, orderID, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoice", reflect.TypeOf((*MockOrderService)(nil).GetInvoice), ctx, orderID, customerID)
+}
+
+// GetOrderByID mocks base method.
+func (m *MockOrderService) GetOrderByID(ctx context.Context, id, customerID string) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderByID", ctx, id, customerID)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderByID indicates an expected call of GetOrderByID.
+func (mr *MockOrderServiceMockRecorder) GetOrderByID(ctx, id, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByID", reflect.TypeOf((*MockOrderService)(nil).GetOrderByID), ctx, id, customerID)
+}
+
 // GetOrders mocks base method.
-func (m *MockOrderService) GetOrders(ctx context.Context) ([]domain.Order, error) {
+func (m *MockOrderService) GetOrders(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrders", ctx)
+	ret := m.ctrl.Call(m, "GetOrders", ctx, filter)
 	ret0, _ := ret[0].([]domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOrders indicates an expected call of GetOrders.
-func (mr *MockOrderServiceMockRecorder) GetOrders(ctx interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) GetOrders(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderService)(nil).GetOrders), ctx, filter)
+}
+
+// MockInvoiceRenderer is a mock of InvoiceRenderer interface.
+type MockInvoiceRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceRendererMockRecorder
+}
+
+// MockInvoiceRendererMockRecorder is the mock recorder for MockInvoiceRenderer.
+type MockInvoiceRendererMockRecorder struct {
+	mock *MockInvoiceRenderer
+}
+
+// NewMockInvoiceRenderer creates a new mock instance.
+func NewMockInvoiceRenderer(ctrl *gomock.Controller) *MockInvoiceRenderer {
+	mock := &MockInvoiceRenderer{ctrl: ctrl}
+	mock.recorder = &MockInvoiceRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceRenderer) EXPECT() *MockInvoiceRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockInvoiceRenderer) Render(w io.Writer, mediaType string, invoice domain.Invoice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", w, mediaType, invoice)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockInvoiceRendererMockRecorder) Render(w, mediaType, invoice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockInvoiceRenderer)(nil).Render), w, mediaType, invoice)
+}
+
+// MockInventoryService is a mock of InventoryService interface.
+type MockInventoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryServiceMockRecorder
+}
+
+// MockInventoryServiceMockRecorder is the mock recorder for MockInventoryService.
+type MockInventoryServiceMockRecorder struct {
+	mock *MockInventoryService
+}
+
+// NewMockInventoryService creates a new mock instance.
+func NewMockInventoryService(ctrl *gomock.Controller) *MockInventoryService {
+	mock := &MockInventoryService{ctrl: ctrl}
+	mock.recorder = &MockInventoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryService) EXPECT() *MockInventoryServiceMockRecorder {
+	return m.recorder
+}
+
+// GetStockAlerts mocks base method.
+func (m *MockInventoryService) GetStockAlerts(ctx context.Context, onlyOpen bool) ([]domain.StockAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStockAlerts", ctx, onlyOpen)
+	ret0, _ := ret[0].([]domain.StockAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStockAlerts indicates an expected call of GetStockAlerts.
+func (mr *MockInventoryServiceMockRecorder) GetStockAlerts(ctx, onlyOpen interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStockAlerts", reflect.TypeOf((*MockInventoryService)(nil).GetStockAlerts), ctx, onlyOpen)
+}
+
+// MockCategoryService is a mock of CategoryService interface.
+type MockCategoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryServiceMockRecorder
+}
+
+// MockCategoryServiceMockRecorder is the mock recorder for MockCategoryService.
+type MockCategoryServiceMockRecorder struct {
+	mock *MockCategoryService
+}
+
+// NewMockCategoryService creates a new mock instance.
+func NewMockCategoryService(ctrl *gomock.Controller) *MockCategoryService {
+	mock := &MockCategoryService{ctrl: ctrl}
+	mock.recorder = &MockCategoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryService) EXPECT() *MockCategoryServiceMockRecorder {
+	return m.recorder
+}
+
+// GetCategoryByID mocks base method.
+func (m *MockCategoryService) GetCategoryByID(ctx context.Context, id string) (*domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryByID indicates an expected call of GetCategoryByID.
+func (mr *MockCategoryServiceMockRecorder) GetCategoryByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByID", reflect.TypeOf((*MockCategoryService)(nil).GetCategoryByID), ctx, id)
+}
+
+// GetCategoryProducts mocks base method.
+func (m *MockCategoryService) GetCategoryProducts(ctx context.Context, id string, includeDescendants bool, limit int) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryProducts", ctx, id, includeDescendants, limit)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryProducts indicates an expected call of GetCategoryProducts.
+func (mr *MockCategoryServiceMockRecorder) GetCategoryProducts(ctx, id, includeDescendants, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryProducts", reflect.TypeOf((*MockCategoryService)(nil).GetCategoryProducts), ctx, id, includeDescendants, limit)
+}
+
+// GetCategoryTree mocks base method.
+func (m *MockCategoryService) GetCategoryTree(ctx context.Context) ([]domain.CategoryNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTree", ctx)
+	ret0, _ := ret[0].([]domain.CategoryNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTree indicates an expected call of GetCategoryTree.
+func (mr *MockCategoryServiceMockRecorder) GetCategoryTree(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTree", reflect.TypeOf((*MockCategoryService)(nil).GetCategoryTree), ctx)
+}
+
+// MockPricingService is a mock of PricingService interface.
+type MockPricingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPricingServiceMockRecorder
+}
+
+// MockPricingServiceMockRecorder is the mock recorder for MockPricingService.
+type MockPricingServiceMockRecorder struct {
+	mock *MockPricingService
+}
+
+// NewMockPricingService creates a new mock instance.
+func NewMockPricingService(ctrl *gomock.Controller) *MockPricingService {
+	mock := &MockPricingService{ctrl: ctrl}
+	mock.recorder = &MockPricingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPricingService) EXPECT() *MockPricingServiceMockRecorder {
+	return m.recorder
+}
+
+// ConvertProducts mocks base method.
+func (m *MockPricingService) ConvertProducts(ctx context.Context, products []domain.Product, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertProducts", ctx, products, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConvertProducts indicates an expected call of ConvertProducts.
+func (mr *MockPricingServiceMockRecorder) ConvertProducts(ctx, products, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertProducts", reflect.TypeOf((*MockPricingService)(nil).ConvertProducts), ctx, products, currency)
+}
+
+// MockPromotionService is a mock of PromotionService interface.
+type MockPromotionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionServiceMockRecorder
+}
+
+// MockPromotionServiceMockRecorder is the mock recorder for MockPromotionService.
+type MockPromotionServiceMockRecorder struct {
+	mock *MockPromotionService
+}
+
+// NewMockPromotionService creates a new mock instance.
+func NewMockPromotionService(ctrl *gomock.Controller) *MockPromotionService {
+	mock := &MockPromotionService{ctrl: ctrl}
+	mock.recorder = &MockPromotionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionService) EXPECT() *MockPromotionServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPromotionByID mocks base method.
+func (m *MockPromotionService) GetPromotionByID(ctx context.Context, id string) (*domain.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotionByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotionByID indicates an expected call of GetPromotionByID.
+func (mr *MockPromotionServiceMockRecorder) GetPromotionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotionByID", reflect.TypeOf((*MockPromotionService)(nil).GetPromotionByID), ctx, id)
+}
+
+// GetPromotions mocks base method.
+func (m *MockPromotionService) GetPromotions(ctx context.Context) ([]domain.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotions", ctx)
+	ret0, _ := ret[0].([]domain.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotions indicates an expected call of GetPromotions.
+func (mr *MockPromotionServiceMockRecorder) GetPromotions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotions", reflect.TypeOf((*MockPromotionService)(nil).GetPromotions), ctx)
+}
+
+// MockCustomerService is a mock of CustomerService interface.
+type MockCustomerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerServiceMockRecorder
+}
+
+// MockCustomerServiceMockRecorder is the mock recorder for MockCustomerService.
+type MockCustomerServiceMockRecorder struct {
+	mock *MockCustomerService
+}
+
+// NewMockCustomerService creates a new mock instance.
+func NewMockCustomerService(ctrl *gomock.Controller) *MockCustomerService {
+	mock := &MockCustomerService{ctrl: ctrl}
+	mock.recorder = &MockCustomerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerService) EXPECT() *MockCustomerServiceMockRecorder {
+	return m.recorder
+}
+
+// GetCustomerByID mocks base method.
+func (m *MockCustomerService) GetCustomerByID(ctx context.Context, id string) (*domain.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerByID indicates an expected call of GetCustomerByID.
+func (mr *MockCustomerServiceMockRecorder) GetCustomerByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByID", reflect.TypeOf((*MockCustomerService)(nil).GetCustomerByID), ctx, id)
+}
+
+// GetCustomers mocks base method.
+func (m *MockCustomerService) GetCustomers(ctx context.Context, limit int) ([]domain.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomers", ctx, limit)
+	ret0, _ := ret[0].([]domain.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomers indicates an expected call of GetCustomers.
+func (mr *MockCustomerServiceMockRecorder) GetCustomers(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomers", reflect.TypeOf((*MockCustomerService)(nil).GetCustomers), ctx, limit)
+}
+
+// MockCartService is a mock of CartService interface.
+type MockCartService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartServiceMockRecorder
+}
+
+// MockCartServiceMockRecorder is the mock recorder for MockCartService.
+type MockCartServiceMockRecorder struct {
+	mock *MockCartService
+}
+
+// NewMockCartService creates a new mock instance.
+func NewMockCartService(ctrl *gomock.Controller) *MockCartService {
+	mock := &MockCartService{ctrl: ctrl}
+	mock.recorder = &MockCartServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartService) EXPECT() *MockCartServiceMockRecorder {
+	return m.recorder
+}
+
+// GetCart mocks base method.
+func (m *MockCartService) GetCart(ctx context.Context, id, customerID string) (*domain.CartView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCart", ctx, id, customerID)
+	ret0, _ := ret[0].(*domain.CartView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCart indicates an expected call of GetCart.
+func (mr *MockCartServiceMockRecorder) GetCart(ctx, id, customerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderService)(nil).GetOrders), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCart", reflect.TypeOf((*MockCartService)(nil).GetCart), ctx, id, customerID)
 }
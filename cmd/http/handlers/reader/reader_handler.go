@@ -2,8 +2,10 @@ package reader
 
 import (
 	"context"
+	"io"
 	"microservice-products-catalog/cmd/http/auth"
 	"microservice-products-catalog/internal/domain"
+	"time"
 )
 
 //go:generate mockgen -source=reader_handler.go -destination=./mocks/reader_handler_mocks.go -package=mocks
@@ -15,22 +17,78 @@ type TokenGenerator interface {
 type ProductService interface {
 	GetProducts(ctx context.Context, limit int) ([]domain.Product, error)
 	GetProductByID(ctx context.Context, id string) (*domain.Product, error)
+	ExportProducts(ctx context.Context, filter domain.ProductFilter, fn func(product domain.Product) error) error
+	GetPriceHistory(ctx context.Context, productID string) ([]domain.PriceChange, error)
+	GetScheduledPrices(ctx context.Context, productID string) ([]domain.ScheduledPrice, error)
 }
 
 type OrderService interface {
-	GetOrders(ctx context.Context) ([]domain.Order, error)
+	GetOrders(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, error)
+	GetOrderByID(ctx context.Context, id string, customerID string) (*domain.Order, error)
+	ExportOrders(ctx context.Context, filter domain.OrderFilter, fn func(order domain.Order) error) error
+	GetInvoice(ctx context.Context, orderID string, customerID string) (*domain.Invoice, error)
 }
 
+// InvoiceRenderer writes an invoice as mediaType, text/html or text/plain.
+type InvoiceRenderer interface {
+	Render(w io.Writer, mediaType string, invoice domain.Invoice) error
+}
+
+type InventoryService interface {
+	GetStockAlerts(ctx context.Context, onlyOpen bool) ([]domain.StockAlert, error)
+}
+
+type CategoryService interface {
+	GetCategoryTree(ctx context.Context) ([]domain.CategoryNode, error)
+	GetCategoryByID(ctx context.Context, id string) (*domain.Category, error)
+	GetCategoryProducts(ctx context.Context, id string, includeDescendants bool, limit int) ([]domain.Product, error)
+}
+
+type PricingService interface {
+	ConvertProducts(ctx context.Context, products []domain.Product, currency string) error
+}
+
+type PromotionService interface {
+	GetPromotions(ctx context.Context) ([]domain.Promotion, error)
+	GetPromotionByID(ctx context.Context, id string) (*domain.Promotion, error)
+}
+
+type CustomerService interface {
+	GetCustomers(ctx context.Context, limit int) ([]domain.Customer, error)
+	GetCustomerByID(ctx context.Context, id string) (*domain.Customer, error)
+}
+
+type CartService interface {
+	GetCart(ctx context.Context, id string, customerID string) (*domain.CartView, error)
+}
+
+// ReaderHandler depends on the interface, not concrete types. ExportTimeout replaces the write
+// timeout of the server for the exports, zero keeps it.
 type ReaderHandler struct {
-	ProductService ProductService
-	OrderService   OrderService
-	TokenGenerator TokenGenerator
+	ProductService   ProductService
+	OrderService     OrderService
+	InventoryService InventoryService
+	CategoryService  CategoryService
+	PricingService   PricingService
+	PromotionService PromotionService
+	CustomerService  CustomerService
+	CartService      CartService
+	TokenGenerator   TokenGenerator
+	InvoiceRenderer  InvoiceRenderer
+	ExportTimeout    time.Duration
 }
 
-func NewReaderHandler(productService ProductService, orderService OrderService, tokenGenerator TokenGenerator) *ReaderHandler {
+func NewReaderHandler(productService ProductService, orderService OrderService, inventoryService InventoryService, categoryService CategoryService, pricingService PricingService, promotionService PromotionService, customerService CustomerService, cartService CartService, tokenGenerator TokenGenerator, invoiceRenderer InvoiceRenderer) *ReaderHandler {
 	return &ReaderHandler{
-		ProductService: productService,
-		OrderService:   orderService,
-		TokenGenerator: tokenGenerator,
+		ProductService:   productService,
+		OrderService:     orderService,
+		InventoryService: inventoryService,
+		CategoryService:  categoryService,
+		PricingService:   pricingService,
+		PromotionService: promotionService,
+		CustomerService:  customerService,
+		CartService:      cartService,
+		TokenGenerator:   tokenGenerator,
+		InvoiceRenderer:  invoiceRenderer,
 	}
 }
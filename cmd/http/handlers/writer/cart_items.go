@@ -0,0 +1,62 @@
+package writer
+
+import (
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+)
+
+// HandleAddCartItem serves POST /api/carts/{id}/items and answers with the cart.
+func (h *WriteHandler) HandleAddCartItem(w http.ResponseWriter, r *http.Request) {
+	path, ok := parseCartPath(w, r)
+	if !ok {
+		return
+	}
+
+	var body dto.AddCartItemRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	view, err := h.CartService.AddCartItem(r.Context(), path.cartID, path.claims.CustomerScope(), body.ProductID, body.VariantID, body.Quantity)
+	if err != nil {
+		problem.Error(w, r, "error adding cart item", err)
+		return
+	}
+	writeCartView(w, view)
+}
+
+// HandleUpdateCartItem serves PUT /api/carts/{id}/items/{itemID} and answers with the cart.
+func (h *WriteHandler) HandleUpdateCartItem(w http.ResponseWriter, r *http.Request) {
+	path, ok := parseCartPath(w, r)
+	if !ok {
+		return
+	}
+
+	var body dto.UpdateCartItemRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	view, err := h.CartService.UpdateCartItem(r.Context(), path.cartID, path.claims.CustomerScope(), path.segments[1], body.Quantity)
+	if err != nil {
+		problem.Error(w, r, "error updating cart item", err)
+		return
+	}
+	writeCartView(w, view)
+}
+
+// HandleRemoveCartItem serves DELETE /api/carts/{id}/items/{itemID} and answers with the cart.
+func (h *WriteHandler) HandleRemoveCartItem(w http.ResponseWriter, r *http.Request) {
+	path, ok := parseCartPath(w, r)
+	if !ok {
+		return
+	}
+
+	view, err := h.CartService.RemoveCartItem(r.Context(), path.cartID, path.claims.CustomerScope(), path.segments[1])
+	if err != nil {
+		problem.Error(w, r, "error removing cart item", err)
+		return
+	}
+	writeCartView(w, view)
+}
@@ -0,0 +1,60 @@
+package writer
+
+import (
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+	"strings"
+)
+
+// HandleAddCategoryProduct serves PUT /api/categories/{id}/products/{productID}, linking
+// an already linked product is not an error.
+func (h *WriteHandler) HandleAddCategoryProduct(w http.ResponseWriter, r *http.Request) {
+	categoryID, productID, ok := parseCategoryProductPath(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.CategoryService.AddProductToCategory(r.Context(), categoryID, productID)
+	if err != nil {
+		problem.Error(w, r, "error adding product to category", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleRemoveCategoryProduct serves DELETE /api/categories/{id}/products/{productID}.
+func (h *WriteHandler) HandleRemoveCategoryProduct(w http.ResponseWriter, r *http.Request) {
+	categoryID, productID, ok := parseCategoryProductPath(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.CategoryService.RemoveProductFromCategory(r.Context(), categoryID, productID)
+	if err != nil {
+		problem.Error(w, r, "error removing product from category", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseCategoryProductPath(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) < 3 {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid category product path")
+		return "", "", false
+	}
+
+	categoryID, productID := parts[len(parts)-3], parts[len(parts)-1]
+	if _, err := uuid.Parse(categoryID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid category id format, must be UUID")
+		return "", "", false
+	}
+	if _, err := uuid.Parse(productID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product id format, must be UUID")
+		return "", "", false
+	}
+	return categoryID, productID, true
+}
@@ -0,0 +1,45 @@
+package writer
+
+import (
+	"encoding/json"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+)
+
+// HandleCheckoutCart serves POST /api/carts/{id}/checkout, it answers 201 with the orders placed
+// or 409 with the lines whose stock changed since they were added in the problem conflicts.
+func (h *WriteHandler) HandleCheckoutCart(w http.ResponseWriter, r *http.Request) {
+	path, ok := parseCartPath(w, r)
+	if !ok {
+		return
+	}
+
+	var body dto.CheckoutCartRequest
+	if r.ContentLength != 0 && !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	options := domain.CheckoutOptions{Region: body.Region}
+	if body.Currency != "" {
+		currency, err := domain.ParseCurrency(body.Currency)
+		if err != nil {
+			problem.Error(w, r, "error checking out cart", err)
+			return
+		}
+		options.Currency = currency
+	}
+
+	orders, err := h.CartService.Checkout(r.Context(), path.cartID, path.claims.CustomerScope(), options)
+	if err != nil {
+		problem.Error(w, r, "error checking out cart", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(orders); err != nil {
+		return
+	}
+}
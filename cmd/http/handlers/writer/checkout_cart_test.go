@@ -0,0 +1,110 @@
+package writer_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/handlers/writer"
+	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleCheckoutCart(t *testing.T) {
+	cartID := "3c9a1f2e-7b4d-4e6a-9c8b-1d2e3f4a5b6c"
+	conflict := &domain.CheckoutConflictError{Lines: []domain.LineConflict{
+		{ProductID: productID, Requested: 3, Available: 1, Reason: domain.LineInsufficientStock},
+	}}
+
+	testCases := []struct {
+		name                 string
+		request              *http.Request
+		setupMock            func(mock *mocks.MockCartService)
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name:    "Success - 201 orders of the cart",
+			request: withClaims(httptest.NewRequest(http.MethodPost, "/api/carts/"+cartID+"/checkout", strings.NewReader(`{"currency": "eur", "region": "US-NY"}`)), customerClaims),
+			setupMock: func(mock *mocks.MockCartService) {
+				mock.EXPECT().
+					Checkout(gomock.Any(), cartID, customerClaims.Subject, domain.CheckoutOptions{Currency: "EUR", Region: "US-NY"}).
+					Return([]domain.Order{{ID: "18eb9153-a00c-466d-8f38-f149806b054e", ProductID: productID, Quantity: 3}}, nil).Times(1)
+			},
+			expectedStatus:       http.StatusCreated,
+			expectedBodyContains: `"product_id":"` + productID + `"`,
+		},
+		{
+			name:    "Success - 201 without body, admin reaches any cart",
+			request: withClaims(httptest.NewRequest(http.MethodPost, "/api/carts/"+cartID+"/checkout", nil), adminClaims),
+			setupMock: func(mock *mocks.MockCartService) {
+				mock.EXPECT().Checkout(gomock.Any(), cartID, "", domain.CheckoutOptions{}).Return([]domain.Order{}, nil).Times(1)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:    "Failure - 409 per line conflict report",
+			request: withClaims(httptest.NewRequest(http.MethodPost, "/api/carts/"+cartID+"/checkout", nil), customerClaims),
+			setupMock: func(mock *mocks.MockCartService) {
+				mock.EXPECT().Checkout(gomock.Any(), cartID, customerClaims.Subject, domain.CheckoutOptions{}).Return(nil, conflict).Times(1)
+			},
+			expectedStatus:       http.StatusConflict,
+			expectedBodyContains: `"conflicts":[{"product_id":"` + productID + `","requested":3,"available":1,"reason":"insufficient_stock"}]`,
+		},
+		{
+			name:    "Failure - 410 expired cart",
+			request: withClaims(httptest.NewRequest(http.MethodPost, "/api/carts/"+cartID+"/checkout", nil), customerClaims),
+			setupMock: func(mock *mocks.MockCartService) {
+				mock.EXPECT().Checkout(gomock.Any(), cartID, customerClaims.Subject, domain.CheckoutOptions{}).Return(nil, domain.ErrCartExpired).Times(1)
+			},
+			expectedStatus:       http.StatusGone,
+			expectedBodyContains: domain.ErrCartExpired.Error(),
+		},
+		{
+			name:    "Failure - 409 cart already checked out",
+			request: withClaims(httptest.NewRequest(http.MethodPost, "/api/carts/"+cartID+"/checkout", nil), customerClaims),
+			setupMock: func(mock *mocks.MockCartService) {
+				mock.EXPECT().Checkout(gomock.Any(), cartID, customerClaims.Subject, domain.CheckoutOptions{}).Return(nil, domain.ErrCartCheckedOut).Times(1)
+			},
+			expectedStatus:       http.StatusConflict,
+			expectedBodyContains: domain.ErrCartCheckedOut.Error(),
+		},
+		{
+			name:                 "Failure - 400 invalid cart id",
+			request:              withClaims(httptest.NewRequest(http.MethodPost, "/api/carts/mine/checkout", nil), customerClaims),
+			setupMock:            func(mock *mocks.MockCartService) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: "invalid cart id format, must be UUID",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockCartService)
+
+			handler := writer.NewWriteHandler(mockProductService, mockOrderService, mockCategoryService, mockPromotionService, mockCustomerService, mockCartService)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			handler.HandleCheckoutCart(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+		})
+	}
+}
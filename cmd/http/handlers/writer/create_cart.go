@@ -0,0 +1,69 @@
+package writer
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strings"
+)
+
+// HandleCreateCart opens an empty cart for the customer of the token.
+func (h *WriteHandler) HandleCreateCart(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+
+	cart, err := h.CartService.CreateCart(r.Context(), claims.Subject)
+	if err != nil {
+		problem.Error(w, r, "error creating cart", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(cart); err != nil {
+		return
+	}
+}
+
+// cartPath is /api/carts/{id}/{subresource...} of an authenticated request.
+type cartPath struct {
+	claims   auth.TokenClaims
+	cartID   string
+	segments []string
+}
+
+// parseCartPath reads the cart id and the segments that follow it, every id of the path must be
+// a UUID.
+func parseCartPath(w http.ResponseWriter, r *http.Request) (cartPath, bool) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return cartPath{}, false
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/carts/"), "/"), "/")
+	if _, err := uuid.Parse(segments[0]); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid cart id format, must be UUID")
+		return cartPath{}, false
+	}
+	if len(segments) == 3 {
+		if _, err := uuid.Parse(segments[2]); err != nil {
+			problem.WriteStatus(w, r, http.StatusBadRequest, "invalid cart item id format, must be UUID")
+			return cartPath{}, false
+		}
+	}
+	return cartPath{claims: claims, cartID: segments[0], segments: segments[1:]}, true
+}
+
+// writeCartView answers with the cart after a change of its items.
+func writeCartView(w http.ResponseWriter, view *domain.CartView) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(view); err != nil {
+		return
+	}
+}
@@ -0,0 +1,38 @@
+package writer
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+)
+
+// HandleCreateCategory answers with the created category so clients get the generated
+// ID to attach subcategories and products.
+func (h *WriteHandler) HandleCreateCategory(w http.ResponseWriter, r *http.Request) {
+	var body dto.CreateCategoryRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	category := domain.Category{
+		ID:          uuid.New().String(),
+		Name:        body.Name,
+		Description: body.Description,
+		ParentID:    body.ParentID,
+	}
+
+	err := h.CategoryService.CreateCategory(r.Context(), category)
+	if err != nil {
+		problem.Error(w, r, "error creating category", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(category); err != nil {
+		return
+	}
+}
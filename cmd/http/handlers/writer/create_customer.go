@@ -0,0 +1,78 @@
+package writer
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strings"
+)
+
+// HandleCreateCustomer registers the customer of the token, the customer ID is the subject
+// so the orders placed with the token belong to it. Admins can create any customer.
+func (h *WriteHandler) HandleCreateCustomer(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+
+	var body dto.CreateCustomerRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	customerID := body.ID
+	switch {
+	case claims.IsAdmin() && customerID == "":
+		customerID = uuid.New().String()
+	case claims.IsAdmin():
+	case customerID != "" && customerID != claims.Subject:
+		problem.WriteStatus(w, r, http.StatusForbidden, "the token cannot create another customer")
+		return
+	default:
+		if _, err := uuid.Parse(claims.Subject); err != nil {
+			problem.WriteStatus(w, r, http.StatusBadRequest, "the token subject is not a valid customer id")
+			return
+		}
+		customerID = claims.Subject
+	}
+
+	customer, err := h.CustomerService.CreateCustomer(r.Context(), domain.Customer{
+		ID:    customerID,
+		Email: body.Email,
+		Name:  body.Name,
+	})
+	if err != nil {
+		problem.Error(w, r, "error creating customer", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(customer); err != nil {
+		return
+	}
+}
+
+// parseCustomerID reads the customer id of /api/customers/{id}, tokens of other customers get a 403.
+func parseCustomerID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return "", false
+	}
+
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	customerID := parts[len(parts)-1]
+	if _, err := uuid.Parse(customerID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid customer id format, must be UUID")
+		return "", false
+	}
+	if !claims.CanAccessCustomer(customerID) {
+		problem.WriteStatus(w, r, http.StatusForbidden, "the token cannot access this customer")
+		return "", false
+	}
+	return customerID, true
+}
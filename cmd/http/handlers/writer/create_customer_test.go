@@ -0,0 +1,113 @@
+package writer_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/handlers/writer"
+	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleCreateCustomer(t *testing.T) {
+	body := `{"email": "Ada@Example.com", "name": "Ada"}`
+
+	testCases := []struct {
+		name                 string
+		request              *http.Request
+		setupMock            func(mock *mocks.MockCustomerService)
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name:    "Success - 201 customer ID is the token subject",
+			request: withClaims(httptest.NewRequest(http.MethodPost, "/api/customers", strings.NewReader(body)), customerClaims),
+			setupMock: func(mock *mocks.MockCustomerService) {
+				mock.EXPECT().
+					CreateCustomer(gomock.Any(), domain.Customer{ID: customerClaims.Subject, Email: "Ada@Example.com", Name: "Ada"}).
+					DoAndReturn(func(_ context.Context, c domain.Customer) (domain.Customer, error) {
+						c.Email = "ada@example.com"
+						return c, nil
+					}).Times(1)
+			},
+			expectedStatus:       http.StatusCreated,
+			expectedBodyContains: `"id":"` + customerClaims.Subject + `"`,
+		},
+		{
+			name:    "Success - 201 admin generates the customer ID",
+			request: withClaims(httptest.NewRequest(http.MethodPost, "/api/customers", strings.NewReader(body)), adminClaims),
+			setupMock: func(mock *mocks.MockCustomerService) {
+				mock.EXPECT().
+					CreateCustomer(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c domain.Customer) (domain.Customer, error) {
+						assert.NotEmpty(t, c.ID)
+						assert.NotEqual(t, adminClaims.Subject, c.ID)
+						return c, nil
+					}).Times(1)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:                 "Failure - 403 customer creates another customer",
+			request:              withClaims(httptest.NewRequest(http.MethodPost, "/api/customers", strings.NewReader(`{"id": "`+adminClaims.Subject+`", "email": "ada@example.com", "name": "Ada"}`)), customerClaims),
+			setupMock:            func(mock *mocks.MockCustomerService) {},
+			expectedStatus:       http.StatusForbidden,
+			expectedBodyContains: "the token cannot create another customer",
+		},
+		{
+			name:                 "Failure - 400 invalid email",
+			request:              withClaims(httptest.NewRequest(http.MethodPost, "/api/customers", strings.NewReader(`{"email": "ada", "name": "Ada"}`)), customerClaims),
+			setupMock:            func(mock *mocks.MockCustomerService) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: `{"field":"email","detail":"must be an email address"}`,
+		},
+		{
+			name:    "Failure - 409 customer already exists",
+			request: withClaims(httptest.NewRequest(http.MethodPost, "/api/customers", strings.NewReader(body)), customerClaims),
+			setupMock: func(mock *mocks.MockCustomerService) {
+				mock.EXPECT().CreateCustomer(gomock.Any(), gomock.Any()).Return(domain.Customer{}, domain.ErrCustomerAlreadyExists).Times(1)
+			},
+			expectedStatus:       http.StatusConflict,
+			expectedBodyContains: domain.ErrCustomerAlreadyExists.Error(),
+		},
+		{
+			name:                 "Failure - 401 without token",
+			request:              httptest.NewRequest(http.MethodPost, "/api/customers", strings.NewReader(body)),
+			setupMock:            func(mock *mocks.MockCustomerService) {},
+			expectedStatus:       http.StatusUnauthorized,
+			expectedBodyContains: "Header Authorization is required",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockCustomerService)
+
+			handler := writer.NewWriteHandler(mockProductService, mockOrderService, mockCategoryService, mockPromotionService, mockCustomerService, mockCartService)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			handler.HandleCreateCustomer(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+		})
+	}
+}
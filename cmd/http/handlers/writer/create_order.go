@@ -2,61 +2,61 @@ package writer
 
 import (
 	"encoding/json"
-	"errors"
-	"fmt"
-	"io"
+	"microservice-products-catalog/cmd/http/auth"
 	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
 	"microservice-products-catalog/internal/domain"
 	"net/http"
 )
 
+// HandleCreateOrder places the order for the customer of the token, admins can place it on
+// behalf of another customer with customer_id.
 func (h *WriteHandler) HandleCreateOrder(w http.ResponseWriter, r *http.Request) {
-	bytes, err := io.ReadAll(r.Body)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		_, err = w.Write([]byte(fmt.Sprintf("error rading body: %s", err)))
-		if err != nil {
-			return
-		}
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+
+	bytes, ok := h.readBody(w, r)
+	if !ok {
 		return
 	}
 
 	var body dto.CreateOrderRequest
 	if err := json.Unmarshal(bytes, &body); err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		_, err = w.Write([]byte(fmt.Sprintf("error rading body: %s", err)))
-		if err != nil {
-			return
-		}
+		problem.Write(w, r, problem.FromBodyError(err))
 		return
 	}
 
-	// TODO [technical debate] Create a mapper to parse data
-	err = h.OrderService.CreateOrder(r.Context(), body.ProductID, body.Quantity)
-
-	if err != nil {
-		fmt.Printf("[ERROR] - Error creating order: %s\n", err.Error())
-		if errors.Is(domain.ErrProductNotFound, err) {
-			w.WriteHeader(http.StatusNotFound)
-			_, err = w.Write([]byte(fmt.Sprintf("error creating order: %s", err)))
-			if err != nil {
-				return
-			}
+	customerID := claims.Subject
+	if body.CustomerID != "" && body.CustomerID != customerID {
+		if !claims.IsAdmin() {
+			problem.WriteStatus(w, r, http.StatusForbidden, "the token cannot place orders for another customer")
 			return
 		}
-		if errors.Is(domain.ErrInsufficientStock, err) {
-			w.WriteHeader(http.StatusBadRequest)
-			_, err = w.Write([]byte(fmt.Sprintf("error creating order: %s", err)))
-			if err != nil {
-				return
-			}
-			return
-		}
-		w.WriteHeader(http.StatusInternalServerError)
-		_, err = w.Write([]byte(fmt.Sprintf("error creating order")))
+		customerID = body.CustomerID
+	}
+
+	request := domain.OrderRequest{
+		CustomerID: customerID,
+		ProductID:  body.ProductID,
+		VariantID:  body.VariantID,
+		Quantity:   body.Quantity,
+		CouponCode: body.CouponCode,
+		Region:     body.Region,
+	}
+	if body.Currency != "" {
+		currency, err := domain.ParseCurrency(body.Currency)
 		if err != nil {
+			problem.Error(w, r, "error creating order", err)
 			return
 		}
+		request.Currency = currency
+	}
+
+	err := h.OrderService.CreateOrder(r.Context(), request)
+	if err != nil {
+		problem.Error(w, r, "error creating order", err)
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
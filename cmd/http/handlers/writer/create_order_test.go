@@ -3,10 +3,13 @@ package writer_test
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/auth"
 	"microservice-products-catalog/cmd/http/handlers/writer"
 	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
+	"microservice-products-catalog/internal/domain"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -18,6 +21,16 @@ const (
 	quantity  = 3
 )
 
+var (
+	customerClaims = auth.TokenClaims{Subject: "0b7d7c8e-3f4a-4b8e-9d61-2a5f1e7c9b30", Scope: "orders:write"}
+	adminClaims    = auth.TokenClaims{Subject: "9e2f6a4b-7c1d-4e8f-a053-6b4d2c1e0f97", Scope: "admin"}
+)
+
+// withClaims authenticates the request as auth.RequireToken does.
+func withClaims(request *http.Request, claims auth.TokenClaims) *http.Request {
+	return request.WithContext(auth.WithClaims(request.Context(), claims))
+}
+
 func TestHandleCreateOrder(t *testing.T) {
 	payload := map[string]any{
 		"product_id": productID,
@@ -26,11 +39,24 @@ func TestHandleCreateOrder(t *testing.T) {
 
 	b, _ := json.Marshal(payload)
 
-	validRequest := httptest.NewRequest(
+	variantID := "8b2c6a0e-1f0d-4b8e-9a57-4c3f0e2d1a9b"
+	variantBody, _ := json.Marshal(map[string]any{
+		"product_id": productID,
+		"variant_id": variantID,
+		"quantity":   quantity,
+	})
+
+	currencyBody, _ := json.Marshal(map[string]any{
+		"product_id": productID,
+		"quantity":   quantity,
+		"currency":   "eur",
+	})
+
+	validRequest := withClaims(httptest.NewRequest(
 		http.MethodPost,
 		"/api/orders",
 		bytes.NewReader(b),
-	)
+	), customerClaims)
 
 	type testCase struct {
 		testName             string
@@ -50,31 +76,185 @@ func TestHandleCreateOrder(t *testing.T) {
 			},
 			setupMock: func(mock *mocks.MockOrderService) {
 				mock.EXPECT().
-					CreateOrder(gomock.Any(), productID, quantity).Return(nil).Times(1)
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity}).Return(nil).Times(1)
 			},
 			expectedStatus: http.StatusCreated,
 		},
 		{
 			testName: "Failure - 404 Product Not Found",
-			request:  httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(b)),
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(b)), customerClaims),
 			setupRequest: func(req *http.Request) {
 				req.Header.Set("Content-Type", "application/json")
 			},
 			setupMock: func(mock *mocks.MockOrderService) {
 				mock.EXPECT().
-					CreateOrder(gomock.Any(), productID, quantity).Return(nil).Times(1)
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity}).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			testName: "Success - 201 Created Order of a variant",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(variantBody)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, VariantID: variantID, Quantity: quantity}).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			testName: "Failure - 400 Insufficient Stock",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(b)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity}).Return(domain.ErrInsufficientStock).Times(1)
+			},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: domain.ErrInsufficientStock.Error(),
+		},
+		{
+			testName: "Failure - 400 Variant Required",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(b)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity}).Return(domain.ErrVariantRequired).Times(1)
+			},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: domain.ErrVariantRequired.Error(),
+		},
+		{
+			testName: "Failure - 404 Variant Not Found",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(variantBody)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, VariantID: variantID, Quantity: quantity}).Return(domain.ErrVariantNotFound).Times(1)
+			},
+			expectedStatus:       http.StatusNotFound,
+			expectedBodyContains: domain.ErrVariantNotFound.Error(),
+		},
+		{
+			testName: "Success - 201 Created Order in another currency",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(currencyBody)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity, Currency: "EUR"}).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			testName: "Failure - 400 Exchange Rate Not Found",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(currencyBody)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity, Currency: "EUR"}).
+					Return(fmt.Errorf("error converting USD to EUR: %w", domain.ErrExchangeRateNotFound)).Times(1)
+			},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: domain.ErrExchangeRateNotFound.Error(),
+		},
+		{
+			testName:             "Failure - 400 Invalid Currency",
+			request:              withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader(`{"product_id":"`+productID+`","quantity":1,"currency":"EURO"}`)), customerClaims),
+			setupMock:            func(mock *mocks.MockOrderService) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: domain.ErrInvalidCurrency.Error(),
+		},
+		{
+			testName: "Failure - 409 Coupon Usage Limit Reached",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader(`{"product_id":"`+productID+`","quantity":3,"coupon_code":"SUMMER10"}`)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity, CouponCode: "SUMMER10"}).
+					Return(domain.ErrCouponUsageLimitReached).Times(1)
+			},
+			expectedStatus:       http.StatusConflict,
+			expectedBodyContains: domain.ErrCouponUsageLimitReached.Error(),
+		},
+		{
+			testName: "Failure - 402 Payment Declined",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader(`{"product_id":"`+productID+`","quantity":3}`)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity}).
+					Return(domain.ErrPaymentDeclined).Times(1)
+			},
+			expectedStatus:       http.StatusPaymentRequired,
+			expectedBodyContains: domain.ErrPaymentDeclined.Error(),
+		},
+		{
+			testName: "Failure - 504 Payment Gateway Timeout",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader(`{"product_id":"`+productID+`","quantity":3}`)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity}).
+					Return(domain.ErrPaymentTimeout).Times(1)
+			},
+			expectedStatus:       http.StatusGatewayTimeout,
+			expectedBodyContains: domain.ErrPaymentTimeout.Error(),
+		},
+		{
+			testName: "Failure - 400 Tax Region Not Found",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader(`{"product_id":"`+productID+`","quantity":3,"region":"XX"}`)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity, Region: "XX"}).
+					Return(fmt.Errorf("%w: %q", domain.ErrTaxRegionNotFound, "XX")).Times(1)
+			},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: domain.ErrTaxRegionNotFound.Error(),
+		},
+		{
+			testName: "Failure - 400 Coupon Not Active",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader(`{"product_id":"`+productID+`","quantity":3,"coupon_code":"WINTER"}`)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity, CouponCode: "WINTER"}).
+					Return(domain.ErrCouponNotActive).Times(1)
+			},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: domain.ErrCouponNotActive.Error(),
+		},
+		{
+			testName: "Success - 201 Admin places an order on behalf of a customer",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader(`{"customer_id":"`+customerClaims.Subject+`","product_id":"`+productID+`","quantity":3}`)), adminClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity}).Return(nil).Times(1)
 			},
 			expectedStatus: http.StatusCreated,
 		},
+		{
+			testName:             "Failure - 403 Customer places an order for another customer",
+			request:              withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", strings.NewReader(`{"customer_id":"`+adminClaims.Subject+`","product_id":"`+productID+`","quantity":3}`)), customerClaims),
+			setupMock:            func(mock *mocks.MockOrderService) {},
+			expectedStatus:       http.StatusForbidden,
+			expectedBodyContains: "the token cannot place orders for another customer",
+		},
+		{
+			testName: "Failure - 404 Customer Not Found",
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(b)), customerClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity}).Return(domain.ErrCustomerNotFound).Times(1)
+			},
+			expectedStatus:       http.StatusNotFound,
+			expectedBodyContains: domain.ErrCustomerNotFound.Error(),
+		},
+		{
+			testName:             "Failure - 401 without token",
+			request:              httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(b)),
+			setupMock:            func(mock *mocks.MockOrderService) {},
+			expectedStatus:       http.StatusUnauthorized,
+			expectedBodyContains: "Header Authorization is required",
+		},
 		/*{
 			testName: "Failure - 500 Internal Server Error",
-			request:  httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(b)),
+			request:  withClaims(httptest.NewRequest(http.MethodPost, "/api/orders", bytes.NewReader(b)), customerClaims),
 			setupRequest: func(req *http.Request) {
 				req.Header.Set("Content-Type", "application/json")
 			},
 			setupMock: func(mock *mocks.MockOrderService) {
 				mock.EXPECT().
-					CreateOrder(gomock.Any(), productID, quantity).Return(errors.New("unknown Database")).Times(1)
+					CreateOrder(gomock.Any(), domain.OrderRequest{CustomerID: customerClaims.Subject, ProductID: productID, Quantity: quantity}).Return(errors.New("unknown Database")).Times(1)
 			},
 			expectedStatus:       http.StatusInternalServerError,
 			expectedBodyContains: "error creating order",
@@ -87,10 +267,14 @@ func TestHandleCreateOrder(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
 			mockProductService := mocks.NewMockProductService(ctrl)
 			tc.setupMock(mockOrderService)
 
-			writerHandler := writer.NewWriteHandler(mockProductService, mockOrderService)
+			writerHandler := writer.NewWriteHandler(mockProductService, mockOrderService, mockCategoryService, mockPromotionService, mockCustomerService, mockCartService)
 			recorder := httptest.NewRecorder()
 
 			if tc.setupRequest != nil {
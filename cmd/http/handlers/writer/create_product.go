@@ -2,10 +2,9 @@ package writer
 
 import (
 	"encoding/json"
-	"fmt"
 	"github.com/google/uuid"
-	"io"
 	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
 	"microservice-products-catalog/internal/domain"
 	"net/http"
 )
@@ -13,7 +12,7 @@ import (
 func (h *WriteHandler) HandleCreateProduct(w http.ResponseWriter, r *http.Request) {
 	/*if r.Method != http.MethodPost {
 		w.Header().Set("Allow", http.MethodPost)
-		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "Method Not Allowed")
 		return
 	}*/
 
@@ -21,23 +20,14 @@ func (h *WriteHandler) HandleCreateProduct(w http.ResponseWriter, r *http.Reques
 	//if JWToken == "" {
 	//} // handle token logic
 
-	bytes, err := io.ReadAll(r.Body)
-	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		_, err = w.Write([]byte(fmt.Sprintf("error reading body: %s", err)))
-		if err != nil {
-			return
-		}
+	bytes, ok := h.readBody(w, r)
+	if !ok {
 		return
 	}
 
 	var body dto.CreateProductRequest
 	if err := json.Unmarshal(bytes, &body); err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		_, err = w.Write([]byte(fmt.Sprintf("error reading body: %s", err)))
-		if err != nil {
-			return
-		}
+		problem.Write(w, r, problem.FromBodyError(err))
 		return
 	}
 
@@ -49,24 +39,31 @@ func (h *WriteHandler) HandleCreateProduct(w http.ResponseWriter, r *http.Reques
 		return
 	}*/
 
-	// TODO [technical debate] Create a mapper to parse data
-	err = h.ProductService.CreateProduct(r.Context(), domain.Product{
-		ID:          uuid.New().String(),
-		Name:        body.Name,
-		Description: body.Description,
-		Price:       body.Price,
-		Stock:       body.Stock,
-	})
+	product := productFromCreateRequest(body)
+	product.ID = uuid.New().String()
 
+	err := h.ProductService.CreateProduct(r.Context(), product)
 	if err != nil {
-		fmt.Printf("[ERROR] - Error creating product: %s\n", err.Error())
-		w.WriteHeader(http.StatusInternalServerError)
-		_, err = w.Write([]byte(fmt.Sprintf("error creating product")))
-		if err != nil {
-			return
-		}
+		problem.Error(w, r, "error creating product", err)
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
 }
+
+// productFromCreateRequest maps the request to the domain, it is shared with the bulk import rows.
+func productFromCreateRequest(body dto.CreateProductRequest) domain.Product {
+	product := domain.Product{
+		Name:            body.Name,
+		Description:     body.Description,
+		Price:           body.Price,
+		Stock:           body.Stock,
+		ReorderPoint:    body.ReorderPoint,
+		ReorderQuantity: body.ReorderQuantity,
+	}
+	if body.ExternalSKU != "" {
+		externalSKU := body.ExternalSKU
+		product.ExternalSKU = &externalSKU
+	}
+	return product
+}
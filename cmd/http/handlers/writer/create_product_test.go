@@ -3,15 +3,13 @@ package writer_test
 import (
 	"bytes"
 	"context"
-	"microservice-products-catalog/internal/domain"
-
 	"encoding/json"
 	"errors"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"microservice-products-catalog/cmd/http/handlers/writer"
 	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
-
+	"microservice-products-catalog/internal/domain"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -84,7 +82,7 @@ func TestHandleCreateProduct(t *testing.T) {
 			},
 			setupMock:            func(mock *mocks.MockProductService) {},
 			expectedStatus:       http.StatusBadRequest,
-			expectedBodyContains: "error reading body",
+			expectedBodyContains: `"type":"/problems/malformed-body"`,
 		},
 
 		{
@@ -111,10 +109,14 @@ func TestHandleCreateProduct(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
 			mockProductService := mocks.NewMockProductService(ctrl)
 			tc.setupMock(mockProductService)
 
-			writerHandler := writer.NewWriteHandler(mockProductService, mockOrderService)
+			writerHandler := writer.NewWriteHandler(mockProductService, mockOrderService, mockCategoryService, mockPromotionService, mockCustomerService, mockCartService)
 			recorder := httptest.NewRecorder()
 
 			if tc.setupRequest != nil {
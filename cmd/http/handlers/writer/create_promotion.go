@@ -0,0 +1,57 @@
+package writer
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+)
+
+// HandleCreatePromotion answers with the created promotion and its generated ID. The
+// promotions are managed by the shop, an admin token is required.
+func (h *WriteHandler) HandleCreatePromotion(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+	if !claims.IsAdmin() {
+		problem.WriteStatus(w, r, http.StatusForbidden, "promotions require an admin token")
+		return
+	}
+
+	var body dto.CreatePromotionRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	promotion := domain.Promotion{
+		ID:          uuid.New().String(),
+		Name:        body.Name,
+		Type:        domain.PromotionType(body.Type),
+		Percentage:  body.Percentage,
+		Amount:      body.Amount,
+		BuyQuantity: body.BuyQuantity,
+		GetQuantity: body.GetQuantity,
+		ProductID:   body.ProductID,
+		CouponCode:  body.CouponCode,
+		UsageLimit:  body.UsageLimit,
+		StartsAt:    body.StartsAt,
+		EndsAt:      body.EndsAt,
+		Active:      body.Active == nil || *body.Active,
+	}
+
+	err := h.PromotionService.CreatePromotion(r.Context(), promotion)
+	if err != nil {
+		problem.Error(w, r, "error creating promotion", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(promotion); err != nil {
+		return
+	}
+}
@@ -0,0 +1,119 @@
+package writer_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/handlers/writer"
+	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleCreatePromotion(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		claims               *auth.TokenClaims
+		body                 string
+		setupMock            func(mock *mocks.MockPromotionService)
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name:   "Success - 201 Created coupon, active by default",
+			claims: &adminClaims,
+			body:   `{"name": "Summer", "type": "fixed_amount", "amount": {"amount": "5.00", "currency": "USD"}, "coupon_code": "SUMMER5", "usage_limit": 100}`,
+			setupMock: func(mock *mocks.MockPromotionService) {
+				mock.EXPECT().
+					CreatePromotion(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p domain.Promotion) error {
+						assert.Equal(t, domain.PromotionFixedAmount, p.Type)
+						assert.Equal(t, domain.NewMoney(500, domain.BaseCurrency), *p.Amount)
+						assert.True(t, p.Active)
+						return nil
+					}).Times(1)
+			},
+			expectedStatus:       http.StatusCreated,
+			expectedBodyContains: `"coupon_code":"SUMMER5"`,
+		},
+		{
+			name:                 "Failure - 400 unknown type",
+			claims:               &adminClaims,
+			body:                 `{"name": "Gift", "type": "free_shipping"}`,
+			setupMock:            func(mock *mocks.MockPromotionService) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: `{"field":"type","detail":"must be one of: percentage, fixed_amount, buy_x_get_y"}`,
+		},
+		{
+			name:   "Failure - 400 invalid promotion",
+			claims: &adminClaims,
+			body:   `{"name": "3x2", "type": "buy_x_get_y", "buy_quantity": 2}`,
+			setupMock: func(mock *mocks.MockPromotionService) {
+				mock.EXPECT().CreatePromotion(gomock.Any(), gomock.Any()).Return(domain.ErrInvalidPromotion).Times(1)
+			},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: domain.ErrInvalidPromotion.Error(),
+		},
+		{
+			name:   "Failure - 409 coupon code already exists",
+			claims: &adminClaims,
+			body:   `{"name": "Summer", "type": "percentage", "percentage": 10, "coupon_code": "SUMMER10"}`,
+			setupMock: func(mock *mocks.MockPromotionService) {
+				mock.EXPECT().CreatePromotion(gomock.Any(), gomock.Any()).Return(domain.ErrCouponAlreadyExists).Times(1)
+			},
+			expectedStatus:       http.StatusConflict,
+			expectedBodyContains: domain.ErrCouponAlreadyExists.Error(),
+		},
+		{
+			name:                 "Failure - 401 without token",
+			body:                 `{"name": "Free", "type": "percentage", "percentage": 100, "coupon_code": "FREE"}`,
+			setupMock:            func(mock *mocks.MockPromotionService) {},
+			expectedStatus:       http.StatusUnauthorized,
+			expectedBodyContains: "Header Authorization is required",
+		},
+		{
+			name:                 "Failure - 403 customer token",
+			claims:               &customerClaims,
+			body:                 `{"name": "Free", "type": "percentage", "percentage": 100, "coupon_code": "FREE"}`,
+			setupMock:            func(mock *mocks.MockPromotionService) {},
+			expectedStatus:       http.StatusForbidden,
+			expectedBodyContains: "promotions require an admin token",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockPromotionService)
+
+			handler := writer.NewWriteHandler(mockProductService, mockOrderService, mockCategoryService, mockPromotionService, mockCustomerService, mockCartService)
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodPost, "/api/promotions", strings.NewReader(tc.body))
+			if tc.claims != nil {
+				request = withClaims(request, *tc.claims)
+			}
+
+			// Act
+			handler.HandleCreatePromotion(recorder, request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+		})
+	}
+}
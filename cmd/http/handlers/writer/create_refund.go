@@ -0,0 +1,55 @@
+package writer
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strings"
+)
+
+// HandleCreateRefund serves POST /api/orders/{id}/refunds, refunds are issued by customer
+// service so an admin token is required.
+func (h *WriteHandler) HandleCreateRefund(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+	if !claims.IsAdmin() {
+		problem.WriteStatus(w, r, http.StatusForbidden, "refunds require an admin token")
+		return
+	}
+
+	// /api/orders/{id}/refunds
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	orderID := parts[len(parts)-2]
+	if _, err := uuid.Parse(orderID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid order id format, must be UUID")
+		return
+	}
+
+	var body dto.CreateRefundRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	refund, err := h.OrderService.RefundOrder(r.Context(), domain.RefundRequest{
+		OrderID:  orderID,
+		Quantity: body.Quantity,
+		Reason:   body.Reason,
+		Restock:  body.Restock,
+	})
+	if err != nil {
+		problem.Error(w, r, "error refunding order", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(refund); err != nil {
+		return
+	}
+}
@@ -0,0 +1,101 @@
+package writer_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/handlers/writer"
+	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleCreateRefund(t *testing.T) {
+	orderID := "18eb9153-a00c-466d-8f38-f149806b054e"
+	path := "/api/orders/" + orderID + "/refunds"
+	body := `{"quantity": 1, "reason": "damaged", "restock": true}`
+	request := domain.RefundRequest{OrderID: orderID, Quantity: 1, Reason: "damaged", Restock: true}
+
+	testCases := []struct {
+		name                 string
+		request              *http.Request
+		setupMock            func(mock *mocks.MockOrderService)
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name:    "Success - 201 refund of one unit",
+			request: withClaims(httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)), adminClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().RefundOrder(gomock.Any(), request).
+					Return(&domain.Refund{ID: "refund-1", OrderID: orderID, Quantity: 1, Amount: domain.NewMoney(333, domain.BaseCurrency), Reason: "damaged", Restock: true}, nil).Times(1)
+			},
+			expectedStatus:       http.StatusCreated,
+			expectedBodyContains: `"amount":"3.33"`,
+		},
+		{
+			name:                 "Failure - 403 customer token",
+			request:              withClaims(httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)), customerClaims),
+			setupMock:            func(mock *mocks.MockOrderService) {},
+			expectedStatus:       http.StatusForbidden,
+			expectedBodyContains: "refunds require an admin token",
+		},
+		{
+			name:                 "Failure - 400 missing reason",
+			request:              withClaims(httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"quantity": 1}`)), adminClaims),
+			setupMock:            func(mock *mocks.MockOrderService) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: `{"field":"reason","detail":"is required"}`,
+		},
+		{
+			name:    "Failure - 409 quantity already refunded",
+			request: withClaims(httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)), adminClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().RefundOrder(gomock.Any(), request).Return(nil, domain.ErrRefundQuantityExceeded).Times(1)
+			},
+			expectedStatus:       http.StatusConflict,
+			expectedBodyContains: domain.ErrRefundQuantityExceeded.Error(),
+		},
+		{
+			name:    "Failure - 404 order not found",
+			request: withClaims(httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)), adminClaims),
+			setupMock: func(mock *mocks.MockOrderService) {
+				mock.EXPECT().RefundOrder(gomock.Any(), request).Return(nil, domain.ErrOrderNotFound).Times(1)
+			},
+			expectedStatus:       http.StatusNotFound,
+			expectedBodyContains: domain.ErrOrderNotFound.Error(),
+		},
+		{
+			name:                 "Failure - 400 invalid order id",
+			request:              withClaims(httptest.NewRequest(http.MethodPost, "/api/orders/last/refunds", strings.NewReader(body)), adminClaims),
+			setupMock:            func(mock *mocks.MockOrderService) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: "invalid order id format, must be UUID",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			tc.setupMock(mockOrderService)
+
+			handler := writer.NewWriteHandler(mocks.NewMockProductService(ctrl), mockOrderService, mocks.NewMockCategoryService(ctrl), mocks.NewMockPromotionService(ctrl), mocks.NewMockCustomerService(ctrl), mocks.NewMockCartService(ctrl))
+			recorder := httptest.NewRecorder()
+
+			// Act
+			handler.HandleCreateRefund(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+		})
+	}
+}
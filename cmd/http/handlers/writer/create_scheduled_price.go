@@ -0,0 +1,48 @@
+package writer
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strings"
+)
+
+// HandleCreateScheduledPrice serves POST /api/products/{id}/scheduled-prices, it answers with
+// the pending schedule, the price scheduler applies it once effective_at is reached.
+func (h *WriteHandler) HandleCreateScheduledPrice(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	productID := parts[len(parts)-2]
+	if _, err := uuid.Parse(productID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product id format, must be UUID")
+		return
+	}
+
+	var body dto.CreateScheduledPriceRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+	if body.Price.Currency != domain.BaseCurrency {
+		problem.Write(w, r, problem.Invalid(problem.FieldError{Field: "price.currency", Detail: "must be " + domain.BaseCurrency}))
+		return
+	}
+
+	scheduledPrice, err := h.ProductService.CreateScheduledPrice(r.Context(), domain.ScheduledPrice{
+		ProductID:   productID,
+		Price:       body.Price,
+		EffectiveAt: body.EffectiveAt,
+		EndsAt:      body.EndsAt,
+	})
+	if err != nil {
+		problem.Error(w, r, "error creating scheduled price", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(scheduledPrice); err != nil {
+		return
+	}
+}
@@ -0,0 +1,122 @@
+package writer_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/handlers/writer"
+	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandleCreateScheduledPrice(t *testing.T) {
+	productID := "b82a87a1-a15e-4767-8b26-99cbbcb8ae97"
+	effectiveAt := time.Date(2026, time.November, 27, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name                 string
+		path                 string
+		body                 string
+		setupMock            func(mock *mocks.MockProductService)
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name: "Success - 201 Created pending schedule",
+			path: "/api/products/" + productID + "/scheduled-prices",
+			body: `{"price": "7.99", "effective_at": "2026-11-27T00:00:00Z", "ends_at": "2026-11-30T00:00:00Z"}`,
+			setupMock: func(mock *mocks.MockProductService) {
+				mock.EXPECT().
+					CreateScheduledPrice(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s domain.ScheduledPrice) (domain.ScheduledPrice, error) {
+						assert.Equal(t, productID, s.ProductID)
+						assert.Equal(t, domain.NewMoney(799, domain.BaseCurrency), s.Price)
+						assert.True(t, effectiveAt.Equal(s.EffectiveAt))
+						s.ID = "6f1d2c3b-4a5e-4f60-8172-93a4b5c6d7e8"
+						s.Status = domain.ScheduledPricePending
+						return s, nil
+					}).Times(1)
+			},
+			expectedStatus:       http.StatusCreated,
+			expectedBodyContains: `"status":"pending"`,
+		},
+		{
+			name:                 "Failure - 400 missing effective_at",
+			path:                 "/api/products/" + productID + "/scheduled-prices",
+			body:                 `{"price": "7.99"}`,
+			setupMock:            func(mock *mocks.MockProductService) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: `{"field":"effective_at","detail":"is required"}`,
+		},
+		{
+			name:                 "Failure - 400 price in another currency",
+			path:                 "/api/products/" + productID + "/scheduled-prices",
+			body:                 `{"price": {"amount": "7.99", "currency": "EUR"}, "effective_at": "2026-11-27T00:00:00Z"}`,
+			setupMock:            func(mock *mocks.MockProductService) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: `{"field":"price.currency","detail":"must be USD"}`,
+		},
+		{
+			name:                 "Failure - 400 invalid product id",
+			path:                 "/api/products/-1/scheduled-prices",
+			body:                 `{"price": "7.99", "effective_at": "2026-11-27T00:00:00Z"}`,
+			setupMock:            func(mock *mocks.MockProductService) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: "invalid product id format, must be UUID",
+		},
+		{
+			name: "Failure - 409 overlapping schedule",
+			path: "/api/products/" + productID + "/scheduled-prices",
+			body: `{"price": "7.99", "effective_at": "2026-11-27T00:00:00Z"}`,
+			setupMock: func(mock *mocks.MockProductService) {
+				mock.EXPECT().CreateScheduledPrice(gomock.Any(), gomock.Any()).Return(domain.ScheduledPrice{}, domain.ErrScheduledPriceConflict).Times(1)
+			},
+			expectedStatus:       http.StatusConflict,
+			expectedBodyContains: domain.ErrScheduledPriceConflict.Error(),
+		},
+		{
+			name: "Failure - 404 product not found",
+			path: "/api/products/" + productID + "/scheduled-prices",
+			body: `{"price": "7.99", "effective_at": "2026-11-27T00:00:00Z"}`,
+			setupMock: func(mock *mocks.MockProductService) {
+				mock.EXPECT().CreateScheduledPrice(gomock.Any(), gomock.Any()).Return(domain.ScheduledPrice{}, domain.ErrProductNotFound).Times(1)
+			},
+			expectedStatus:       http.StatusNotFound,
+			expectedBodyContains: domain.ErrProductNotFound.Error(),
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockProductService)
+
+			handler := writer.NewWriteHandler(mockProductService, mockOrderService, mockCategoryService, mockPromotionService, mockCustomerService, mockCartService)
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(tc.body))
+
+			// Act
+			handler.HandleCreateScheduledPrice(recorder, request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+		})
+	}
+}
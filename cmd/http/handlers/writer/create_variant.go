@@ -0,0 +1,55 @@
+package writer
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strings"
+)
+
+// HandleCreateVariant serves POST /api/products/{id}/variants and answers with the created variant.
+func (h *WriteHandler) HandleCreateVariant(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	if len(parts) < 2 {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product id")
+		return
+	}
+
+	productID := parts[len(parts)-2]
+	if _, err := uuid.Parse(productID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product id format, must be UUID")
+		return
+	}
+
+	var body dto.CreateVariantRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	variant := domain.Variant{
+		ID:        uuid.New().String(),
+		ProductID: productID,
+		SKU:       body.SKU,
+		Options:   domain.VariantOptions(body.Options),
+		Price:     body.Price,
+		Stock:     body.Stock,
+	}
+	if variant.Options == nil {
+		variant.Options = domain.VariantOptions{}
+	}
+
+	err := h.ProductService.CreateVariant(r.Context(), variant)
+	if err != nil {
+		problem.Error(w, r, "error creating variant", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(variant); err != nil {
+		return
+	}
+}
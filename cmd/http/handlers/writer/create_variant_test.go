@@ -0,0 +1,115 @@
+package writer_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/cmd/http/handlers/writer"
+	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleCreateVariant(t *testing.T) {
+	shirtID := uuid.New().String()
+	path := fmt.Sprintf("/api/products/%s/variants", shirtID)
+	validBody := `{"sku":"SHIRT-M-BLUE","options":{"size":"M","colour":"blue"},"price":25,"stock":10}`
+
+	testCases := []struct {
+		name                 string
+		setupMock            func(mock *mocks.MockProductService)
+		request              *http.Request
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name: "Success - 201 Created",
+			setupMock: func(mock *mocks.MockProductService) {
+				mock.EXPECT().
+					CreateVariant(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ any, variant domain.Variant) error {
+						assert.Equal(t, shirtID, variant.ProductID)
+						assert.Equal(t, domain.VariantOptions{"size": "M", "colour": "blue"}, variant.Options)
+						assert.Equal(t, domain.NewMoney(2500, domain.BaseCurrency), *variant.Price)
+						return nil
+					}).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodPost, path, strings.NewReader(validBody)),
+			expectedStatus:       http.StatusCreated,
+			expectedBodyContains: `"sku":"SHIRT-M-BLUE"`,
+		},
+		{
+			name:                 "Failure - 400 missing SKU",
+			setupMock:            func(mock *mocks.MockProductService) {},
+			request:              httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"stock":10}`)),
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: `{"field":"sku","detail":"is required"}`,
+		},
+		{
+			name: "Failure - 404 product not found",
+			setupMock: func(mock *mocks.MockProductService) {
+				mock.EXPECT().CreateVariant(gomock.Any(), gomock.Any()).Return(domain.ErrProductNotFound).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodPost, path, strings.NewReader(validBody)),
+			expectedStatus:       http.StatusNotFound,
+			expectedBodyContains: "product not found",
+		},
+		{
+			name: "Failure - 409 duplicated SKU",
+			setupMock: func(mock *mocks.MockProductService) {
+				mock.EXPECT().CreateVariant(gomock.Any(), gomock.Any()).Return(domain.ErrVariantSKUAlreadyExists).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodPost, path, strings.NewReader(validBody)),
+			expectedStatus:       http.StatusConflict,
+			expectedBodyContains: "variant sku already exists",
+		},
+		{
+			name: "Failure - 500 Internal Server Error",
+			setupMock: func(mock *mocks.MockProductService) {
+				mock.EXPECT().CreateVariant(gomock.Any(), gomock.Any()).Return(errors.New("database is down")).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodPost, path, strings.NewReader(validBody)),
+			expectedStatus:       http.StatusInternalServerError,
+			expectedBodyContains: "error creating variant",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockProductService)
+
+			handler := writer.NewWriteHandler(mockProductService, mockOrderService, mockCategoryService, mockPromotionService, mockCustomerService, mockCartService)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			handler.HandleCreateVariant(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+			if tc.expectedStatus == http.StatusCreated {
+				var variant domain.Variant
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &variant))
+				assert.NotEmpty(t, variant.ID)
+			}
+		})
+	}
+}
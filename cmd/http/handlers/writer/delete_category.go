@@ -0,0 +1,25 @@
+package writer
+
+import (
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+	"strings"
+)
+
+func (h *WriteHandler) HandleDeleteCategory(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	categoryID := parts[len(parts)-1]
+	if _, err := uuid.Parse(categoryID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid category id format, must be UUID")
+		return
+	}
+
+	err := h.CategoryService.DeleteCategory(r.Context(), categoryID)
+	if err != nil {
+		problem.Error(w, r, "error deleting category", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,22 @@
+package writer
+
+import (
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+)
+
+// HandleDeleteCustomer refuses to delete a customer with orders, they must keep their buyer.
+func (h *WriteHandler) HandleDeleteCustomer(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := parseCustomerID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.CustomerService.DeleteCustomer(r.Context(), customerID)
+	if err != nil {
+		problem.Error(w, r, "error deleting customer", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -112,10 +112,14 @@ func TestHandleDeleteProduct(t *testing.T) {
 			defer mockCtrl.Finish()
 
 			mockOrderService := mocks.NewMockOrderService(mockCtrl)
+			mockCategoryService := mocks.NewMockCategoryService(mockCtrl)
+			mockPromotionService := mocks.NewMockPromotionService(mockCtrl)
+			mockCustomerService := mocks.NewMockCustomerService(mockCtrl)
+			mockCartService := mocks.NewMockCartService(mockCtrl)
 			mockProductService := mocks.NewMockProductService(mockCtrl)
 			tc.setupMock(mockProductService)
 
-			writerHandler := NewWriteHandler(mockProductService, mockOrderService)
+			writerHandler := NewWriteHandler(mockProductService, mockOrderService, mockCategoryService, mockPromotionService, mockCustomerService, mockCartService)
 			recorder := httptest.NewRecorder()
 
 			if tc.setupRequest != nil {
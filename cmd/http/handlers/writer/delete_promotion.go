@@ -0,0 +1,33 @@
+package writer
+
+import (
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+)
+
+// HandleDeletePromotion keeps the discounts already applied to orders, it requires an admin
+// token.
+func (h *WriteHandler) HandleDeletePromotion(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+	if !claims.IsAdmin() {
+		problem.WriteStatus(w, r, http.StatusForbidden, "promotions require an admin token")
+		return
+	}
+
+	promotionID, ok := parsePromotionID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.PromotionService.DeletePromotion(r.Context(), promotionID)
+	if err != nil {
+		problem.Error(w, r, "error deleting promotion", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
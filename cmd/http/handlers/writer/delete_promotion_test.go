@@ -0,0 +1,105 @@
+package writer_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/handlers/writer"
+	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandlePromotionWrites(t *testing.T) {
+	promotionID := "8a7b6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+	path := "/api/promotions/" + promotionID
+
+	testCases := []struct {
+		name                 string
+		request              *http.Request
+		handle               func(handler *writer.WriteHandler) http.HandlerFunc
+		setupMock            func(mock *mocks.MockPromotionService)
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name:    "Success - 204 admin deletes the promotion",
+			request: withClaims(httptest.NewRequest(http.MethodDelete, path, nil), adminClaims),
+			handle:  func(handler *writer.WriteHandler) http.HandlerFunc { return handler.HandleDeletePromotion },
+			setupMock: func(mock *mocks.MockPromotionService) {
+				mock.EXPECT().DeletePromotion(gomock.Any(), promotionID).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                 "Failure - 401 delete without token",
+			request:              httptest.NewRequest(http.MethodDelete, path, nil),
+			handle:               func(handler *writer.WriteHandler) http.HandlerFunc { return handler.HandleDeletePromotion },
+			setupMock:            func(mock *mocks.MockPromotionService) {},
+			expectedStatus:       http.StatusUnauthorized,
+			expectedBodyContains: "Header Authorization is required",
+		},
+		{
+			name:                 "Failure - 403 delete with a customer token",
+			request:              withClaims(httptest.NewRequest(http.MethodDelete, path, nil), customerClaims),
+			handle:               func(handler *writer.WriteHandler) http.HandlerFunc { return handler.HandleDeletePromotion },
+			setupMock:            func(mock *mocks.MockPromotionService) {},
+			expectedStatus:       http.StatusForbidden,
+			expectedBodyContains: "promotions require an admin token",
+		},
+		{
+			name:    "Success - 204 admin updates the promotion",
+			request: withClaims(httptest.NewRequest(http.MethodPut, path, strings.NewReader(`{"name": "Winter"}`)), adminClaims),
+			handle:  func(handler *writer.WriteHandler) http.HandlerFunc { return handler.HandleUpdatePromotion },
+			setupMock: func(mock *mocks.MockPromotionService) {
+				mock.EXPECT().UpdatePromotion(gomock.Any(), promotionID, gomock.Any()).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                 "Failure - 401 update without token",
+			request:              httptest.NewRequest(http.MethodPut, path, strings.NewReader(`{"percentage": 100}`)),
+			handle:               func(handler *writer.WriteHandler) http.HandlerFunc { return handler.HandleUpdatePromotion },
+			setupMock:            func(mock *mocks.MockPromotionService) {},
+			expectedStatus:       http.StatusUnauthorized,
+			expectedBodyContains: "Header Authorization is required",
+		},
+		{
+			name:                 "Failure - 403 update with a customer token",
+			request:              withClaims(httptest.NewRequest(http.MethodPut, path, strings.NewReader(`{"percentage": 100}`)), customerClaims),
+			handle:               func(handler *writer.WriteHandler) http.HandlerFunc { return handler.HandleUpdatePromotion },
+			setupMock:            func(mock *mocks.MockPromotionService) {},
+			expectedStatus:       http.StatusForbidden,
+			expectedBodyContains: "promotions require an admin token",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockPromotionService)
+
+			handler := writer.NewWriteHandler(mockProductService, mockOrderService, mockCategoryService, mockPromotionService, mockCustomerService, mockCartService)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			tc.handle(handler)(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package writer
+
+import (
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+)
+
+// HandleDeleteVariant serves DELETE /api/products/{id}/variants/{variantID}, variants
+// already ordered cannot be deleted.
+func (h *WriteHandler) HandleDeleteVariant(w http.ResponseWriter, r *http.Request) {
+	productID, variantID, ok := parseVariantPath(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.ProductService.DeleteVariant(r.Context(), productID, variantID)
+	if err != nil {
+		problem.Error(w, r, "error deleting variant", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
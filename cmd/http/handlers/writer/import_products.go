@@ -0,0 +1,97 @@
+package writer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const maxImportBatchSize = 1000
+
+func (h *WriteHandler) HandleImportProducts(w http.ResponseWriter, r *http.Request) {
+	options, err := parseImportOptions(r)
+	if err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, fmt.Sprintf("error parsing import options: %s", err))
+		return
+	}
+
+	// a large import takes longer than the server timeouts allow, it gets ImportTimeout to be
+	// read and answered instead
+	if h.ImportTimeout > 0 {
+		deadline := time.Now().Add(h.ImportTimeout)
+		controller := http.NewResponseController(w)
+		if err := errors.Join(controller.SetReadDeadline(deadline), controller.SetWriteDeadline(deadline)); err != nil {
+			logging.FromContext(r.Context()).Warn("error extending the import deadlines", "error", err)
+		}
+	}
+
+	// the body is parsed while the rows are imported, it is never read in full. Past
+	// MaxImportBytes the row being read fails and the import stops there.
+	if h.MaxImportBytes > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, h.MaxImportBytes)
+	}
+	var rows domain.ProductImportRows
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/csv":
+		rows = csvImportRows(r.Body)
+	case "application/x-ndjson", "application/ndjson":
+		rows = ndjsonImportRows(r.Body)
+	default:
+		problem.WriteStatus(w, r, http.StatusUnsupportedMediaType, "Content-Type must be text/csv or application/x-ndjson")
+		return
+	}
+
+	report, err := h.ProductService.ImportProducts(r.Context(), rows, options)
+	if err != nil {
+		problem.Error(w, r, "error importing products", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	reportResponse, err := json.Marshal(report)
+	if err != nil {
+		_, err = w.Write([]byte(err.Error()))
+		if err != nil {
+			return
+		}
+	}
+	_, err = w.Write(reportResponse)
+	if err != nil {
+		return
+	}
+}
+
+func parseImportOptions(request *http.Request) (domain.ProductImportOptions, error) {
+	var options domain.ProductImportOptions
+
+	if dryRunStr := request.URL.Query().Get("dry_run"); dryRunStr != "" {
+		dryRun, err := strconv.ParseBool(dryRunStr)
+		if err != nil {
+			return options, errors.New("dry_run must be a boolean")
+		}
+		options.DryRun = dryRun
+	}
+
+	if batchSizeStr := request.URL.Query().Get("batch_size"); batchSizeStr != "" {
+		batchSize, err := strconv.Atoi(batchSizeStr)
+		if err != nil {
+			return options, errors.New("batch_size must be an integer")
+		}
+		if batchSize <= 0 || batchSize > maxImportBatchSize {
+			return options, fmt.Errorf("batch_size must be between 1 and %d", maxImportBatchSize)
+		}
+		options.BatchSize = batchSize
+	}
+
+	return options, nil
+}
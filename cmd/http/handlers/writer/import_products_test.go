@@ -0,0 +1,204 @@
+package writer_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"microservice-products-catalog/cmd/http/handlers/writer"
+	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHandleImportProducts(t *testing.T) {
+	csvBody := "name,description,price,stock,external_sku\n" +
+		"Gopher,Realistic replic for the Gopher animal,12.21,50,GOPH-001\n" +
+		"Rusty,Realistic replic for the Rusty animal,not-a-number,10,\n" +
+		"Ferris,,3.50,10,\n"
+
+	ndjsonBody := `{"name":"Gopher","description":"Realistic replic for the Gopher animal","price":12.21,"stock":50}` + "\n" +
+		`{"name":"Rusty",` + "\n"
+
+	// collectRows consumes the rows like the service does and returns them to be asserted
+	collectRows := func(got *[]domain.ProductImportRow) func(context.Context, domain.ProductImportRows, domain.ProductImportOptions) (domain.ProductImportReport, error) {
+		return func(_ context.Context, rows domain.ProductImportRows, options domain.ProductImportOptions) (domain.ProductImportReport, error) {
+			for row := range rows {
+				*got = append(*got, row)
+			}
+			return domain.ProductImportReport{DryRun: options.DryRun}, nil
+		}
+	}
+
+	testCases := []struct {
+		testName             string
+		request              *http.Request
+		contentType          string
+		setupMock            func(mock *mocks.MockProductService, rows *[]domain.ProductImportRow)
+		assertRows           func(t *testing.T, rows []domain.ProductImportRow)
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			testName:    "Success - 200 CSV rows are parsed and validated",
+			request:     httptest.NewRequest(http.MethodPost, "/api/products/import?dry_run=true&batch_size=50", strings.NewReader(csvBody)),
+			contentType: "text/csv",
+			setupMock: func(mock *mocks.MockProductService, rows *[]domain.ProductImportRow) {
+				mock.EXPECT().
+					ImportProducts(gomock.Any(), gomock.Any(), domain.ProductImportOptions{DryRun: true, BatchSize: 50}).
+					DoAndReturn(collectRows(rows)).Times(1)
+			},
+			assertRows: func(t *testing.T, rows []domain.ProductImportRow) {
+				assert.Len(t, rows, 3)
+				assert.Equal(t, 2, rows[0].Line)
+				assert.Empty(t, rows[0].Error)
+				assert.Equal(t, "Gopher", rows[0].Product.Name)
+				assert.Equal(t, "GOPH-001", *rows[0].Product.ExternalSKU)
+				assert.Contains(t, rows[1].Error, "price must be a decimal amount")
+				assert.Contains(t, rows[2].Error, "DTO validation error")
+			},
+			expectedStatus:       http.StatusOK,
+			expectedBodyContains: `"dry_run":true`,
+		},
+		{
+			testName:    "Success - 200 NDJSON malformed line only fails its row",
+			request:     httptest.NewRequest(http.MethodPost, "/api/products/import", strings.NewReader(ndjsonBody)),
+			contentType: "application/x-ndjson",
+			setupMock: func(mock *mocks.MockProductService, rows *[]domain.ProductImportRow) {
+				mock.EXPECT().
+					ImportProducts(gomock.Any(), gomock.Any(), domain.ProductImportOptions{}).
+					DoAndReturn(collectRows(rows)).Times(1)
+			},
+			assertRows: func(t *testing.T, rows []domain.ProductImportRow) {
+				assert.Len(t, rows, 2)
+				assert.Empty(t, rows[0].Error)
+				assert.Equal(t, 2, rows[1].Line)
+				assert.Contains(t, rows[1].Error, "error reading row")
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			testName:             "Failure - 415 Unsupported Content-Type",
+			request:              httptest.NewRequest(http.MethodPost, "/api/products/import", strings.NewReader(csvBody)),
+			contentType:          "application/json",
+			setupMock:            func(mock *mocks.MockProductService, rows *[]domain.ProductImportRow) {},
+			expectedStatus:       http.StatusUnsupportedMediaType,
+			expectedBodyContains: "Content-Type must be text/csv or application/x-ndjson",
+		},
+		{
+			testName:             "Failure - 400 Invalid batch size",
+			request:              httptest.NewRequest(http.MethodPost, "/api/products/import?batch_size=0", strings.NewReader(csvBody)),
+			contentType:          "text/csv",
+			setupMock:            func(mock *mocks.MockProductService, rows *[]domain.ProductImportRow) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: "batch_size must be between 1 and 1000",
+		},
+		{
+			testName:             "Failure - 400 Invalid dry run",
+			request:              httptest.NewRequest(http.MethodPost, "/api/products/import?dry_run=maybe", strings.NewReader(csvBody)),
+			contentType:          "text/csv",
+			setupMock:            func(mock *mocks.MockProductService, rows *[]domain.ProductImportRow) {},
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: `"detail":"error parsing import options: dry_run must be a boolean","instance"`,
+		},
+		{
+			testName:    "Failure - 500 Internal Server Error",
+			request:     httptest.NewRequest(http.MethodPost, "/api/products/import", strings.NewReader(csvBody)),
+			contentType: "text/csv",
+			setupMock: func(mock *mocks.MockProductService, rows *[]domain.ProductImportRow) {
+				mock.EXPECT().
+					ImportProducts(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(domain.ProductImportReport{}, errors.New("context canceled")).Times(1)
+			},
+			expectedStatus:       http.StatusInternalServerError,
+			expectedBodyContains: "error importing products",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			var rows []domain.ProductImportRow
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			tc.setupMock(mockProductService, &rows)
+
+			writerHandler := writer.NewWriteHandler(mockProductService, mockOrderService, mockCategoryService, mockPromotionService, mockCustomerService, mockCartService)
+			recorder := httptest.NewRecorder()
+			tc.request.Header.Set("Content-Type", tc.contentType)
+
+			// Act
+			writerHandler.HandleImportProducts(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedBodyContains != "" {
+				assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+			}
+			if tc.assertRows != nil {
+				tc.assertRows(t, rows)
+			}
+		})
+	}
+}
+
+func TestHandleImportProducts_SlowBody(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+
+	imported := make(chan int, 1)
+	mockProductService := mocks.NewMockProductService(ctrl)
+	mockProductService.EXPECT().
+		ImportProducts(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, rows domain.ProductImportRows, _ domain.ProductImportOptions) (domain.ProductImportReport, error) {
+			count := 0
+			for range rows {
+				count++
+			}
+			imported <- count
+			return domain.ProductImportReport{}, nil
+		}).Times(1)
+
+	writerHandler := writer.NewWriteHandler(mockProductService, mocks.NewMockOrderService(ctrl), mocks.NewMockCategoryService(ctrl), mocks.NewMockPromotionService(ctrl), mocks.NewMockCustomerService(ctrl), mocks.NewMockCartService(ctrl))
+	writerHandler.ImportTimeout = 5 * time.Second
+
+	// the body takes longer to arrive than the server read and write timeouts
+	server := httptest.NewUnstartedServer(http.HandlerFunc(writerHandler.HandleImportProducts))
+	server.Config.ReadTimeout = 100 * time.Millisecond
+	server.Config.WriteTimeout = 100 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	body, writer := io.Pipe()
+	go func() {
+		_, _ = io.WriteString(writer, "name,description,price,stock,external_sku\n")
+		for _, name := range []string{"Gopher", "Rusty", "Ferris"} {
+			time.Sleep(100 * time.Millisecond)
+			_, _ = io.WriteString(writer, name+",,3.50,10,\n")
+		}
+		_ = writer.Close()
+	}()
+
+	// Act
+	response, err := http.Post(server.URL, "text/csv", body)
+
+	// Assert
+	require.NoError(t, err)
+	defer response.Body.Close()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, 3, <-imported)
+}
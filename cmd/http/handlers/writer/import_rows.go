@@ -0,0 +1,158 @@
+package writer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/go-playground/validator.v9"
+	"io"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/internal/domain"
+	"strconv"
+	"strings"
+)
+
+// csvImportRows reads a CSV with a header row, the columns are named after the JSON
+// fields of dto.CreateProductRequest and may come in any order.
+func csvImportRows(body io.Reader) domain.ProductImportRows {
+	return func(yield func(domain.ProductImportRow) bool) {
+		reader := csv.NewReader(body)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+
+		header, err := reader.Read()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				yield(domain.ProductImportRow{Line: 1, Error: fmt.Sprintf("error reading header: %s", err)})
+			}
+			return
+		}
+
+		columns := make(map[string]int, len(header))
+		for i, column := range header {
+			columns[strings.ToLower(strings.TrimSpace(column))] = i
+		}
+		for _, required := range []string{"name", "description", "price", "stock"} {
+			if _, ok := columns[required]; !ok {
+				yield(domain.ProductImportRow{Line: 1, Error: fmt.Sprintf("missing column %q in header", required)})
+				return
+			}
+		}
+
+		validate := dto.NewValidator()
+		for {
+			record, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			line, _ := reader.FieldPos(0)
+
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				if !yield(domain.ProductImportRow{Line: parseErr.Line, Error: parseErr.Err.Error()}) {
+					return
+				}
+				continue
+			}
+			if err != nil {
+				// the body can not be read anymore
+				yield(domain.ProductImportRow{Line: line, Error: fmt.Sprintf("error reading body: %s", err)})
+				return
+			}
+
+			if !yield(csvImportRow(validate, line, columns, record)) {
+				return
+			}
+		}
+	}
+}
+
+func csvImportRow(validate *validator.Validate, line int, columns map[string]int, record []string) domain.ProductImportRow {
+	field := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var body dto.CreateProductRequest
+	var err error
+
+	body.Name = field("name")
+	body.Description = field("description")
+	body.ExternalSKU = field("external_sku")
+	if body.Price, err = parseImportMoney(field("price")); err != nil {
+		return domain.ProductImportRow{Line: line, Error: fmt.Sprintf("price must be a decimal amount: %s", err)}
+	}
+	if body.Stock, err = parseImportInt(field("stock")); err != nil {
+		return domain.ProductImportRow{Line: line, Error: fmt.Sprintf("stock must be an integer: %s", err)}
+	}
+	if body.ReorderPoint, err = parseImportInt(field("reorder_point")); err != nil {
+		return domain.ProductImportRow{Line: line, Error: fmt.Sprintf("reorder_point must be an integer: %s", err)}
+	}
+	if body.ReorderQuantity, err = parseImportInt(field("reorder_quantity")); err != nil {
+		return domain.ProductImportRow{Line: line, Error: fmt.Sprintf("reorder_quantity must be an integer: %s", err)}
+	}
+
+	return importRow(validate, line, body)
+}
+
+// ndjsonImportRows reads one dto.CreateProductRequest JSON object per line, a malformed
+// line only fails that row.
+func ndjsonImportRows(body io.Reader) domain.ProductImportRows {
+	return func(yield func(domain.ProductImportRow) bool) {
+		reader := bufio.NewReader(body)
+		validate := dto.NewValidator()
+
+		for line := 1; ; line++ {
+			raw, err := reader.ReadBytes('\n')
+			if err != nil && !errors.Is(err, io.EOF) {
+				yield(domain.ProductImportRow{Line: line, Error: fmt.Sprintf("error reading body: %s", err)})
+				return
+			}
+
+			if raw = bytes.TrimSpace(raw); len(raw) > 0 {
+				var request dto.CreateProductRequest
+				row := domain.ProductImportRow{Line: line}
+				if jsonErr := json.Unmarshal(raw, &request); jsonErr != nil {
+					row.Error = fmt.Sprintf("error reading row: %s", jsonErr)
+				} else {
+					row = importRow(validate, line, request)
+				}
+				if !yield(row) {
+					return
+				}
+			}
+
+			if errors.Is(err, io.EOF) {
+				return
+			}
+		}
+	}
+}
+
+// importRow applies the same validations as the single product creation.
+func importRow(validate *validator.Validate, line int, body dto.CreateProductRequest) domain.ProductImportRow {
+	if err := validate.Struct(body); err != nil {
+		return domain.ProductImportRow{Line: line, Error: fmt.Sprintf("DTO validation error: %s", err)}
+	}
+	return domain.ProductImportRow{Line: line, Product: productFromCreateRequest(body)}
+}
+
+func parseImportMoney(value string) (domain.Money, error) {
+	if value == "" {
+		return domain.Money{}, nil
+	}
+	return domain.ParseMoney(value, domain.BaseCurrency)
+}
+
+func parseImportInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
@@ -49,6 +49,35 @@ func (mr *MockProductServiceMockRecorder) CreateProduct(ctx, product interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockProductService)(nil).CreateProduct), ctx, product)
 }
 
+// CreateScheduledPrice mocks base method.
+func (m *MockProductService) CreateScheduledPrice(ctx context.Context, scheduledPrice domain.ScheduledPrice) (domain.ScheduledPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledPrice", ctx, scheduledPrice)
+	ret0, _ := ret[0].(domain.ScheduledPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledPrice indicates an expected call of CreateScheduledPrice.
+func (mr *MockProductServiceMockRecorder) CreateScheduledPrice(ctx, scheduledPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledPrice", reflect.TypeOf((*MockProductService)(nil).CreateScheduledPrice), ctx, scheduledPrice)
+}
+
+// CreateVariant mocks base method.
+func (m *MockProductService) CreateVariant(ctx context.Context, variant domain.Variant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVariant", ctx, variant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVariant indicates an expected call of CreateVariant.
+func (mr *MockProductServiceMockRecorder) CreateVariant(ctx, variant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVariant", reflect.TypeOf((*MockProductService)(nil).CreateVariant), ctx, variant)
+}
+
 // DeleteProduct mocks base method.
 func (m *MockProductService) DeleteProduct(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -63,6 +92,63 @@ func (mr *MockProductServiceMockRecorder) DeleteProduct(ctx, id interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductService)(nil).DeleteProduct), ctx, id)
 }
 
+// DeleteProductPrice mocks base method.
+func (m *MockProductService) DeleteProductPrice(ctx context.Context, productID, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductPrice", ctx, productID, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductPrice indicates an expected call of DeleteProductPrice.
+func (mr *MockProductServiceMockRecorder) DeleteProductPrice(ctx, productID, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductPrice", reflect.TypeOf((*MockProductService)(nil).DeleteProductPrice), ctx, productID, currency)
+}
+
+// DeleteVariant mocks base method.
+func (m *MockProductService) DeleteVariant(ctx context.Context, productID, variantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVariant", ctx, productID, variantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVariant indicates an expected call of DeleteVariant.
+func (mr *MockProductServiceMockRecorder) DeleteVariant(ctx, productID, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVariant", reflect.TypeOf((*MockProductService)(nil).DeleteVariant), ctx, productID, variantID)
+}
+
+// ImportProducts mocks base method.
+func (m *MockProductService) ImportProducts(ctx context.Context, rows domain.ProductImportRows, options domain.ProductImportOptions) (domain.ProductImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportProducts", ctx, rows, options)
+	ret0, _ := ret[0].(domain.ProductImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportProducts indicates an expected call of ImportProducts.
+func (mr *MockProductServiceMockRecorder) ImportProducts(ctx, rows, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportProducts", reflect.TypeOf((*MockProductService)(nil).ImportProducts), ctx, rows, options)
+}
+
+// SetProductPrice mocks base method.
+func (m *MockProductService) SetProductPrice(ctx context.Context, productID string, price domain.Money) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductPrice", ctx, productID, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProductPrice indicates an expected call of SetProductPrice.
+func (mr *MockProductServiceMockRecorder) SetProductPrice(ctx, productID, price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductPrice", reflect.TypeOf((*MockProductService)(nil).SetProductPrice), ctx, productID, price)
+}
+
 // UpdateProduct mocks base method.
 func (m *MockProductService) UpdateProduct(ctx context.Context, product *domain.Product) error {
 	m.ctrl.T.Helper()
@@ -77,6 +163,20 @@ func (mr *MockProductServiceMockRecorder) UpdateProduct(ctx, product interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockProductService)(nil).UpdateProduct), ctx, product)
 }
 
+// UpdateVariant mocks base method.
+func (m *MockProductService) UpdateVariant(ctx context.Context, productID, variantID string, update domain.VariantUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", ctx, productID, variantID, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockProductServiceMockRecorder) UpdateVariant(ctx, productID, variantID, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockProductService)(nil).UpdateVariant), ctx, productID, variantID, update)
+}
+
 // MockOrderService is a mock of OrderService interface.
 type MockOrderService struct {
 	ctrl     *gomock.Controller
@@ -101,15 +201,353 @@ func (m *MockOrderService) EXPECT() *MockOrderServiceMockRecorder {
 }
 
 // CreateOrder mocks base method.
-func (m *MockOrderService) CreateOrder(ctx context.Context, productID string, quantity int) error {
+func (m *MockOrderService) CreateOrder(ctx context.Context, request domain.OrderRequest) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrder", ctx, productID, quantity)
+	ret := m.ctrl.Call(m, "CreateOrder", ctx, request)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateOrder indicates an expected call of CreateOrder.
-func (mr *MockOrderServiceMockRecorder) CreateOrder(ctx, productID, quantity interface{}) *gomock.Call {
+func (mr *MockOrderServiceMockRecorder) CreateOrder(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderService)(nil).CreateOrder), ctx, request)
+}
+
+// RefundOrder mocks base method.
+func (m *MockOrderService) RefundOrder(ctx context.Context, request domain.RefundRequest) (*domain.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundOrder", ctx, request)
+	ret0, _ := ret[0].(*domain.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundOrder indicates an expected call of RefundOrder.
+func (mr *MockOrderServiceMockRecorder) RefundOrder(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockOrderService)(nil).RefundOrder), ctx, request)
+}
+
+// MockCategoryService is a mock of CategoryService interface.
+type MockCategoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryServiceMockRecorder
+}
+
+// MockCategoryServiceMockRecorder is the mock recorder for MockCategoryService.
+type MockCategoryServiceMockRecorder struct {
+	mock *MockCategoryService
+}
+
+// NewMockCategoryService creates a new mock instance.
+func NewMockCategoryService(ctrl *gomock.Controller) *MockCategoryService {
+	mock := &MockCategoryService{ctrl: ctrl}
+	mock.recorder = &MockCategoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCategoryService) EXPECT() *MockCategoryServiceMockRecorder {
+	return m.recorder
+}
+
+// AddProductToCategory mocks base method.
+func (m *MockCategoryService) AddProductToCategory(ctx context.Context, categoryID, productID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProductToCategory", ctx, categoryID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddProductToCategory indicates an expected call of AddProductToCategory.
+func (mr *MockCategoryServiceMockRecorder) AddProductToCategory(ctx, categoryID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductToCategory", reflect.TypeOf((*MockCategoryService)(nil).AddProductToCategory), ctx, categoryID, productID)
+}
+
+// CreateCategory mocks base method.
+func (m *MockCategoryService) CreateCategory(ctx context.Context, category domain.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockCategoryServiceMockRecorder) CreateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockCategoryService)(nil).CreateCategory), ctx, category)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockCategoryService) DeleteCategory(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockCategoryServiceMockRecorder) DeleteCategory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockCategoryService)(nil).DeleteCategory), ctx, id)
+}
+
+// RemoveProductFromCategory mocks base method.
+func (m *MockCategoryService) RemoveProductFromCategory(ctx context.Context, categoryID, productID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveProductFromCategory", ctx, categoryID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveProductFromCategory indicates an expected call of RemoveProductFromCategory.
+func (mr *MockCategoryServiceMockRecorder) RemoveProductFromCategory(ctx, categoryID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProductFromCategory", reflect.TypeOf((*MockCategoryService)(nil).RemoveProductFromCategory), ctx, categoryID, productID)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockCategoryService) UpdateCategory(ctx context.Context, id string, update domain.CategoryUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockCategoryServiceMockRecorder) UpdateCategory(ctx, id, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockCategoryService)(nil).UpdateCategory), ctx, id, update)
+}
+
+// MockPromotionService is a mock of PromotionService interface.
+type MockPromotionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionServiceMockRecorder
+}
+
+// MockPromotionServiceMockRecorder is the mock recorder for MockPromotionService.
+type MockPromotionServiceMockRecorder struct {
+	mock *MockPromotionService
+}
+
+// NewMockPromotionService creates a new mock instance.
+func NewMockPromotionService(ctrl *gomock.Controller) *MockPromotionService {
+	mock := &MockPromotionService{ctrl: ctrl}
+	mock.recorder = &MockPromotionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionService) EXPECT() *MockPromotionServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePromotion mocks base method.
+func (m *MockPromotionService) CreatePromotion(ctx context.Context, promotion domain.Promotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromotion", ctx, promotion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromotion indicates an expected call of CreatePromotion.
+func (mr *MockPromotionServiceMockRecorder) CreatePromotion(ctx, promotion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotion", reflect.TypeOf((*MockPromotionService)(nil).CreatePromotion), ctx, promotion)
+}
+
+// DeletePromotion mocks base method.
+func (m *MockPromotionService) DeletePromotion(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePromotion", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePromotion indicates an expected call of DeletePromotion.
+func (mr *MockPromotionServiceMockRecorder) DeletePromotion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePromotion", reflect.TypeOf((*MockPromotionService)(nil).DeletePromotion), ctx, id)
+}
+
+// UpdatePromotion mocks base method.
+func (m *MockPromotionService) UpdatePromotion(ctx context.Context, id string, update domain.PromotionUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePromotion", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePromotion indicates an expected call of UpdatePromotion.
+func (mr *MockPromotionServiceMockRecorder) UpdatePromotion(ctx, id, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePromotion", reflect.TypeOf((*MockPromotionService)(nil).UpdatePromotion), ctx, id, update)
+}
+
+// MockCustomerService is a mock of CustomerService interface.
+type MockCustomerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerServiceMockRecorder
+}
+
+// MockCustomerServiceMockRecorder is the mock recorder for MockCustomerService.
+type MockCustomerServiceMockRecorder struct {
+	mock *MockCustomerService
+}
+
+// NewMockCustomerService creates a new mock instance.
+func NewMockCustomerService(ctrl *gomock.Controller) *MockCustomerService {
+	mock := &MockCustomerService{ctrl: ctrl}
+	mock.recorder = &MockCustomerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerService) EXPECT() *MockCustomerServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateCustomer mocks base method.
+func (m *MockCustomerService) CreateCustomer(ctx context.Context, customer domain.Customer) (domain.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomer", ctx, customer)
+	ret0, _ := ret[0].(domain.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomer indicates an expected call of CreateCustomer.
+func (mr *MockCustomerServiceMockRecorder) CreateCustomer(ctx, customer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockCustomerService)(nil).CreateCustomer), ctx, customer)
+}
+
+// DeleteCustomer mocks base method.
+func (m *MockCustomerService) DeleteCustomer(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomer", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCustomer indicates an expected call of DeleteCustomer.
+func (mr *MockCustomerServiceMockRecorder) DeleteCustomer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomer", reflect.TypeOf((*MockCustomerService)(nil).DeleteCustomer), ctx, id)
+}
+
+// UpdateCustomer mocks base method.
+func (m *MockCustomerService) UpdateCustomer(ctx context.Context, id string, update domain.CustomerUpdate) (*domain.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomer", ctx, id, update)
+	ret0, _ := ret[0].(*domain.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomer indicates an expected call of UpdateCustomer.
+func (mr *MockCustomerServiceMockRecorder) UpdateCustomer(ctx, id, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomer", reflect.TypeOf((*MockCustomerService)(nil).UpdateCustomer), ctx, id, update)
+}
+
+// MockCartService is a mock of CartService interface.
+type MockCartService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartServiceMockRecorder
+}
+
+// MockCartServiceMockRecorder is the mock recorder for MockCartService.
+type MockCartServiceMockRecorder struct {
+	mock *MockCartService
+}
+
+// NewMockCartService creates a new mock instance.
+func NewMockCartService(ctrl *gomock.Controller) *MockCartService {
+	mock := &MockCartService{ctrl: ctrl}
+	mock.recorder = &MockCartServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCartService) EXPECT() *MockCartServiceMockRecorder {
+	return m.recorder
+}
+
+// AddCartItem mocks base method.
+func (m *MockCartService) AddCartItem(ctx context.Context, cartID, customerID, productID, variantID string, quantity int) (*domain.CartView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCartItem", ctx, cartID, customerID, productID, variantID, quantity)
+	ret0, _ := ret[0].(*domain.CartView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCartItem indicates an expected call of AddCartItem.
+func (mr *MockCartServiceMockRecorder) AddCartItem(ctx, cartID, customerID, productID, variantID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCartItem", reflect.TypeOf((*MockCartService)(nil).AddCartItem), ctx, cartID, customerID, productID, variantID, quantity)
+}
+
+// Checkout mocks base method.
+func (m *MockCartService) Checkout(ctx context.Context, cartID, customerID string, options domain.CheckoutOptions) ([]domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, cartID, customerID, options)
+	ret0, _ := ret[0].([]domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockCartServiceMockRecorder) Checkout(ctx, cartID, customerID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockCartService)(nil).Checkout), ctx, cartID, customerID, options)
+}
+
+// CreateCart mocks base method.
+func (m *MockCartService) CreateCart(ctx context.Context, customerID string) (domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCart", ctx, customerID)
+	ret0, _ := ret[0].(domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCart indicates an expected call of CreateCart.
+func (mr *MockCartServiceMockRecorder) CreateCart(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCart", reflect.TypeOf((*MockCartService)(nil).CreateCart), ctx, customerID)
+}
+
+// RemoveCartItem mocks base method.
+func (m *MockCartService) RemoveCartItem(ctx context.Context, cartID, customerID, itemID string) (*domain.CartView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCartItem", ctx, cartID, customerID, itemID)
+	ret0, _ := ret[0].(*domain.CartView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCartItem indicates an expected call of RemoveCartItem.
+func (mr *MockCartServiceMockRecorder) RemoveCartItem(ctx, cartID, customerID, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCartItem", reflect.TypeOf((*MockCartService)(nil).RemoveCartItem), ctx, cartID, customerID, itemID)
+}
+
+// UpdateCartItem mocks base method.
+func (m *MockCartService) UpdateCartItem(ctx context.Context, cartID, customerID, itemID string, quantity int) (*domain.CartView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCartItem", ctx, cartID, customerID, itemID, quantity)
+	ret0, _ := ret[0].(*domain.CartView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCartItem indicates an expected call of UpdateCartItem.
+func (mr *MockCartServiceMockRecorder) UpdateCartItem(ctx, cartID, customerID, itemID, quantity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderService)(nil).CreateOrder), ctx, productID, quantity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCartItem", reflect.TypeOf((*MockCartService)(nil).UpdateCartItem), ctx, cartID, customerID, itemID, quantity)
 }
@@ -0,0 +1,75 @@
+package writer
+
+import (
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strings"
+)
+
+// HandleSetProductPrice serves PUT /api/products/{id}/prices/{currency}, it creates or
+// replaces the list price of the product in that currency.
+func (h *WriteHandler) HandleSetProductPrice(w http.ResponseWriter, r *http.Request) {
+	productID, currency, ok := parseProductPricePath(w, r)
+	if !ok {
+		return
+	}
+
+	var body dto.SetProductPriceRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	price, err := domain.ParseMoney(body.Amount, currency)
+	if err != nil || price.Amount < 10 {
+		problem.Write(w, r, problem.Invalid(problem.FieldError{Field: "amount", Detail: "must be a decimal amount of at least 0.10"}))
+		return
+	}
+
+	err = h.ProductService.SetProductPrice(r.Context(), productID, price)
+	if err != nil {
+		problem.Error(w, r, "error setting product price", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleDeleteProductPrice serves DELETE /api/products/{id}/prices/{currency}, orders in
+// that currency go back to converting the base price.
+func (h *WriteHandler) HandleDeleteProductPrice(w http.ResponseWriter, r *http.Request) {
+	productID, currency, ok := parseProductPricePath(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.ProductService.DeleteProductPrice(r.Context(), productID, currency)
+	if err != nil {
+		problem.Error(w, r, "error deleting product price", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseProductPricePath(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	parts := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+	if len(parts) < 3 {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product price path")
+		return "", "", false
+	}
+
+	productID := parts[len(parts)-3]
+	if _, err := uuid.Parse(productID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product id format, must be UUID")
+		return "", "", false
+	}
+	currency, err := domain.ParseCurrency(parts[len(parts)-1])
+	if err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, err.Error())
+		return "", "", false
+	}
+	return productID, currency, true
+}
@@ -0,0 +1,45 @@
+package writer
+
+import (
+	"encoding/json"
+	"io"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"net/http"
+)
+
+// readBody reads the whole request body, up to MaxBodyBytes when it is set. It answers 413
+// when the body is larger and 400 when it cannot be read, and reports if the handler goes on.
+func (h *WriteHandler) readBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	if h.MaxBodyBytes > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, h.MaxBodyBytes)
+	}
+
+	bytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		problem.Write(w, r, problem.FromBodyError(err))
+		return nil, false
+	}
+	return bytes, true
+}
+
+// decodeBody reads the JSON body into body and validates it, the invalid fields are answered
+// with a 400 that lists them.
+func (h *WriteHandler) decodeBody(w http.ResponseWriter, r *http.Request, body any) bool {
+	bytes, ok := h.readBody(w, r)
+	if !ok {
+		return false
+	}
+
+	if err := json.Unmarshal(bytes, body); err != nil {
+		problem.Write(w, r, problem.FromBodyError(err))
+		return false
+	}
+
+	validate := dto.NewValidator()
+	if err := validate.Struct(body); err != nil {
+		problem.Write(w, r, problem.Validation(err))
+		return false
+	}
+	return true
+}
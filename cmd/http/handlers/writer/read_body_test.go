@@ -0,0 +1,75 @@
+package writer_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/handlers/writer"
+	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWriteHandler_MaxBodyBytes(t *testing.T) {
+	body := `{"name":"T-Shirts","description":"Cotton t-shirts"}`
+
+	testCases := []struct {
+		name                 string
+		maxBodyBytes         int64
+		setupMock            func(mock *mocks.MockCategoryService)
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name:         "Success - 201 body within the limit",
+			maxBodyBytes: int64(len(body)),
+			setupMock: func(mock *mocks.MockCategoryService) {
+				mock.EXPECT().CreateCategory(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:         "Success - 201 no limit",
+			maxBodyBytes: 0,
+			setupMock: func(mock *mocks.MockCategoryService) {
+				mock.EXPECT().CreateCategory(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:                 "Failure - 413 body too large",
+			maxBodyBytes:         int64(len(body)) - 1,
+			setupMock:            func(mock *mocks.MockCategoryService) {},
+			expectedStatus:       http.StatusRequestEntityTooLarge,
+			expectedBodyContains: "the body exceeds 50 bytes",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			tc.setupMock(mockCategoryService)
+
+			handler := writer.NewWriteHandler(mocks.NewMockProductService(ctrl), mocks.NewMockOrderService(ctrl), mockCategoryService, mocks.NewMockPromotionService(ctrl), mocks.NewMockCustomerService(ctrl), mocks.NewMockCartService(ctrl))
+			handler.MaxBodyBytes = tc.maxBodyBytes
+			request := httptest.NewRequest(http.MethodPost, "/api/categories", strings.NewReader(body))
+			recorder := httptest.NewRecorder()
+
+			// Act
+			handler.HandleCreateCategory(recorder, request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedBodyContains != "" {
+				assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+			}
+		})
+	}
+}
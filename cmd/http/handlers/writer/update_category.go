@@ -0,0 +1,57 @@
+package writer
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strings"
+)
+
+func (h *WriteHandler) HandleUpdateCategory(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(r.URL.Path, "/")
+	categoryID := parts[len(parts)-1]
+	if _, err := uuid.Parse(categoryID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid category id format, must be UUID")
+		return
+	}
+
+	bytes, ok := h.readBody(w, r)
+	if !ok {
+		return
+	}
+
+	var body dto.UpdateCategoryRequest
+	if err := json.Unmarshal(bytes, &body); err != nil {
+		problem.Write(w, r, problem.FromBodyError(err))
+		return
+	}
+
+	// an empty parent_id moves the category to the root, it must skip the UUID validation
+	parentID := body.ParentID
+	if parentID != nil && *parentID == "" {
+		body.ParentID = nil
+	}
+
+	validate := dto.NewValidator()
+	if err := validate.Struct(body); err != nil {
+		problem.Write(w, r, problem.Validation(err))
+		return
+	}
+
+	update := domain.CategoryUpdate{
+		Name:        body.Name,
+		Description: body.Description,
+		ParentID:    parentID,
+	}
+
+	err := h.CategoryService.UpdateCategory(r.Context(), categoryID, update)
+	if err != nil {
+		problem.Error(w, r, "error updating category", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
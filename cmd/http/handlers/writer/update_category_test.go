@@ -0,0 +1,114 @@
+package writer_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/handlers/writer"
+	"microservice-products-catalog/cmd/http/handlers/writer/mocks"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleUpdateCategory(t *testing.T) {
+	categoryID := uuid.New().String()
+	parentID := uuid.New().String()
+	newName := "T-Shirts"
+	root := ""
+	path := fmt.Sprintf("/api/categories/%s", categoryID)
+
+	testCases := []struct {
+		name                 string
+		setupMock            func(mock *mocks.MockCategoryService)
+		request              *http.Request
+		expectedStatus       int
+		expectedBodyContains string
+	}{
+		{
+			name: "Success - 204 rename and move",
+			setupMock: func(mock *mocks.MockCategoryService) {
+				mock.EXPECT().
+					UpdateCategory(gomock.Any(), categoryID, domain.CategoryUpdate{Name: &newName, ParentID: &parentID}).
+					Return(nil).Times(1)
+			},
+			request:        httptest.NewRequest(http.MethodPut, path, strings.NewReader(fmt.Sprintf(`{"name":"T-Shirts","parent_id":"%s"}`, parentID))),
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "Success - 204 move to the root",
+			setupMock: func(mock *mocks.MockCategoryService) {
+				mock.EXPECT().UpdateCategory(gomock.Any(), categoryID, domain.CategoryUpdate{ParentID: &root}).Return(nil).Times(1)
+			},
+			request:        httptest.NewRequest(http.MethodPut, path, strings.NewReader(`{"parent_id":""}`)),
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:                 "Failure - 400 invalid parent id",
+			setupMock:            func(mock *mocks.MockCategoryService) {},
+			request:              httptest.NewRequest(http.MethodPut, path, strings.NewReader(`{"parent_id":"shirts"}`)),
+			expectedStatus:       http.StatusBadRequest,
+			expectedBodyContains: `{"field":"parent_id","detail":"must be a UUID"}`,
+		},
+		{
+			name: "Failure - 409 cycle",
+			setupMock: func(mock *mocks.MockCategoryService) {
+				mock.EXPECT().UpdateCategory(gomock.Any(), categoryID, gomock.Any()).Return(domain.ErrCategoryCycle).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodPut, path, strings.NewReader(fmt.Sprintf(`{"parent_id":"%s"}`, parentID))),
+			expectedStatus:       http.StatusConflict,
+			expectedBodyContains: domain.ErrCategoryCycle.Error(),
+		},
+		{
+			name: "Failure - 404 category not found",
+			setupMock: func(mock *mocks.MockCategoryService) {
+				mock.EXPECT().UpdateCategory(gomock.Any(), categoryID, gomock.Any()).Return(domain.ErrCategoryNotFound).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodPut, path, strings.NewReader(`{"name":"T-Shirts"}`)),
+			expectedStatus:       http.StatusNotFound,
+			expectedBodyContains: "category not found",
+		},
+		{
+			name: "Failure - 500 Internal Server Error",
+			setupMock: func(mock *mocks.MockCategoryService) {
+				mock.EXPECT().UpdateCategory(gomock.Any(), categoryID, gomock.Any()).Return(errors.New("database is down")).Times(1)
+			},
+			request:              httptest.NewRequest(http.MethodPut, path, strings.NewReader(`{"name":"T-Shirts"}`)),
+			expectedStatus:       http.StatusInternalServerError,
+			expectedBodyContains: "error updating category",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
+			tc.setupMock(mockCategoryService)
+
+			handler := writer.NewWriteHandler(mockProductService, mockOrderService, mockCategoryService, mockPromotionService, mockCustomerService, mockCartService)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			handler.HandleUpdateCategory(recorder, tc.request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedBodyContains != "" {
+				assert.Contains(t, recorder.Body.String(), tc.expectedBodyContains)
+			}
+		})
+	}
+}
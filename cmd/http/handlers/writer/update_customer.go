@@ -0,0 +1,36 @@
+package writer
+
+import (
+	"encoding/json"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+)
+
+func (h *WriteHandler) HandleUpdateCustomer(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := parseCustomerID(w, r)
+	if !ok {
+		return
+	}
+
+	var body dto.UpdateCustomerRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	customer, err := h.CustomerService.UpdateCustomer(r.Context(), customerID, domain.CustomerUpdate{
+		Email: body.Email,
+		Name:  body.Name,
+	})
+	if err != nil {
+		problem.Error(w, r, "error updating customer", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(customer); err != nil {
+		return
+	}
+}
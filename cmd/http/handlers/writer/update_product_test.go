@@ -18,7 +18,7 @@ import (
 func TestHandleUpdateProduct(t *testing.T) {
 
 	newName := "New Gopher"
-	newPrice := 99.9
+	newPrice := domain.NewMoney(9990, domain.BaseCurrency)
 	newStock := 20
 
 	updateBody := dto.UpdateProductRequest{
@@ -82,7 +82,7 @@ func TestHandleUpdateProduct(t *testing.T) {
 				bytes.NewReader([]byte(`{"price": -1}`)),
 			),
 			expectedStatus:       http.StatusBadRequest,
-			expectedBodyContains: "DTO validation error",
+			expectedBodyContains: `{"field":"price","detail":"must be at least 0.10"}`,
 		},
 	}
 
@@ -96,6 +96,10 @@ func TestHandleUpdateProduct(t *testing.T) {
 
 			mockProductService := mocks.NewMockProductService(ctrl)
 			mockOrderService := mocks.NewMockOrderService(ctrl)
+			mockCategoryService := mocks.NewMockCategoryService(ctrl)
+			mockPromotionService := mocks.NewMockPromotionService(ctrl)
+			mockCustomerService := mocks.NewMockCustomerService(ctrl)
+			mockCartService := mocks.NewMockCartService(ctrl)
 
 			if tc.setupMock != nil {
 				tc.setupMock(mockProductService)
@@ -104,6 +108,10 @@ func TestHandleUpdateProduct(t *testing.T) {
 			handler := writer.NewWriteHandler(
 				mockProductService,
 				mockOrderService,
+				mockCategoryService,
+				mockPromotionService,
+				mockCustomerService,
+				mockCartService,
 			)
 
 			recorder := httptest.NewRecorder()
@@ -0,0 +1,63 @@
+package writer
+
+import (
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strings"
+)
+
+// HandleUpdatePromotion requires an admin token, see HandleCreatePromotion.
+func (h *WriteHandler) HandleUpdatePromotion(w http.ResponseWriter, r *http.Request) {
+	claims, ok := auth.RequestClaims(w, r)
+	if !ok {
+		return
+	}
+	if !claims.IsAdmin() {
+		problem.WriteStatus(w, r, http.StatusForbidden, "promotions require an admin token")
+		return
+	}
+
+	promotionID, ok := parsePromotionID(w, r)
+	if !ok {
+		return
+	}
+
+	var body dto.UpdatePromotionRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	update := domain.PromotionUpdate{
+		Name:        body.Name,
+		Percentage:  body.Percentage,
+		Amount:      body.Amount,
+		BuyQuantity: body.BuyQuantity,
+		GetQuantity: body.GetQuantity,
+		UsageLimit:  body.UsageLimit,
+		StartsAt:    body.StartsAt,
+		EndsAt:      body.EndsAt,
+		Active:      body.Active,
+	}
+
+	err := h.PromotionService.UpdatePromotion(r.Context(), promotionID, update)
+	if err != nil {
+		problem.Error(w, r, "error updating promotion", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parsePromotionID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	parts := strings.Split(r.URL.Path, "/")
+	promotionID := parts[len(parts)-1]
+	if _, err := uuid.Parse(promotionID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid promotion id format, must be UUID")
+		return "", false
+	}
+	return promotionID, true
+}
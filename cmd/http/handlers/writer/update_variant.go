@@ -0,0 +1,57 @@
+package writer
+
+import (
+	"github.com/google/uuid"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strings"
+)
+
+// HandleUpdateVariant serves PUT /api/products/{id}/variants/{variantID}.
+func (h *WriteHandler) HandleUpdateVariant(w http.ResponseWriter, r *http.Request) {
+	productID, variantID, ok := parseVariantPath(w, r)
+	if !ok {
+		return
+	}
+
+	var body dto.UpdateVariantRequest
+	if !h.decodeBody(w, r, &body) {
+		return
+	}
+
+	update := domain.VariantUpdate{
+		SKU:     body.SKU,
+		Options: domain.VariantOptions(body.Options),
+		Price:   body.Price,
+		Stock:   body.Stock,
+	}
+
+	err := h.ProductService.UpdateVariant(r.Context(), productID, variantID, update)
+	if err != nil {
+		problem.Error(w, r, "error updating variant", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func parseVariantPath(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	parts := strings.Split(r.URL.Path, "/")
+	if len(parts) < 3 {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid variant path")
+		return "", "", false
+	}
+
+	productID, variantID := parts[len(parts)-3], parts[len(parts)-1]
+	if _, err := uuid.Parse(productID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid product id format, must be UUID")
+		return "", "", false
+	}
+	if _, err := uuid.Parse(variantID); err != nil {
+		problem.WriteStatus(w, r, http.StatusBadRequest, "invalid variant id format, must be UUID")
+		return "", "", false
+	}
+	return productID, variantID, true
+}
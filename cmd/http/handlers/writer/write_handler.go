@@ -3,6 +3,7 @@ package writer
 import (
 	"context"
 	"microservice-products-catalog/internal/domain"
+	"time"
 )
 
 //go:generate mockgen -source=write_handler.go -destination=././mocks/product_service_mock.go -package=mocks
@@ -11,21 +12,70 @@ type ProductService interface {
 	CreateProduct(ctx context.Context, product domain.Product) error
 	DeleteProduct(ctx context.Context, id string) error
 	UpdateProduct(ctx context.Context, product *domain.Product) error
+	ImportProducts(ctx context.Context, rows domain.ProductImportRows, options domain.ProductImportOptions) (domain.ProductImportReport, error)
+	CreateVariant(ctx context.Context, variant domain.Variant) error
+	UpdateVariant(ctx context.Context, productID string, variantID string, update domain.VariantUpdate) error
+	DeleteVariant(ctx context.Context, productID string, variantID string) error
+	SetProductPrice(ctx context.Context, productID string, price domain.Money) error
+	DeleteProductPrice(ctx context.Context, productID string, currency string) error
+	CreateScheduledPrice(ctx context.Context, scheduledPrice domain.ScheduledPrice) (domain.ScheduledPrice, error)
 }
 
 type OrderService interface {
-	CreateOrder(ctx context.Context, productID string, quantity int) error
+	CreateOrder(ctx context.Context, request domain.OrderRequest) error
+	RefundOrder(ctx context.Context, request domain.RefundRequest) (*domain.Refund, error)
 }
 
-// WriteHandler depends on the interface, not concrete types
+type CategoryService interface {
+	CreateCategory(ctx context.Context, category domain.Category) error
+	UpdateCategory(ctx context.Context, id string, update domain.CategoryUpdate) error
+	DeleteCategory(ctx context.Context, id string) error
+	AddProductToCategory(ctx context.Context, categoryID string, productID string) error
+	RemoveProductFromCategory(ctx context.Context, categoryID string, productID string) error
+}
+
+type PromotionService interface {
+	CreatePromotion(ctx context.Context, promotion domain.Promotion) error
+	UpdatePromotion(ctx context.Context, id string, update domain.PromotionUpdate) error
+	DeletePromotion(ctx context.Context, id string) error
+}
+
+type CustomerService interface {
+	CreateCustomer(ctx context.Context, customer domain.Customer) (domain.Customer, error)
+	UpdateCustomer(ctx context.Context, id string, update domain.CustomerUpdate) (*domain.Customer, error)
+	DeleteCustomer(ctx context.Context, id string) error
+}
+
+type CartService interface {
+	CreateCart(ctx context.Context, customerID string) (domain.Cart, error)
+	AddCartItem(ctx context.Context, cartID string, customerID string, productID string, variantID string, quantity int) (*domain.CartView, error)
+	UpdateCartItem(ctx context.Context, cartID string, customerID string, itemID string, quantity int) (*domain.CartView, error)
+	RemoveCartItem(ctx context.Context, cartID string, customerID string, itemID string) (*domain.CartView, error)
+	Checkout(ctx context.Context, cartID string, customerID string, options domain.CheckoutOptions) ([]domain.Order, error)
+}
+
+// WriteHandler depends on the interface, not concrete types. MaxBodyBytes caps the JSON bodies
+// and MaxImportBytes the streamed imports, zero leaves them unlimited. ImportTimeout replaces
+// the read and write timeouts of the server for the imports, zero keeps them.
 type WriteHandler struct {
-	ProductService ProductService
-	OrderService   OrderService
+	ProductService   ProductService
+	OrderService     OrderService
+	CategoryService  CategoryService
+	PromotionService PromotionService
+	CustomerService  CustomerService
+	CartService      CartService
+	MaxBodyBytes     int64
+	MaxImportBytes   int64
+	ImportTimeout    time.Duration
 }
 
-func NewWriteHandler(productService ProductService, orderService OrderService) *WriteHandler {
+func NewWriteHandler(productService ProductService, orderService OrderService, categoryService CategoryService, promotionService PromotionService, customerService CustomerService, cartService CartService) *WriteHandler {
 	return &WriteHandler{
-		ProductService: productService,
-		OrderService:   orderService,
+		ProductService:   productService,
+		OrderService:     orderService,
+		CategoryService:  categoryService,
+		PromotionService: promotionService,
+		CustomerService:  customerService,
+		CartService:      cartService,
 	}
 }
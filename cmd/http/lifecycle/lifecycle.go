@@ -0,0 +1,163 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Hook is a component of the application. OnStart runs at start up in the order the hooks
+// were appended and OnStop at shutdown in the reverse order, so a component stops before
+// the ones it depends on. Either may be nil.
+type Hook struct {
+	Name    string
+	OnStart func(ctx context.Context) error
+	OnStop  func(ctx context.Context) error
+}
+
+// App starts the hooks, waits for the context to be cancelled (e.g. on SIGTERM) or for a
+// component to fail, and stops the hooks within the stop timeout.
+type App struct {
+	logger *slog.Logger
+	hooks  []Hook
+	failed chan error
+}
+
+func New(logger *slog.Logger) *App {
+	return &App{
+		logger: logger,
+		failed: make(chan error, 1),
+	}
+}
+
+func (a *App) Append(hook Hook) {
+	a.hooks = append(a.hooks, hook)
+}
+
+// Fail stops the application with err, for the components that fail after they started.
+func (a *App) Fail(err error) {
+	select {
+	case a.failed <- err:
+	default:
+	}
+}
+
+// Run starts the hooks and blocks until ctx is cancelled or a component fails, then stops the
+// started hooks. The hooks get stopTimeout to stop, all of them together. The error is the
+// one of the failed component joined with the errors of the stop hooks.
+func (a *App) Run(ctx context.Context, stopTimeout time.Duration) error {
+	var started []Hook
+	var runErr error
+
+	for _, hook := range a.hooks {
+		if hook.OnStart != nil {
+			if err := hook.OnStart(ctx); err != nil {
+				runErr = fmt.Errorf("starting %s: %w", hook.Name, err)
+				break
+			}
+		}
+		started = append(started, hook)
+	}
+
+	if runErr == nil {
+		a.logger.Info("application started")
+		select {
+		case <-ctx.Done():
+			a.logger.Info("shutting down")
+		case err := <-a.failed:
+			runErr = err
+			a.logger.Error("shutting down after a failure", "error", err)
+		}
+	}
+
+	// the stop hooks must run even though ctx is cancelled, the logger and the other values are kept
+	stopCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), stopTimeout)
+	defer cancel()
+
+	errs := []error{runErr}
+	for i := len(started) - 1; i >= 0; i-- {
+		hook := started[i]
+		if hook.OnStop == nil {
+			continue
+		}
+		if err := hook.OnStop(stopCtx); err != nil {
+			a.logger.Error("error stopping component", "component", hook.Name, "error", err)
+			errs = append(errs, fmt.Errorf("stopping %s: %w", hook.Name, err))
+			continue
+		}
+		a.logger.Info("component stopped", "component", hook.Name)
+	}
+	return errors.Join(errs...)
+}
+
+// Worker is a hook for a background job that runs until its context is cancelled, such as
+// product.Service.RunPriceScheduler. Its context is only cancelled when the hook is stopped,
+// and the stop waits for run to return.
+func Worker(name string, run func(ctx context.Context)) Hook {
+	var cancel context.CancelFunc
+	done := make(chan struct{})
+
+	return Hook{
+		Name: name,
+		OnStart: func(ctx context.Context) error {
+			var workerCtx context.Context
+			workerCtx, cancel = context.WithCancel(context.WithoutCancel(ctx))
+			go func() {
+				defer close(done)
+				run(workerCtx)
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	}
+}
+
+// Server is a hook serving server on listener, over TLS when server.TLSConfig is set. At stop
+// beforeShutdown runs first, e.g. to fail the readiness probe, and the server keeps serving for
+// drainDelay, at most until the stop timeout, so the load balancer sees the probe fail before
+// the connections are refused. Then the server stops accepting connections and waits for the
+// requests in flight to complete. A server that stops serving on its own fails the app.
+func Server(app *App, server *http.Server, listener net.Listener, beforeShutdown func(), drainDelay time.Duration) Hook {
+	return Hook{
+		Name: "http server",
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				serve := server.Serve
+				if server.TLSConfig != nil {
+					// the certificates are in the TLS config, not in files
+					serve = func(listener net.Listener) error { return server.ServeTLS(listener, "", "") }
+				}
+				if err := serve(listener); !errors.Is(err, http.ErrServerClosed) {
+					app.Fail(fmt.Errorf("http server: %w", err))
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if beforeShutdown != nil {
+				beforeShutdown()
+			}
+			if drainDelay > 0 {
+				drained := time.NewTimer(drainDelay)
+				defer drained.Stop()
+				select {
+				case <-drained.C:
+				case <-ctx.Done():
+				}
+			}
+			return server.Shutdown(ctx)
+		},
+	}
+}
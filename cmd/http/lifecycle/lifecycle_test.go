@@ -0,0 +1,278 @@
+package lifecycle_test
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"microservice-products-catalog/cmd/http/lifecycle"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestApp_InFlightRequestsCompleteOnShutdown(t *testing.T) {
+	// Arrange
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(entered)
+		<-release
+		_, _ = w.Write([]byte("order created"))
+	})}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var notReady atomic.Bool
+	app := lifecycle.New(discardLogger())
+	app.Append(lifecycle.Server(app, server, listener, func() { notReady.Store(true) }, 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() { runErr <- app.Run(ctx, 5*time.Second) }()
+
+	type response struct {
+		status int
+		body   string
+		err    error
+	}
+	responses := make(chan response, 1)
+	go func() {
+		res, err := http.Post("http://"+listener.Addr().String()+"/api/orders", "application/json", nil)
+		if err != nil {
+			responses <- response{err: err}
+			return
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		responses <- response{status: res.StatusCode, body: string(body), err: err}
+	}()
+	<-entered
+
+	// Act
+	cancel()
+
+	// Assert
+	select {
+	case err := <-runErr:
+		t.Fatalf("the app stopped with a request in flight: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.True(t, notReady.Load())
+
+	close(release)
+	res := <-responses
+	require.NoError(t, res.err)
+	assert.Equal(t, http.StatusOK, res.status)
+	assert.Equal(t, "order created", res.body)
+	assert.NoError(t, <-runErr)
+
+	_, err = net.DialTimeout("tcp", listener.Addr().String(), time.Second)
+	assert.Error(t, err)
+}
+
+func TestApp_Run(t *testing.T) {
+	startErr := errors.New("port in use")
+
+	testCases := []struct {
+		testName      string
+		failing       string
+		expectedCalls []string
+		expectedError error
+	}{
+		{
+			testName:      "Success - Hooks stop in the reverse order",
+			expectedCalls: []string{"start mysql", "start workers", "start server", "stop server", "stop workers", "stop mysql"},
+		},
+		{
+			testName:      "Failure - Only the started hooks are stopped",
+			failing:       "server",
+			expectedCalls: []string{"start mysql", "start workers", "start server", "stop workers", "stop mysql"},
+			expectedError: startErr,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			var calls []string
+			app := lifecycle.New(discardLogger())
+			for _, name := range []string{"mysql", "workers", "server"} {
+				app.Append(lifecycle.Hook{
+					Name: name,
+					OnStart: func(ctx context.Context) error {
+						calls = append(calls, "start "+name)
+						if name == tc.failing {
+							return startErr
+						}
+						return nil
+					},
+					OnStop: func(ctx context.Context) error {
+						calls = append(calls, "stop "+name)
+						return nil
+					},
+				})
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			// Act
+			err := app.Run(ctx, time.Second)
+
+			// Assert
+			assert.Equal(t, tc.expectedCalls, calls)
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApp_FailStopsTheApp(t *testing.T) {
+	serveErr := errors.New("listener closed")
+	app := lifecycle.New(discardLogger())
+	app.Append(lifecycle.Hook{Name: "server", OnStart: func(ctx context.Context) error {
+		go app.Fail(serveErr)
+		return nil
+	}})
+
+	err := app.Run(context.Background(), time.Second)
+
+	assert.ErrorIs(t, err, serveErr)
+}
+
+func TestWorker(t *testing.T) {
+	stopped := make(chan struct{})
+	worker := lifecycle.Worker("price scheduler", func(ctx context.Context) {
+		<-ctx.Done()
+		close(stopped)
+	})
+
+	// the context of the worker is not the one of the start, only the stop cancels it
+	startCtx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, worker.OnStart(startCtx))
+	cancel()
+
+	select {
+	case <-stopped:
+		t.Fatal("the worker stopped before its hook")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	require.NoError(t, worker.OnStop(context.Background()))
+	<-stopped
+}
+
+func TestServer_ServesDuringTheDrainDelay(t *testing.T) {
+	// Arrange
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	notReady := make(chan struct{})
+	app := lifecycle.New(discardLogger())
+	app.Append(lifecycle.Server(app, server, listener, func() { close(notReady) }, 200*time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() { runErr <- app.Run(ctx, 5*time.Second) }()
+
+	// Act
+	cancel()
+	<-notReady
+
+	// Assert
+	// the probe failed but the load balancer may still route a request before it sees it
+	res, err := http.Get("http://" + listener.Addr().String() + "/api/products")
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	assert.NoError(t, <-runErr)
+	_, err = net.DialTimeout("tcp", listener.Addr().String(), time.Second)
+	assert.Error(t, err)
+}
+
+func TestServer_DrainDelayIsBoundByTheStopTimeout(t *testing.T) {
+	// Arrange
+	server := &http.Server{Handler: http.NotFoundHandler()}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	app := lifecycle.New(discardLogger())
+	app.Append(lifecycle.Server(app, server, listener, nil, time.Hour))
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() { runErr <- app.Run(ctx, 50*time.Millisecond) }()
+
+	// Act
+	cancel()
+
+	// Assert
+	select {
+	case <-runErr:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the drain delay outlived the stop timeout")
+	}
+	_, err = net.DialTimeout("tcp", listener.Addr().String(), time.Second)
+	assert.Error(t, err)
+}
+
+func TestServer_ServesTLSWithHTTP2(t *testing.T) {
+	// Arrange
+	// the test server only lends its certificate and a client trusting it
+	certificates := httptest.NewTLSServer(http.NotFoundHandler())
+	certificates.Close()
+	client := &http.Client{Transport: &http.Transport{
+		TLSClientConfig:   certificates.Client().Transport.(*http.Transport).TLSClientConfig,
+		ForceAttemptHTTP2: true,
+	}}
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(r.Proto))
+		}),
+		TLSConfig: &tls.Config{Certificates: certificates.TLS.Certificates},
+		Protocols: new(http.Protocols),
+	}
+	server.Protocols.SetHTTP1(true)
+	server.Protocols.SetHTTP2(true)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	app := lifecycle.New(discardLogger())
+	app.Append(lifecycle.Server(app, server, listener, nil, 0))
+	ctx, cancel := context.WithCancel(context.Background())
+	runErr := make(chan error, 1)
+	go func() { runErr <- app.Run(ctx, 5*time.Second) }()
+
+	// Act
+	res, err := client.Get("https://" + listener.Addr().String() + "/api/products")
+
+	// Assert
+	require.NoError(t, err)
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	_ = res.Body.Close()
+	assert.Equal(t, "HTTP/2.0", string(body))
+
+	cancel()
+	assert.NoError(t, <-runErr)
+}
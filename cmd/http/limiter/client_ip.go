@@ -0,0 +1,68 @@
+package limiter
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// parseTrustedProxies reads the IPs and CIDRs checked by config.Config.Validate.
+func parseTrustedProxies(proxies []string) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, proxy := range proxies {
+		if prefix, err := netip.ParsePrefix(proxy); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		if addr, err := netip.ParseAddr(proxy); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+		}
+	}
+	return prefixes
+}
+
+func trusted(addr netip.Addr, proxies []netip.Prefix) bool {
+	for _, proxy := range proxies {
+		if proxy.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP is the IP of the peer, unless the peer is a trusted proxy: then X-Forwarded-For
+// is read from the right, each proxy appending the address it was reached from, and the
+// first address not trusted is the client. The addresses left of it can be forged by the
+// client and are never read.
+func clientIP(r *http.Request, proxies []netip.Prefix) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	peer, err := netip.ParseAddr(host)
+	if err != nil {
+		return host
+	}
+	peer = peer.Unmap()
+	if !trusted(peer, proxies) {
+		return peer.String()
+	}
+
+	var hops []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			// a malformed hop ends the chain, the last proxy is the client as far as we know
+			break
+		}
+		peer = hop.Unmap()
+		if !trusted(peer, proxies) {
+			break
+		}
+	}
+	return peer.String()
+}
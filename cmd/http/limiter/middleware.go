@@ -0,0 +1,129 @@
+package limiter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/config"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"microservice-products-catalog/internal/infraestructure/ratelimit"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// APIKeyHeader carries the API key of a client, see config.RateLimit.
+const APIKeyHeader = "X-API-Key"
+
+// Middleware limits the requests of each client to each route with a token bucket, see
+// config.RateLimit. The answers carry the RateLimit-Limit, RateLimit-Remaining,
+// RateLimit-Reset and RateLimit-Policy headers, a request past the limit is answered 429
+// with Retry-After. The limit fails open: when the store fails the request is served.
+type Middleware struct {
+	limiter        *ratelimit.Limiter
+	verifier       auth.TokenVerifier
+	defaultLimit   ratelimit.Limit
+	routes         map[string]ratelimit.Limit
+	apiKeys        map[[sha256.Size]byte]string
+	trustedProxies []netip.Prefix
+}
+
+func New(limiter *ratelimit.Limiter, verifier auth.TokenVerifier, cfg config.RateLimit) *Middleware {
+	m := &Middleware{
+		limiter:        limiter,
+		verifier:       verifier,
+		routes:         map[string]ratelimit.Limit{},
+		apiKeys:        map[[sha256.Size]byte]string{},
+		trustedProxies: parseTrustedProxies(cfg.TrustedProxies),
+	}
+	if !cfg.Enabled {
+		return m
+	}
+
+	m.defaultLimit = limitFromRate(cfg.Default)
+	for route, rate := range cfg.Routes {
+		m.routes[route] = limitFromRate(rate)
+	}
+	for _, key := range cfg.APIKeys {
+		// the buckets are named after a digest, the keys are never stored
+		digest := sha256.Sum256([]byte(key))
+		m.apiKeys[digest] = hex.EncodeToString(digest[:8])
+	}
+	return m
+}
+
+func limitFromRate(rate config.Rate) ratelimit.Limit {
+	return ratelimit.Limit{Requests: rate.Requests, Period: rate.Period, Burst: rate.Burst}
+}
+
+// Handle wraps the handler of a route, the route is the pattern the mux matched.
+func (m *Middleware) Handle(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route, limit := m.limitOf(r)
+		if limit.Unlimited() {
+			h(w, r)
+			return
+		}
+
+		result, err := m.limiter.Allow(r.Context(), m.clientKey(r)+" "+route, limit)
+		if err != nil {
+			logging.FromContext(r.Context()).Error("error taking rate limit token", "route", route, "error", err)
+			h(w, r)
+			return
+		}
+
+		policy := fmt.Sprintf("%d;w=%d", limit.Requests, int(limit.Period.Seconds()))
+		if limit.Burst > 0 {
+			policy += fmt.Sprintf(";burst=%d", limit.Burst)
+		}
+		w.Header().Set("RateLimit-Policy", policy)
+		w.Header().Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+		w.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		w.Header().Set("RateLimit-Reset", strconv.Itoa(seconds(result.ResetAfter)))
+
+		if !result.Allowed {
+			retryAfter := max(seconds(result.RetryAfter), 1)
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			problem.Write(w, r, problem.RateLimited(retryAfter))
+			return
+		}
+		h(w, r)
+	}
+}
+
+// limitOf picks the limit of the request: the one of its method and route, the one of its
+// route or the default one. The route names the bucket, so every route has its own.
+func (m *Middleware) limitOf(r *http.Request) (string, ratelimit.Limit) {
+	if limit, ok := m.routes[r.Method+" "+r.Pattern]; ok {
+		return r.Method + " " + r.Pattern, limit
+	}
+	if limit, ok := m.routes[r.Pattern]; ok {
+		return r.Pattern, limit
+	}
+	return r.Pattern, m.defaultLimit
+}
+
+// clientKey names the client: the subject of a valid token, a known API key or the IP.
+func (m *Middleware) clientKey(r *http.Request) string {
+	if token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); found && token != "" {
+		if claims, err := m.verifier.Verify(token); err == nil && claims.Subject != "" {
+			return "subject:" + claims.Subject
+		}
+	}
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		if name, ok := m.apiKeys[sha256.Sum256([]byte(key))]; ok {
+			return "api-key:" + name
+		}
+	}
+	return "ip:" + clientIP(r, m.trustedProxies)
+}
+
+// seconds rounds d up to whole seconds, the unit of the headers.
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
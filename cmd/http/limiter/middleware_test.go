@@ -0,0 +1,283 @@
+package limiter_test
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/auth"
+	"microservice-products-catalog/cmd/http/config"
+	"microservice-products-catalog/cmd/http/limiter"
+	"microservice-products-catalog/internal/infraestructure/ratelimit"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakeVerifier map[string]auth.TokenClaims
+
+func (f fakeVerifier) Verify(token string) (auth.TokenClaims, error) {
+	claims, ok := f[token]
+	if !ok {
+		return auth.TokenClaims{}, errors.New("invalid token")
+	}
+	return claims, nil
+}
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, ratelimit.Limit, time.Time) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("store unavailable")
+}
+
+type request struct {
+	method        string
+	path          string
+	remoteAddr    string
+	forwardedFor  string
+	authorization string
+	apiKey        string
+	// wait advances the clock before the request
+	wait time.Duration
+}
+
+func rateLimitConfig() config.RateLimit {
+	cfg := config.Default().RateLimit
+	cfg.Default = config.Rate{Requests: 2, Period: time.Minute}
+	cfg.Routes = config.RouteRates{
+		"POST /api/orders": {Requests: 1, Period: time.Minute},
+		"/api/carts":       {Requests: 1, Period: time.Second, Burst: 3},
+	}
+	cfg.TrustedProxies = []string{"10.0.0.0/8"}
+	cfg.APIKeys = []string{"partner-key"}
+	return cfg
+}
+
+func TestMiddleware_Handle(t *testing.T) {
+	verifier := fakeVerifier{
+		"alice": {Subject: "alice"},
+		"bob":   {Subject: "bob"},
+	}
+
+	testCases := []struct {
+		name            string
+		cfg             func() config.RateLimit
+		store           ratelimit.Store
+		requests        []request
+		expectedStatus  int
+		expectedHeaders map[string]string
+	}{
+		{
+			name:           "Success - default limit",
+			requests:       []request{{method: http.MethodGet, path: "/api/orders"}},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"RateLimit-Policy":    "2;w=60",
+				"RateLimit-Limit":     "2",
+				"RateLimit-Remaining": "1",
+				"RateLimit-Reset":     "30",
+			},
+		},
+		{
+			name: "Failure - 429 past the default limit",
+			requests: []request{
+				{method: http.MethodGet, path: "/api/orders"},
+				{method: http.MethodGet, path: "/api/orders"},
+				{method: http.MethodGet, path: "/api/orders", wait: 10 * time.Second},
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedHeaders: map[string]string{
+				"RateLimit-Remaining": "0",
+				"RateLimit-Reset":     "50",
+				"Retry-After":         "20",
+			},
+		},
+		{
+			name: "Success - refilled after the wait",
+			requests: []request{
+				{method: http.MethodGet, path: "/api/orders"},
+				{method: http.MethodGet, path: "/api/orders"},
+				{method: http.MethodGet, path: "/api/orders", wait: 30 * time.Second},
+			},
+			expectedStatus:  http.StatusOK,
+			expectedHeaders: map[string]string{"RateLimit-Remaining": "0"},
+		},
+		{
+			name: "Failure - 429 method and route limit",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders"},
+				{method: http.MethodPost, path: "/api/orders"},
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedHeaders: map[string]string{
+				"RateLimit-Policy": "1;w=60",
+				"Retry-After":      "60",
+			},
+		},
+		{
+			name: "Success - routes have their own buckets",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders"},
+				{method: http.MethodGet, path: "/api/orders"},
+			},
+			expectedStatus:  http.StatusOK,
+			expectedHeaders: map[string]string{"RateLimit-Remaining": "1"},
+		},
+		{
+			name: "Success - route limit with a burst",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/carts"},
+				{method: http.MethodPost, path: "/api/carts"},
+			},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"RateLimit-Policy":    "1;w=1;burst=3",
+				"RateLimit-Limit":     "3",
+				"RateLimit-Remaining": "1",
+				"RateLimit-Reset":     "2",
+			},
+		},
+		{
+			name: "Success - clients have their own buckets",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders", remoteAddr: "192.0.2.1:1234"},
+				{method: http.MethodPost, path: "/api/orders", remoteAddr: "192.0.2.2:1234"},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Failure - 429 same subject from other IPs",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders", remoteAddr: "192.0.2.1:1234", authorization: "Bearer alice"},
+				{method: http.MethodPost, path: "/api/orders", remoteAddr: "192.0.2.2:1234", authorization: "Bearer alice"},
+			},
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name: "Success - subjects from the same IP",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders", authorization: "Bearer alice"},
+				{method: http.MethodPost, path: "/api/orders", authorization: "Bearer bob"},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Failure - 429 invalid token falls back to the IP",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders"},
+				{method: http.MethodPost, path: "/api/orders", authorization: "Bearer forged"},
+			},
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name: "Success - known API key has its own bucket",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders"},
+				{method: http.MethodPost, path: "/api/orders", apiKey: "partner-key"},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Failure - 429 unknown API key falls back to the IP",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders", apiKey: "guessed-key"},
+				{method: http.MethodPost, path: "/api/orders", apiKey: "another-key"},
+			},
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name: "Success - forwarded clients behind a trusted proxy",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders", remoteAddr: "10.0.0.1:1234", forwardedFor: "192.0.2.1"},
+				{method: http.MethodPost, path: "/api/orders", remoteAddr: "10.0.0.1:1234", forwardedFor: "192.0.2.2, 10.0.0.2"},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Failure - 429 forged header behind a trusted proxy",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders", remoteAddr: "10.0.0.1:1234", forwardedFor: "198.51.100.1, 192.0.2.1"},
+				{method: http.MethodPost, path: "/api/orders", remoteAddr: "10.0.0.1:1234", forwardedFor: "198.51.100.2, 192.0.2.1"},
+			},
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name: "Failure - 429 header from a client not trusted",
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders", remoteAddr: "192.0.2.1:1234", forwardedFor: "198.51.100.1"},
+				{method: http.MethodPost, path: "/api/orders", remoteAddr: "192.0.2.1:1234", forwardedFor: "198.51.100.2"},
+			},
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name: "Success - disabled",
+			cfg: func() config.RateLimit {
+				cfg := rateLimitConfig()
+				cfg.Enabled = false
+				return cfg
+			},
+			requests: []request{
+				{method: http.MethodPost, path: "/api/orders"},
+				{method: http.MethodPost, path: "/api/orders"},
+			},
+			expectedStatus:  http.StatusOK,
+			expectedHeaders: map[string]string{"RateLimit-Limit": ""},
+		},
+		{
+			name:            "Success - store failure lets the request through",
+			store:           failingStore{},
+			requests:        []request{{method: http.MethodPost, path: "/api/orders"}},
+			expectedStatus:  http.StatusOK,
+			expectedHeaders: map[string]string{"RateLimit-Limit": ""},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			cfg := rateLimitConfig()
+			if tc.cfg != nil {
+				cfg = tc.cfg()
+			}
+			store := tc.store
+			if store == nil {
+				store = ratelimit.NewMemoryStore()
+			}
+			now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+			middleware := limiter.New(ratelimit.NewLimiter(store, func() time.Time { return now }), verifier, cfg)
+			mux := http.NewServeMux()
+			ok := func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusOK) }
+			mux.HandleFunc("/api/orders", middleware.Handle(ok))
+			mux.HandleFunc("/api/carts", middleware.Handle(ok))
+
+			// Act
+			var response *httptest.ResponseRecorder
+			for _, req := range tc.requests {
+				now = now.Add(req.wait)
+				r := httptest.NewRequest(req.method, req.path, nil)
+				if req.remoteAddr != "" {
+					r.RemoteAddr = req.remoteAddr
+				}
+				if req.forwardedFor != "" {
+					r.Header.Set("X-Forwarded-For", req.forwardedFor)
+				}
+				if req.authorization != "" {
+					r.Header.Set("Authorization", req.authorization)
+				}
+				if req.apiKey != "" {
+					r.Header.Set(limiter.APIKeyHeader, req.apiKey)
+				}
+				response = httptest.NewRecorder()
+				mux.ServeHTTP(response, r)
+			}
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, response.Code)
+			for header, value := range tc.expectedHeaders {
+				assert.Equal(t, value, response.Header().Get(header), header)
+			}
+		})
+	}
+}
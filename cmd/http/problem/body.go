@@ -0,0 +1,129 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/go-playground/validator.v9"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Invalid is the problem of a request body with invalid fields.
+func Invalid(fields ...FieldError) Problem {
+	p := typed(http.StatusBadRequest, "Validation error", "the body has invalid fields")
+	p.Errors = fields
+	return p
+}
+
+// FromBodyError maps an error reading or decoding a JSON body. The errors of the decoder
+// name Go types, they are answered as the invalid field or the body that does not parse.
+func FromBodyError(err error) Problem {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return typed(http.StatusRequestEntityTooLarge, "Body too large", fmt.Sprintf("the body exceeds %d bytes", tooLarge.Limit))
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return typed(http.StatusBadRequest, "Malformed body", "the body is not valid JSON")
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		if typeErr.Field == "" {
+			return typed(http.StatusBadRequest, "Malformed body", "the body must be "+jsonType(typeErr.Type))
+		}
+		return Invalid(FieldError{Field: typeErr.Field, Detail: "must be " + jsonType(typeErr.Type)})
+	}
+
+	// the fields that decode themselves, such as the money amounts, fail with a domain error
+	if p := FromError("", err); p.Status != http.StatusInternalServerError {
+		return p
+	}
+	return typed(http.StatusBadRequest, "Malformed body", "the body cannot be decoded")
+}
+
+// jsonType names the JSON type of a Go type for the clients, with its article.
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	case reflect.Pointer:
+		return jsonType(t.Elem())
+	}
+	return "an object"
+}
+
+// Validation maps the errors of dto.NewValidator to the invalid fields, the fields are named
+// after their JSON names.
+func Validation(err error) Problem {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return New(http.StatusInternalServerError, "error validating body")
+	}
+
+	fields := make([]FieldError, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		// the namespace starts with the name of the DTO
+		_, field, _ := strings.Cut(fieldErr.Namespace(), ".")
+		fields = append(fields, FieldError{Field: field, Detail: fieldDetail(fieldErr)})
+	}
+	return Invalid(fields...)
+}
+
+var minorUnitsType = reflect.TypeOf(dto.MinorUnits(0))
+
+// fieldDetail explains the validation tag a field failed.
+func fieldDetail(fieldErr validator.FieldError) string {
+	param := fieldErr.Param()
+	if fieldErr.Type() == minorUnitsType {
+		// money is validated by its amount in minor units
+		if minor, err := strconv.ParseInt(param, 10, 64); err == nil {
+			param = domain.Money{Amount: minor}.Decimal()
+		}
+	}
+
+	var unit string
+	switch fieldErr.Kind() {
+	case reflect.String:
+		unit = " characters"
+	case reflect.Slice, reflect.Map, reflect.Array:
+		unit = " items"
+	}
+
+	switch fieldErr.Tag() {
+	case "required":
+		return "is required"
+	case "min", "gte":
+		return fmt.Sprintf("must be at least %s%s", param, unit)
+	case "max", "lte":
+		return fmt.Sprintf("must be at most %s%s", param, unit)
+	case "gt":
+		return fmt.Sprintf("must be greater than %s%s", param, unit)
+	case "lt":
+		return fmt.Sprintf("must be less than %s%s", param, unit)
+	case "oneof":
+		return "must be one of: " + strings.Join(strings.Fields(param), ", ")
+	case "email":
+		return "must be an email address"
+	case "uuid":
+		return "must be a UUID"
+	case "alphanum":
+		return "must only contain letters and digits"
+	}
+	return fmt.Sprintf("failed the %s validation", fieldErr.Tag())
+}
@@ -0,0 +1,108 @@
+package problem
+
+import (
+	"errors"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"net/http"
+)
+
+// domainProblem is the status and the title a domain error is answered with. Every handler
+// answers a domain error the same way, see FromError.
+type domainProblem struct {
+	err    error
+	status int
+	title  string
+}
+
+var domainProblems = []domainProblem{
+	{domain.ErrProductNotFound, http.StatusNotFound, "Product not found"},
+	{domain.ErrVariantNotFound, http.StatusNotFound, "Variant not found"},
+	{domain.ErrPriceNotFound, http.StatusNotFound, "Price not found"},
+	{domain.ErrScheduledPriceNotFound, http.StatusNotFound, "Scheduled price not found"},
+	{domain.ErrStockAlertNotFound, http.StatusNotFound, "Stock alert not found"},
+	{domain.ErrCategoryNotFound, http.StatusNotFound, "Category not found"},
+	{domain.ErrOrderNotFound, http.StatusNotFound, "Order not found"},
+	{domain.ErrInvoiceNotFound, http.StatusNotFound, "Invoice not found"},
+	{domain.ErrPromotionNotFound, http.StatusNotFound, "Promotion not found"},
+	{domain.ErrCustomerNotFound, http.StatusNotFound, "Customer not found"},
+	{domain.ErrCartNotFound, http.StatusNotFound, "Cart not found"},
+	{domain.ErrCartItemNotFound, http.StatusNotFound, "Cart item not found"},
+
+	{domain.ErrCheckoutConflict, http.StatusConflict, "Checkout conflict"},
+	{domain.ErrProductAlreadyExists, http.StatusConflict, "Product already exists"},
+	{domain.ErrVariantSKUAlreadyExists, http.StatusConflict, "Variant SKU already exists"},
+	{domain.ErrVariantHasOrders, http.StatusConflict, "Variant has orders"},
+	{domain.ErrScheduledPriceConflict, http.StatusConflict, "Scheduled price conflict"},
+	{domain.ErrCategoryCycle, http.StatusConflict, "Category cycle"},
+	{domain.ErrCategoryHasChildren, http.StatusConflict, "Category has children"},
+	{domain.ErrCouponAlreadyExists, http.StatusConflict, "Coupon already exists"},
+	{domain.ErrCouponUsageLimitReached, http.StatusConflict, "Coupon usage limit reached"},
+	{domain.ErrCustomerAlreadyExists, http.StatusConflict, "Customer already exists"},
+	{domain.ErrCustomerHasOrders, http.StatusConflict, "Customer has orders"},
+	{domain.ErrCartCheckedOut, http.StatusConflict, "Cart checked out"},
+	{domain.ErrCartChanged, http.StatusConflict, "Cart changed"},
+	{domain.ErrOrderNotRefundable, http.StatusConflict, "Order not refundable"},
+	{domain.ErrRefundQuantityExceeded, http.StatusConflict, "Refund quantity exceeded"},
+
+	{domain.ErrCartExpired, http.StatusGone, "Cart expired"},
+
+	// the order handler answered it with 400 before the problem details, clients rely on it
+	{domain.ErrInsufficientStock, http.StatusBadRequest, "Insufficient stock"},
+	{domain.ErrInvalidMoney, http.StatusBadRequest, "Invalid money"},
+	{domain.ErrInvalidCurrency, http.StatusBadRequest, "Invalid currency"},
+	{domain.ErrExchangeRateNotFound, http.StatusBadRequest, "Unsupported currency"},
+	{domain.ErrBaseCurrencyPrice, http.StatusBadRequest, "Base currency price"},
+	{domain.ErrInvalidScheduledPrice, http.StatusBadRequest, "Invalid scheduled price"},
+	{domain.ErrVariantRequired, http.StatusBadRequest, "Variant required"},
+	{domain.ErrCategoryParentNotFound, http.StatusBadRequest, "Parent category not found"},
+	{domain.ErrInvalidPromotion, http.StatusBadRequest, "Invalid promotion"},
+	{domain.ErrCouponNotFound, http.StatusBadRequest, "Coupon not found"},
+	{domain.ErrCouponNotActive, http.StatusBadRequest, "Coupon not active"},
+	{domain.ErrCouponNotApplicable, http.StatusBadRequest, "Coupon not applicable"},
+	{domain.ErrTaxRegionNotFound, http.StatusBadRequest, "Tax region not found"},
+	{domain.ErrCustomerRequired, http.StatusBadRequest, "Customer required"},
+	{domain.ErrCartEmpty, http.StatusBadRequest, "Cart empty"},
+
+	{domain.ErrInvoiceFormatNotSupported, http.StatusNotAcceptable, "Invoice format not supported"},
+
+	// the payment failed and so did the compensation, it comes first so the payment error it
+	// is joined with is not answered
+	{domain.ErrCompensationFailed, http.StatusInternalServerError, "Compensation failed"},
+	// the order was compensated, the request can be retried with the same body
+	{domain.ErrPaymentDeclined, http.StatusPaymentRequired, "Payment declined"},
+	{domain.ErrPaymentTimeout, http.StatusGatewayTimeout, "Payment timeout"},
+	{domain.ErrPaymentFailed, http.StatusBadGateway, "Payment failed"},
+}
+
+// FromError maps err to its problem, message describes the operation that failed. The
+// detail is the domain error alone, or the message when err is not one: what it wraps or is
+// joined with comes from the infrastructure and is never answered.
+func FromError(message string, err error) Problem {
+	for _, known := range domainProblems {
+		if !errors.Is(err, known.err) {
+			continue
+		}
+
+		p := typed(known.status, known.title, known.err.Error())
+		var conflict *domain.CheckoutConflictError
+		if errors.As(err, &conflict) {
+			p.Conflicts = conflict.Lines
+		}
+		return p
+	}
+	return New(http.StatusInternalServerError, message)
+}
+
+// Error logs err with message and answers its problem, see FromError. The client errors
+// are logged at info level, they are answered and not a failure of the service.
+func Error(w http.ResponseWriter, r *http.Request, message string, err error) {
+	p := FromError(message, err)
+	logger := logging.FromContext(r.Context())
+	if p.Status >= http.StatusInternalServerError {
+		logger.Error(message, "error", err)
+	} else {
+		logger.Info(message, "error", err, "status", p.Status)
+	}
+	Write(w, r, p)
+}
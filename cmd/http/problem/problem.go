@@ -0,0 +1,81 @@
+package problem
+
+import (
+	"encoding/json"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"strings"
+)
+
+// ContentType is the media type of the error answers, RFC 7807.
+const ContentType = "application/problem+json"
+
+// typePrefix is the prefix of the types of the problems of the service, the types are
+// relative to its host. The problems without a type of their own are "about:blank" and
+// titled after their status.
+const typePrefix = "/problems/"
+
+const typeBlank = "about:blank"
+
+// Problem is the body of every error answer. Type identifies the kind of problem and does
+// not change, clients branch on it and not on Detail, which explains this occurrence.
+// Errors lists the invalid fields of a request body and Conflicts the cart lines that
+// cannot be ordered.
+type Problem struct {
+	Type      string                `json:"type"`
+	Title     string                `json:"title"`
+	Status    int                   `json:"status"`
+	Detail    string                `json:"detail,omitempty"`
+	Instance  string                `json:"instance,omitempty"`
+	Errors    []FieldError          `json:"errors,omitempty"`
+	Conflicts []domain.LineConflict `json:"conflicts,omitempty"`
+}
+
+// FieldError is an invalid field of a request body, Field is its JSON path.
+type FieldError struct {
+	Field  string `json:"field"`
+	Detail string `json:"detail"`
+}
+
+// New is a problem without a type of its own, such as an invalid path or a token that
+// cannot access a resource.
+func New(status int, detail string) Problem {
+	return Problem{
+		Type:   typeBlank,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// typed is a problem of the service, its type is named after the title.
+func typed(status int, title string, detail string) Problem {
+	return Problem{
+		Type:   typePrefix + strings.ReplaceAll(strings.ToLower(title), " ", "-"),
+		Title:  title,
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// Write answers the problem, the instance is the path of the request.
+func Write(w http.ResponseWriter, r *http.Request, p Problem) {
+	if p.Instance == "" {
+		p.Instance = r.URL.Path
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// WriteStatus answers a problem without a type of its own, it replaces http.Error.
+func WriteStatus(w http.ResponseWriter, r *http.Request, status int, detail string) {
+	Write(w, r, New(status, detail))
+}
+
+// RateLimited is the problem of a client past its rate limit, retryAfter is in seconds.
+func RateLimited(retryAfter int) Problem {
+	return typed(http.StatusTooManyRequests, "Rate limit exceeded", fmt.Sprintf("rate limit exceeded, retry in %d seconds", retryAfter))
+}
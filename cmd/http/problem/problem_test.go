@@ -0,0 +1,245 @@
+package problem_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"microservice-products-catalog/cmd/http/dto"
+	"microservice-products-catalog/cmd/http/problem"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromError(t *testing.T) {
+	t.Parallel()
+
+	lines := []domain.LineConflict{{ProductID: "p-1", Requested: 3, Available: 1, Reason: domain.LineInsufficientStock}}
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected problem.Problem
+	}{
+		{
+			name: "Success - not found",
+			err:  fmt.Errorf("error getting product: %w", domain.ErrProductNotFound),
+			expected: problem.Problem{
+				Type:   "/problems/product-not-found",
+				Title:  "Product not found",
+				Status: http.StatusNotFound,
+				Detail: domain.ErrProductNotFound.Error(),
+			},
+		},
+		{
+			name: "Success - insufficient stock is a bad request",
+			err:  domain.ErrInsufficientStock,
+			expected: problem.Problem{
+				Type:   "/problems/insufficient-stock",
+				Title:  "Insufficient stock",
+				Status: http.StatusBadRequest,
+				Detail: domain.ErrInsufficientStock.Error(),
+			},
+		},
+		{
+			name: "Success - checkout conflict lists the lines",
+			err:  fmt.Errorf("error checking out cart: %w", &domain.CheckoutConflictError{Lines: lines}),
+			expected: problem.Problem{
+				Type:      "/problems/checkout-conflict",
+				Title:     "Checkout conflict",
+				Status:    http.StatusConflict,
+				Detail:    domain.ErrCheckoutConflict.Error(),
+				Conflicts: lines,
+			},
+		},
+		{
+			name: "Success - server error of the domain hides what it wraps",
+			err:  fmt.Errorf("%w: dial tcp 10.0.0.7:443: i/o timeout", domain.ErrPaymentTimeout),
+			expected: problem.Problem{
+				Type:   "/problems/payment-timeout",
+				Title:  "Payment timeout",
+				Status: http.StatusGatewayTimeout,
+				Detail: domain.ErrPaymentTimeout.Error(),
+			},
+		},
+		{
+			name: "Success - client error of the domain hides what it is joined with",
+			err:  errors.Join(domain.ErrPaymentDeclined, errors.New("Error 1213: Deadlock found when trying to get lock")),
+			expected: problem.Problem{
+				Type:   "/problems/payment-declined",
+				Title:  "Payment declined",
+				Status: http.StatusPaymentRequired,
+				Detail: domain.ErrPaymentDeclined.Error(),
+			},
+		},
+		{
+			name: "Success - failed compensation is a server error",
+			err: errors.Join(
+				fmt.Errorf("%w: card expired", domain.ErrPaymentDeclined),
+				fmt.Errorf("%w: Error 1213: Deadlock found when trying to get lock", domain.ErrCompensationFailed),
+			),
+			expected: problem.Problem{
+				Type:   "/problems/compensation-failed",
+				Title:  "Compensation failed",
+				Status: http.StatusInternalServerError,
+				Detail: domain.ErrCompensationFailed.Error(),
+			},
+		},
+		{
+			name: "Success - infrastructure error is the message",
+			err:  errors.New("Error 1045: Access denied for user 'catalog'@'10.0.0.3'"),
+			expected: problem.Problem{
+				Type:   "about:blank",
+				Title:  "Internal Server Error",
+				Status: http.StatusInternalServerError,
+				Detail: "error fetching products",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			p := problem.FromError("error fetching products", tc.err)
+
+			// Assert
+			assert.Equal(t, tc.expected, p)
+		})
+	}
+}
+
+func TestFromBodyError(t *testing.T) {
+	t.Parallel()
+
+	type body struct {
+		Name  string       `json:"name"`
+		Stock int          `json:"stock"`
+		Price domain.Money `json:"price"`
+	}
+
+	testCases := []struct {
+		name           string
+		body           string
+		limit          int64
+		expectedStatus int
+		expectedType   string
+		expectedErrors []problem.FieldError
+	}{
+		{
+			name:           "Failure - malformed JSON",
+			body:           `{"name":`,
+			expectedStatus: http.StatusBadRequest,
+			expectedType:   "/problems/malformed-body",
+		},
+		{
+			name:           "Failure - body of another type",
+			body:           `[1, 2]`,
+			expectedStatus: http.StatusBadRequest,
+			expectedType:   "/problems/malformed-body",
+		},
+		{
+			name:           "Failure - field of another type",
+			body:           `{"stock": "ten"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedType:   "/problems/validation-error",
+			expectedErrors: []problem.FieldError{{Field: "stock", Detail: "must be an integer"}},
+		},
+		{
+			name:           "Failure - invalid money",
+			body:           `{"price": "ten"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedType:   "/problems/invalid-money",
+		},
+		{
+			name:           "Failure - body too large",
+			body:           `{"name": "a long name"}`,
+			limit:          8,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedType:   "/problems/body-too-large",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			var err error
+			if tc.limit > 0 {
+				reader := http.MaxBytesReader(httptest.NewRecorder(), io.NopCloser(strings.NewReader(tc.body)), tc.limit)
+				_, err = io.ReadAll(reader)
+			} else {
+				var decoded body
+				err = json.Unmarshal([]byte(tc.body), &decoded)
+			}
+			require.Error(t, err)
+
+			// Act
+			p := problem.FromBodyError(err)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, p.Status)
+			assert.Equal(t, tc.expectedType, p.Type)
+			assert.Equal(t, tc.expectedErrors, p.Errors)
+		})
+	}
+}
+
+func TestValidation(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	body := dto.CreateProductRequest{
+		Name:  "",
+		Price: domain.NewMoney(5, domain.BaseCurrency),
+	}
+
+	// Act
+	p := problem.Validation(dto.NewValidator().Struct(body))
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, p.Status)
+	assert.Equal(t, "/problems/validation-error", p.Type)
+	assert.Contains(t, p.Errors, problem.FieldError{Field: "name", Detail: "is required"})
+	assert.Contains(t, p.Errors, problem.FieldError{Field: "price", Detail: "must be at least 0.10"})
+}
+
+func TestValidation_NotValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	p := problem.Validation(errors.New("validator: (nil *dto.CreateProductRequest)"))
+
+	// Assert
+	assert.Equal(t, problem.New(http.StatusInternalServerError, "error validating body"), p)
+}
+
+func TestError(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/api/products/p-1", nil)
+
+	// Act
+	problem.Error(recorder, request, "error fetching product", errors.New("dial tcp 10.0.0.2:3306: connection refused"))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Equal(t, problem.ContentType, recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "nosniff", recorder.Header().Get("X-Content-Type-Options"))
+	assert.NotContains(t, recorder.Body.String(), "10.0.0.2")
+	assert.JSONEq(t,
+		`{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"error fetching product","instance":"/api/products/p-1"}`,
+		recorder.Body.String())
+}
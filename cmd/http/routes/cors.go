@@ -1,20 +1,144 @@
 package routes
 
-import "net/http"
+import (
+	"microservice-products-catalog/cmd/http/config"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
 
-func EnableProductsCORS(h http.HandlerFunc) http.HandlerFunc {
+// CORS answers the cross-origin requests of a route group following its config.CORSPolicy.
+// The allowed origin is echoed with Vary: Origin, a request from another origin is served
+// without CORS headers so the browser blocks the response. A preflight is answered here and
+// never reaches the route: 204 when the origin, the method and the headers are allowed, 403
+// otherwise.
+type CORS struct {
+	policy         config.CORSPolicy
+	origins        []string
+	wildcards      []originWildcard
+	anyOrigin      bool
+	allowedMethods string
+	allowedHeaders map[string]bool
+	exposedHeaders string
+	maxAge         string
+}
+
+// originWildcard matches the subdomains of an origin such as https://*.example.com, at any
+// depth but not the domain itself.
+type originWildcard struct {
+	prefix string
+	suffix string
+}
+
+func (o originWildcard) match(origin string) bool {
+	return len(origin) > len(o.prefix)+len(o.suffix) && strings.HasPrefix(origin, o.prefix) && strings.HasSuffix(origin, o.suffix)
+}
+
+// NewCORS prepares policy, it is checked by config.Config.Validate.
+func NewCORS(policy config.CORSPolicy) *CORS {
+	c := &CORS{
+		policy:         policy,
+		allowedMethods: strings.Join(policy.AllowedMethods, ", "),
+		allowedHeaders: map[string]bool{},
+		exposedHeaders: strings.Join(policy.ExposedHeaders, ", "),
+		maxAge:         strconv.Itoa(int(policy.MaxAge.Seconds())),
+	}
+	for _, origin := range policy.AllowedOrigins {
+		origin = strings.ToLower(origin)
+		switch {
+		case origin == "*":
+			c.anyOrigin = true
+		case strings.Contains(origin, "://*."):
+			scheme, host, _ := strings.Cut(origin, "://*")
+			c.wildcards = append(c.wildcards, originWildcard{prefix: scheme + "://", suffix: host})
+		default:
+			c.origins = append(c.origins, origin)
+		}
+	}
+	for _, header := range policy.AllowedHeaders {
+		c.allowedHeaders[http.CanonicalHeaderKey(header)] = true
+	}
+	return c
+}
+
+// Handle wraps the handler of a route of the group.
+func (c *CORS) Handle(h http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		// the answer depends on the origin, caches must not share it between origins
+		w.Header().Add("Vary", "Origin")
+		origin := r.Header.Get("Origin")
 
-		w.Header().Set("Access-Control-Allow-Origin", "http://localhost:3000")
-		w.Header().Set("Vary", "Origin")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Project-ID")
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			if origin == "" || !c.allowPreflight(origin, r) {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			c.writeOrigin(w, origin)
+			w.Header().Set("Access-Control-Allow-Methods", c.allowedMethods)
+			if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+				w.Header().Set("Access-Control-Allow-Headers", requested)
+			}
+			if c.policy.MaxAge > 0 {
+				w.Header().Set("Access-Control-Max-Age", c.maxAge)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
 
 		if r.Method == http.MethodOptions {
+			w.Header().Set("Allow", strings.Join(append([]string{http.MethodOptions}, c.policy.AllowedMethods...), ", "))
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
 
+		if origin != "" && c.allowOrigin(origin) {
+			c.writeOrigin(w, origin)
+			if c.exposedHeaders != "" {
+				w.Header().Set("Access-Control-Expose-Headers", c.exposedHeaders)
+			}
+		}
 		h(w, r)
 	}
 }
+
+func (c *CORS) writeOrigin(w http.ResponseWriter, origin string) {
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	if c.policy.AllowCredentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+func (c *CORS) allowOrigin(origin string) bool {
+	if c.anyOrigin {
+		return true
+	}
+	origin = strings.ToLower(origin)
+	if slices.Contains(c.origins, origin) {
+		return true
+	}
+	for _, wildcard := range c.wildcards {
+		if wildcard.match(origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *CORS) allowPreflight(origin string, r *http.Request) bool {
+	if !c.allowOrigin(origin) {
+		return false
+	}
+	if !slices.Contains(c.policy.AllowedMethods, r.Header.Get("Access-Control-Request-Method")) {
+		return false
+	}
+	for _, header := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+		header = strings.TrimSpace(header)
+		if header != "" && !c.allowedHeaders[http.CanonicalHeaderKey(header)] {
+			return false
+		}
+	}
+	return true
+}
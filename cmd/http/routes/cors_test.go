@@ -0,0 +1,198 @@
+package routes_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/config"
+	"microservice-products-catalog/cmd/http/routes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCORS_Handle(t *testing.T) {
+	policy := config.CORSPolicy{
+		AllowedOrigins:   []string{"https://shop.example.com", "https://*.staging.example.com"},
+		AllowedMethods:   []string{http.MethodGet, http.MethodPost},
+		AllowedHeaders:   []string{"Content-Type", "Authorization"},
+		ExposedHeaders:   []string{"X-Request-ID"},
+		AllowCredentials: true,
+		MaxAge:           10 * time.Minute,
+	}
+
+	testCases := []struct {
+		name            string
+		policy          config.CORSPolicy
+		method          string
+		headers         map[string]string
+		expectedStatus  int
+		expectedHeaders map[string]string
+		expectServed    bool
+	}{
+		{
+			name:           "Success - exact origin echoed",
+			policy:         policy,
+			method:         http.MethodGet,
+			headers:        map[string]string{"Origin": "https://shop.example.com"},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://shop.example.com",
+				"Access-Control-Allow-Credentials": "true",
+				"Access-Control-Expose-Headers":    "X-Request-ID",
+				"Vary":                             "Origin",
+			},
+			expectServed: true,
+		},
+		{
+			name:           "Success - wildcard subdomain echoed",
+			policy:         policy,
+			method:         http.MethodGet,
+			headers:        map[string]string{"Origin": "https://pr-42.staging.example.com"},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "https://pr-42.staging.example.com",
+			},
+			expectServed: true,
+		},
+		{
+			name:           "Success - wildcard does not match the domain itself",
+			policy:         policy,
+			method:         http.MethodGet,
+			headers:        map[string]string{"Origin": "https://staging.example.com"},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "",
+				"Vary":                        "Origin",
+			},
+			expectServed: true,
+		},
+		{
+			name:           "Success - other origin served without CORS headers",
+			policy:         policy,
+			method:         http.MethodGet,
+			headers:        map[string]string{"Origin": "https://evil.example.org"},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":   "",
+				"Access-Control-Expose-Headers": "",
+			},
+			expectServed: true,
+		},
+		{
+			name:           "Success - request without origin",
+			policy:         policy,
+			method:         http.MethodGet,
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "",
+			},
+			expectServed: true,
+		},
+		{
+			name:   "Success - any origin",
+			policy: config.CORSPolicy{AllowedOrigins: []string{"*"}, AllowedMethods: []string{http.MethodGet}},
+			method: http.MethodGet,
+			headers: map[string]string{
+				"Origin": "https://partner.example.net",
+			},
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://partner.example.net",
+				"Access-Control-Allow-Credentials": "",
+			},
+			expectServed: true,
+		},
+		{
+			name:   "Success - 204 preflight allowed",
+			policy: policy,
+			method: http.MethodOptions,
+			headers: map[string]string{
+				"Origin":                         "https://shop.example.com",
+				"Access-Control-Request-Method":  http.MethodPost,
+				"Access-Control-Request-Headers": "content-type, authorization",
+			},
+			expectedStatus: http.StatusNoContent,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":      "https://shop.example.com",
+				"Access-Control-Allow-Methods":     "GET, POST",
+				"Access-Control-Allow-Headers":     "content-type, authorization",
+				"Access-Control-Allow-Credentials": "true",
+				"Access-Control-Max-Age":           "600",
+			},
+		},
+		{
+			name:   "Failure - 403 preflight from another origin",
+			policy: policy,
+			method: http.MethodOptions,
+			headers: map[string]string{
+				"Origin":                        "https://evil.example.org",
+				"Access-Control-Request-Method": http.MethodGet,
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "",
+				"Access-Control-Allow-Methods": "",
+			},
+		},
+		{
+			name:   "Failure - 403 preflight of a method not allowed",
+			policy: policy,
+			method: http.MethodOptions,
+			headers: map[string]string{
+				"Origin":                        "https://shop.example.com",
+				"Access-Control-Request-Method": http.MethodDelete,
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "Failure - 403 preflight of a header not allowed",
+			policy: policy,
+			method: http.MethodOptions,
+			headers: map[string]string{
+				"Origin":                         "https://shop.example.com",
+				"Access-Control-Request-Method":  http.MethodPost,
+				"Access-Control-Request-Headers": "Content-Type, X-Debug",
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Success - 204 options without preflight",
+			policy:         policy,
+			method:         http.MethodOptions,
+			expectedStatus: http.StatusNoContent,
+			expectedHeaders: map[string]string{
+				"Allow": "OPTIONS, GET, POST",
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			served := false
+			handler := routes.NewCORS(tc.policy).Handle(func(w http.ResponseWriter, r *http.Request) {
+				served = true
+				w.WriteHeader(http.StatusOK)
+			})
+
+			request := httptest.NewRequest(tc.method, "/api/products", nil)
+			for key, value := range tc.headers {
+				request.Header.Set(key, value)
+			}
+			recorder := httptest.NewRecorder()
+
+			// Act
+			handler(recorder, request)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			assert.Equal(t, tc.expectServed, served)
+			for key, value := range tc.expectedHeaders {
+				assert.Equal(t, value, recorder.Header().Get(key), key)
+			}
+		})
+	}
+}
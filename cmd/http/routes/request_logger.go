@@ -0,0 +1,76 @@
+package routes
+
+import (
+	"github.com/google/uuid"
+	"log/slog"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"net/http"
+	"time"
+)
+
+// RequestIDHeader carries the request ID, a client or proxy may send its own and it is
+// echoed on the response.
+const RequestIDHeader = "X-Request-ID"
+
+// LogRequests puts a logger with the request ID in the context of every request, so the
+// handlers, services and repositories log with it, and logs each request once it is served.
+func LogRequests(logger *slog.Logger, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(RequestIDHeader)
+		if requestID == "" || len(requestID) > 128 {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
+
+		requestLogger := logger.With("request_id", requestID)
+		recorder := &statusRecorder{ResponseWriter: w}
+		// the mux sets the matched pattern on the request it is given, so it is read from r afterwards
+		r = r.WithContext(logging.WithLogger(r.Context(), requestLogger))
+		start := time.Now()
+
+		defer func() {
+			requestLogger.Info("request served",
+				"method", r.Method,
+				"route", r.Pattern,
+				"path", r.URL.Path,
+				"status", recorder.Status(),
+				"latency", time.Since(start),
+			)
+		}()
+
+		h.ServeHTTP(recorder, r)
+	})
+}
+
+// statusRecorder keeps the status code written by the handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	if s.status == 0 {
+		s.status = status
+	}
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.status == 0 {
+		s.status = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+// Status is the written status code, 200 when the handler wrote nothing.
+func (s *statusRecorder) Status() int {
+	if s.status == 0 {
+		return http.StatusOK
+	}
+	return s.status
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
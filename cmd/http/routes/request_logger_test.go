@@ -0,0 +1,89 @@
+package routes_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"microservice-products-catalog/cmd/http/routes"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLogRequests(t *testing.T) {
+	testCases := []struct {
+		name            string
+		requestID       string
+		expectedStatus  int
+		expectGenerated bool
+	}{
+		{
+			name:           "Success - Request ID of the client is kept",
+			requestID:      "req-18eb9153",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:            "Success - Request ID is generated when missing",
+			expectedStatus:  http.StatusNotFound,
+			expectGenerated: true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			var out bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&out, nil))
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/products/", func(w http.ResponseWriter, r *http.Request) {
+				logging.FromContext(r.Context()).Info("product not found")
+				w.WriteHeader(http.StatusNotFound)
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/api/products/42", nil)
+			if tc.requestID != "" {
+				request.Header.Set(routes.RequestIDHeader, tc.requestID)
+			}
+			recorder := httptest.NewRecorder()
+
+			// Act
+			routes.LogRequests(logger, mux).ServeHTTP(recorder, request)
+
+			// Assert
+			requestID := recorder.Header().Get(routes.RequestIDHeader)
+			if tc.expectGenerated {
+				assert.NotEmpty(t, requestID)
+			} else {
+				assert.Equal(t, tc.requestID, requestID)
+			}
+
+			var entries []map[string]any
+			decoder := json.NewDecoder(&out)
+			for decoder.More() {
+				var entry map[string]any
+				require.NoError(t, decoder.Decode(&entry))
+				entries = append(entries, entry)
+			}
+			require.Len(t, entries, 2)
+
+			assert.Equal(t, "product not found", entries[0]["msg"])
+			assert.Equal(t, requestID, entries[0]["request_id"])
+
+			served := entries[1]
+			assert.Equal(t, "request served", served["msg"])
+			assert.Equal(t, requestID, served["request_id"])
+			assert.Equal(t, http.MethodGet, served["method"])
+			assert.Equal(t, "/api/products/", served["route"])
+			assert.Equal(t, "/api/products/42", served["path"])
+			assert.Equal(t, float64(tc.expectedStatus), served["status"])
+			assert.Contains(t, served, "latency")
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"net/http"
+	"time"
+)
+
+// RequestObserver records the requests served, see metrics.Metrics.
+type RequestObserver interface {
+	ObserveRequest(method string, route string, status int, duration time.Duration)
+}
+
+// InstrumentRequests records the count and duration of the requests by route and status. The
+// route is the pattern matched by the mux, so the paths with ids do not make a series each.
+func InstrumentRequests(observer RequestObserver, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder := &statusRecorder{ResponseWriter: w}
+		start := time.Now()
+
+		defer func() {
+			route := r.Pattern
+			if route == "" {
+				route = "unmatched"
+			}
+			observer.ObserveRequest(r.Method, route, recorder.Status(), time.Since(start))
+		}()
+
+		h.ServeHTTP(recorder, r)
+	})
+}
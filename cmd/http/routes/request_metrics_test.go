@@ -0,0 +1,48 @@
+package routes_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/routes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type observedRequest struct {
+	method string
+	route  string
+	status int
+}
+
+type requestObserver struct {
+	requests []observedRequest
+}
+
+func (o *requestObserver) ObserveRequest(method string, route string, status int, duration time.Duration) {
+	o.requests = append(o.requests, observedRequest{method: method, route: route, status: status})
+}
+
+func TestInstrumentRequests(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/orders/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("/api/products", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("[]"))
+	})
+
+	observer := &requestObserver{}
+	handler := routes.InstrumentRequests(observer, mux)
+
+	for _, path := range []string{"/api/orders/18eb9153/refunds", "/api/orders/5f3c2b1a/refunds", "/api/products", "/unknown"} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, path, nil))
+	}
+
+	assert.Equal(t, []observedRequest{
+		{method: http.MethodPost, route: "/api/orders/", status: http.StatusCreated},
+		{method: http.MethodPost, route: "/api/orders/", status: http.StatusCreated},
+		{method: http.MethodPost, route: "/api/products", status: http.StatusOK},
+		{method: http.MethodPost, route: "unmatched", status: http.StatusNotFound},
+	}, observer.requests)
+}
@@ -0,0 +1,44 @@
+package routes
+
+import (
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+// TraceRequests starts a span per request, a child of the W3C traceparent header when the
+// client sends one. The span is named after the route matched by the mux.
+//
+// It must wrap the mux directly: the mux sets the matched pattern on the request it is given,
+// so the pattern is copied back to the request of the outer middlewares.
+func TraceRequests(tracer trace.Tracer, h http.Handler) http.Handler {
+	propagator := propagation.TraceContext{}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracer.Start(ctx, r.Method, trace.WithSpanKind(trace.SpanKindServer))
+		defer span.End()
+
+		recorder := &statusRecorder{ResponseWriter: w}
+		traced := r.WithContext(ctx)
+		h.ServeHTTP(recorder, traced)
+		r.Pattern = traced.Pattern
+
+		status := recorder.Status()
+		if traced.Pattern != "" {
+			span.SetName(fmt.Sprintf("%s %s", r.Method, traced.Pattern))
+		}
+		span.SetAttributes(
+			attribute.String("http.request.method", r.Method),
+			attribute.String("http.route", traced.Pattern),
+			attribute.String("url.path", r.URL.Path),
+			attribute.Int("http.response.status_code", status),
+		)
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package routes_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"microservice-products-catalog/cmd/http/routes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTraceRequests(t *testing.T) {
+	testCases := []struct {
+		name           string
+		traceparent    string
+		status         int
+		expectedParent string
+		expectedStatus codes.Code
+	}{
+		{
+			name:           "Success - Span continues the trace of the traceparent header",
+			traceparent:    "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			status:         http.StatusCreated,
+			expectedParent: "00f067aa0ba902b7",
+			expectedStatus: codes.Unset,
+		},
+		{
+			name:           "Success - Span starts a trace without traceparent, 5xx are errors",
+			status:         http.StatusBadGateway,
+			expectedStatus: codes.Error,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			recorder := tracetest.NewSpanRecorder()
+			tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+			var handlerSpan trace.SpanContext
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/orders/", func(w http.ResponseWriter, r *http.Request) {
+				handlerSpan = trace.SpanContextFromContext(r.Context())
+				w.WriteHeader(tc.status)
+			})
+
+			request := httptest.NewRequest(http.MethodPost, "/api/orders/18eb9153/refunds", nil)
+			if tc.traceparent != "" {
+				request.Header.Set("traceparent", tc.traceparent)
+			}
+
+			// Act
+			routes.TraceRequests(tracer, mux).ServeHTTP(httptest.NewRecorder(), request)
+
+			// Assert
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+
+			assert.Equal(t, "POST /api/orders/", span.Name())
+			assert.Equal(t, "/api/orders/", request.Pattern)
+			assert.Equal(t, span.SpanContext().SpanID(), handlerSpan.SpanID())
+			assert.Contains(t, span.Attributes(), attribute.String("http.route", "/api/orders/"))
+			assert.Contains(t, span.Attributes(), attribute.Int("http.response.status_code", tc.status))
+			assert.Equal(t, tc.expectedStatus, span.Status().Code)
+			if tc.expectedParent != "" {
+				assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+				assert.Equal(t, tc.expectedParent, span.Parent().SpanID().String())
+			} else {
+				assert.False(t, span.Parent().IsValid())
+			}
+		})
+	}
+}
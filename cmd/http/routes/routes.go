@@ -1,14 +1,19 @@
 package routes
 
 import (
+	"microservice-products-catalog/cmd/http/auth"
 	"microservice-products-catalog/cmd/http/dependencies"
+	"microservice-products-catalog/cmd/http/problem"
 	"net/http"
+	"strings"
 )
 
 // TODO [technical debate] handle different versions
 
 func SetupProductRoutes(mux *http.ServeMux, dep dependencies.Dependencies) {
-	mux.HandleFunc("/api/products", EnableProductsCORS(func(w http.ResponseWriter, r *http.Request) {
+	cors := NewCORS(dep.CORS.Catalog)
+
+	mux.HandleFunc("/api/products", cors.Handle(dep.RateLimiter.Handle(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
 			dep.ReaderHandler.HandleGetProducts(w, r)
@@ -17,28 +22,121 @@ func SetupProductRoutes(mux *http.ServeMux, dep dependencies.Dependencies) {
 			dep.WriterHandler.HandleCreateProduct(w, r)
 
 		default:
-			w.WriteHeader(http.StatusMethodNotAllowed)
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
 		}
-	}))
-	mux.HandleFunc("/api/products/", EnableProductsCORS(func(w http.ResponseWriter, r *http.Request) {
+	})))
+	mux.HandleFunc("/api/products/export", cors.Handle(dep.RateLimiter.Handle(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
-			dep.ReaderHandler.HandleGetProductByID(w, r)
+			dep.ReaderHandler.HandleExportProducts(w, r)
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	})))
 
-		case http.MethodDelete:
-			dep.WriterHandler.HandleDeleteProduct(w, r)
+	mux.HandleFunc("/api/products/import", cors.Handle(dep.RateLimiter.Handle(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			dep.WriterHandler.HandleImportProducts(w, r)
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	})))
 
-		case http.MethodPut:
-			dep.WriterHandler.HandleUpdateProduct(w, r)
+	// /api/products/{id}, /api/products/{id}/variants, /api/products/{id}/variants/{variantID},
+	// /api/products/{id}/prices/{currency}, /api/products/{id}/price-history
+	// and /api/products/{id}/scheduled-prices
+	mux.HandleFunc("/api/products/", cors.Handle(dep.RateLimiter.Handle(func(w http.ResponseWriter, r *http.Request) {
+		segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/products/"), "/"), "/")
+
+		switch {
+		case len(segments) == 1:
+			switch r.Method {
+			case http.MethodGet:
+				dep.ReaderHandler.HandleGetProductByID(w, r)
+
+			case http.MethodDelete:
+				dep.WriterHandler.HandleDeleteProduct(w, r)
+
+			case http.MethodPut:
+				dep.WriterHandler.HandleUpdateProduct(w, r)
+
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 2 && segments[1] == "variants":
+			switch r.Method {
+			case http.MethodPost:
+				dep.WriterHandler.HandleCreateVariant(w, r)
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 2 && segments[1] == "price-history":
+			switch r.Method {
+			case http.MethodGet:
+				dep.ReaderHandler.HandleGetPriceHistory(w, r)
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 2 && segments[1] == "scheduled-prices":
+			switch r.Method {
+			case http.MethodGet:
+				dep.ReaderHandler.HandleGetScheduledPrices(w, r)
+
+			case http.MethodPost:
+				dep.WriterHandler.HandleCreateScheduledPrice(w, r)
+
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 3 && segments[1] == "variants":
+			switch r.Method {
+			case http.MethodPut:
+				dep.WriterHandler.HandleUpdateVariant(w, r)
+
+			case http.MethodDelete:
+				dep.WriterHandler.HandleDeleteVariant(w, r)
+
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 3 && segments[1] == "prices":
+			switch r.Method {
+			case http.MethodPut:
+				dep.WriterHandler.HandleSetProductPrice(w, r)
+
+			case http.MethodDelete:
+				dep.WriterHandler.HandleDeleteProductPrice(w, r)
+
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
 
 		default:
-			w.WriteHeader(http.StatusMethodNotAllowed)
+			http.NotFound(w, r)
 		}
-	}))
+	})))
 }
 
+// SetupOrderRoutes requires a bearer token, customers only see their own orders.
 func SetupOrderRoutes(mux *http.ServeMux, dep dependencies.Dependencies) {
-	mux.HandleFunc("/api/orders", EnableProductsCORS(func(w http.ResponseWriter, r *http.Request) {
+	cors := NewCORS(dep.CORS.Account)
+
+	mux.HandleFunc("/api/orders/export", cors.Handle(dep.RateLimiter.Handle(auth.RequireToken(dep.TokenVerifier, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			dep.ReaderHandler.HandleExportOrders(w, r)
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	}))))
+
+	mux.HandleFunc("/api/orders", cors.Handle(dep.RateLimiter.Handle(auth.RequireToken(dep.TokenVerifier, func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
 			dep.ReaderHandler.HandleGetOrders(w, r)
@@ -47,7 +145,297 @@ func SetupOrderRoutes(mux *http.ServeMux, dep dependencies.Dependencies) {
 			dep.WriterHandler.HandleCreateOrder(w, r)
 
 		default:
-			w.WriteHeader(http.StatusMethodNotAllowed)
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	}))))
+
+	// /api/orders/{id}, /api/orders/{id}/refunds and /api/orders/{id}/invoice
+	mux.HandleFunc("/api/orders/", cors.Handle(dep.RateLimiter.Handle(auth.RequireToken(dep.TokenVerifier, func(w http.ResponseWriter, r *http.Request) {
+		segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/orders/"), "/"), "/")
+
+		switch {
+		case len(segments) == 1:
+			switch r.Method {
+			case http.MethodGet:
+				dep.ReaderHandler.HandleGetOrderByID(w, r)
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 2 && segments[1] == "invoice":
+			switch r.Method {
+			case http.MethodGet:
+				dep.ReaderHandler.HandleGetOrderInvoice(w, r)
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 2 && segments[1] == "refunds":
+			switch r.Method {
+			case http.MethodPost:
+				dep.WriterHandler.HandleCreateRefund(w, r)
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))))
+}
+
+func SetupInventoryRoutes(mux *http.ServeMux, dep dependencies.Dependencies) {
+	cors := NewCORS(dep.CORS.Catalog)
+
+	mux.HandleFunc("/api/inventory/alerts", cors.Handle(dep.RateLimiter.Handle(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			dep.ReaderHandler.HandleGetStockAlerts(w, r)
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	})))
+}
+
+func SetupCategoryRoutes(mux *http.ServeMux, dep dependencies.Dependencies) {
+	cors := NewCORS(dep.CORS.Catalog)
+
+	mux.HandleFunc("/api/categories", cors.Handle(dep.RateLimiter.Handle(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			dep.ReaderHandler.HandleGetCategories(w, r)
+
+		case http.MethodPost:
+			dep.WriterHandler.HandleCreateCategory(w, r)
+
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	})))
+
+	// /api/categories/{id}, /api/categories/{id}/products and /api/categories/{id}/products/{productID}
+	mux.HandleFunc("/api/categories/", cors.Handle(dep.RateLimiter.Handle(func(w http.ResponseWriter, r *http.Request) {
+		segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/categories/"), "/"), "/")
+
+		switch {
+		case len(segments) == 1:
+			switch r.Method {
+			case http.MethodGet:
+				dep.ReaderHandler.HandleGetCategoryByID(w, r)
+
+			case http.MethodPut:
+				dep.WriterHandler.HandleUpdateCategory(w, r)
+
+			case http.MethodDelete:
+				dep.WriterHandler.HandleDeleteCategory(w, r)
+
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 2 && segments[1] == "products":
+			switch r.Method {
+			case http.MethodGet:
+				dep.ReaderHandler.HandleGetCategoryProducts(w, r)
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 3 && segments[1] == "products":
+			switch r.Method {
+			case http.MethodPut:
+				dep.WriterHandler.HandleAddCategoryProduct(w, r)
+
+			case http.MethodDelete:
+				dep.WriterHandler.HandleRemoveCategoryProduct(w, r)
+
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		default:
+			http.NotFound(w, r)
+		}
+	})))
+}
+
+// SetupPromotionRoutes requires a bearer token, the promotions and their coupon codes are only
+// reached by admins.
+func SetupPromotionRoutes(mux *http.ServeMux, dep dependencies.Dependencies) {
+	cors := NewCORS(dep.CORS.Account)
+
+	mux.HandleFunc("/api/promotions", cors.Handle(dep.RateLimiter.Handle(auth.RequireToken(dep.TokenVerifier, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			dep.ReaderHandler.HandleGetPromotions(w, r)
+
+		case http.MethodPost:
+			dep.WriterHandler.HandleCreatePromotion(w, r)
+
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	}))))
+
+	mux.HandleFunc("/api/promotions/", cors.Handle(dep.RateLimiter.Handle(auth.RequireToken(dep.TokenVerifier, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			dep.ReaderHandler.HandleGetPromotionByID(w, r)
+
+		case http.MethodPut:
+			dep.WriterHandler.HandleUpdatePromotion(w, r)
+
+		case http.MethodDelete:
+			dep.WriterHandler.HandleDeletePromotion(w, r)
+
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	}))))
+}
+
+// SetupCustomerRoutes requires a bearer token, a customer can only reach its own resources.
+func SetupCustomerRoutes(mux *http.ServeMux, dep dependencies.Dependencies) {
+	cors := NewCORS(dep.CORS.Account)
+
+	mux.HandleFunc("/api/customers", cors.Handle(dep.RateLimiter.Handle(auth.RequireToken(dep.TokenVerifier, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			dep.ReaderHandler.HandleGetCustomers(w, r)
+
+		case http.MethodPost:
+			dep.WriterHandler.HandleCreateCustomer(w, r)
+
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	}))))
+
+	// /api/customers/{id} and /api/customers/{id}/orders
+	mux.HandleFunc("/api/customers/", cors.Handle(dep.RateLimiter.Handle(auth.RequireToken(dep.TokenVerifier, func(w http.ResponseWriter, r *http.Request) {
+		segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/customers/"), "/"), "/")
+
+		switch {
+		case len(segments) == 1:
+			switch r.Method {
+			case http.MethodGet:
+				dep.ReaderHandler.HandleGetCustomerByID(w, r)
+
+			case http.MethodPut:
+				dep.WriterHandler.HandleUpdateCustomer(w, r)
+
+			case http.MethodDelete:
+				dep.WriterHandler.HandleDeleteCustomer(w, r)
+
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 2 && segments[1] == "orders":
+			switch r.Method {
+			case http.MethodGet:
+				dep.ReaderHandler.HandleGetCustomerOrders(w, r)
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))))
+}
+
+// SetupCartRoutes requires a bearer token, a customer only reaches its own carts.
+func SetupCartRoutes(mux *http.ServeMux, dep dependencies.Dependencies) {
+	cors := NewCORS(dep.CORS.Account)
+
+	mux.HandleFunc("/api/carts", cors.Handle(dep.RateLimiter.Handle(auth.RequireToken(dep.TokenVerifier, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			dep.WriterHandler.HandleCreateCart(w, r)
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	}))))
+
+	// /api/carts/{id}, /api/carts/{id}/items, /api/carts/{id}/items/{itemID} and /api/carts/{id}/checkout
+	mux.HandleFunc("/api/carts/", cors.Handle(dep.RateLimiter.Handle(auth.RequireToken(dep.TokenVerifier, func(w http.ResponseWriter, r *http.Request) {
+		segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/carts/"), "/"), "/")
+
+		switch {
+		case len(segments) == 1:
+			switch r.Method {
+			case http.MethodGet:
+				dep.ReaderHandler.HandleGetCart(w, r)
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 2 && segments[1] == "items":
+			switch r.Method {
+			case http.MethodPost:
+				dep.WriterHandler.HandleAddCartItem(w, r)
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 3 && segments[1] == "items":
+			switch r.Method {
+			case http.MethodPut:
+				dep.WriterHandler.HandleUpdateCartItem(w, r)
+
+			case http.MethodDelete:
+				dep.WriterHandler.HandleRemoveCartItem(w, r)
+
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		case len(segments) == 2 && segments[1] == "checkout":
+			switch r.Method {
+			case http.MethodPost:
+				dep.WriterHandler.HandleCheckoutCart(w, r)
+			default:
+				problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+			}
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))))
+}
+
+// SetupMetricsRoutes exposes the metrics in the Prometheus text format, it is meant to be
+// scraped from inside the network and is not behind a token.
+func SetupMetricsRoutes(mux *http.ServeMux, dep dependencies.Dependencies) {
+	metricsHandler := dep.Metrics.Handler()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			metricsHandler.ServeHTTP(w, r)
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	})
+}
+
+// SetupProbeRoutes exposes the liveness and readiness probes of the orchestrator, they are not
+// behind a token.
+func SetupProbeRoutes(mux *http.ServeMux, dep dependencies.Dependencies) {
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			dep.ProbeHandler.HandleLiveness(w, r)
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
+		}
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			dep.ProbeHandler.HandleReadiness(w, r)
+		default:
+			problem.WriteStatus(w, r, http.StatusMethodNotAllowed, "")
 		}
-	}))
+	})
 }
@@ -1,19 +1,90 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
 	"fmt"
 	"github.com/joho/godotenv"
-	"log"
+	"log/slog"
 	"microservice-products-catalog/cmd/http/config"
 	"microservice-products-catalog/cmd/http/dependencies"
+	"microservice-products-catalog/cmd/http/lifecycle"
 	"microservice-products-catalog/cmd/http/routes"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
+// main runs the service, or prints its config with "config print". Both take the flags of
+// config.Load, e.g. main config print -config config.yaml -mysql.port 3307.
 func main() {
 	_ = godotenv.Load()
-	cfg := config.LoadConfig()
-	dep := dependencies.InitDependencies(cfg)
+
+	args := os.Args[1:]
+	printConfig := len(args) >= 2 && args[0] == "config" && args[1] == "print"
+	if printConfig {
+		args = args[2:]
+	}
+
+	cfg, err := config.Load(args, os.LookupEnv)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		slog.Error("failed to load config", "error", err)
+		os.Exit(2)
+	}
+
+	if printConfig {
+		if err := config.Print(os.Stdout, cfg); err != nil {
+			slog.Error("failed to print config", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// SIGTERM (orchestrator) and SIGINT (Ctrl+C) start the graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, cfg); err != nil {
+		slog.Error("service stopped", "error", err)
+		os.Exit(1)
+	}
+}
+
+// run starts the service and blocks until ctx is cancelled, then drains the requests in flight
+// and stops the components in order: the HTTP server, the background jobs, the tracer and the
+// MySQL pool.
+func run(ctx context.Context, cfg config.Config) error {
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	logger, err := logging.New(os.Stdout, cfg.Log.Format, cfg.Log.Level)
+	if err != nil {
+		return fmt.Errorf("failed to init logger: %w", err)
+	}
+	slog.SetDefault(logger)
+	ctx = logging.WithLogger(ctx, logger)
+
+	dep, err := dependencies.InitDependencies(cfg, logger)
+	if err != nil {
+		return err
+	}
+	// release releases the tracer provider and the MySQL pool when the service does not start,
+	// once it runs the lifecycle hooks stop them
+	release := func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+		defer cancel()
+		_ = dep.TracerProvider.Shutdown(shutdownCtx)
+		dep.Repository.Close()
+	}
 
 	// Create a new ServeMux
 	mux := http.NewServeMux()
@@ -21,17 +92,92 @@ func main() {
 	// Register your routes
 	routes.SetupProductRoutes(mux, dep)
 	routes.SetupOrderRoutes(mux, dep)
+	routes.SetupInventoryRoutes(mux, dep)
+	routes.SetupCategoryRoutes(mux, dep)
+	routes.SetupPromotionRoutes(mux, dep)
+	routes.SetupCustomerRoutes(mux, dep)
+	routes.SetupCartRoutes(mux, dep)
+	routes.SetupMetricsRoutes(mux, dep)
+	routes.SetupProbeRoutes(mux, dep)
 
-	const port = ":8000"
-	fmt.Printf("Starting server at port %s\n", port)
+	// the tracing middleware wraps the mux directly, see routes.TraceRequests
+	var handler http.Handler = routes.TraceRequests(dep.Tracer, mux)
+	handler = routes.InstrumentRequests(dep.Metrics, handler)
+	handler = routes.LogRequests(dep.Logger, handler)
 
+	server, err := newServer(cfg.Port, cfg.Server, handler, logger)
+	if err != nil {
+		release()
+		return err
+	}
+	listener, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		release()
+		return fmt.Errorf("failed to listen on %s: %w", server.Addr, err)
+	}
+	logger.Info("starting server", "addr", listener.Addr().String(), "tls", cfg.Server.TLS(), "http2", cfg.Server.HTTP2)
+
+	// the hooks stop in the reverse order: the server first, the pool last
+	app := lifecycle.New(logger)
+	app.Append(lifecycle.Hook{
+		Name: "mysql",
+		OnStop: func(ctx context.Context) error {
+			dep.Repository.Close()
+			return nil
+		},
+	})
+	app.Append(lifecycle.Hook{
+		Name:   "tracer",
+		OnStop: dep.TracerProvider.Shutdown,
+	})
+	app.Append(lifecycle.Worker("price scheduler", func(ctx context.Context) {
+		dep.PriceScheduler.RunPriceScheduler(ctx, cfg.Pricing.SchedulerInterval)
+	}))
+	app.Append(lifecycle.Worker("cart expiry", func(ctx context.Context) {
+		dep.CartExpiry.RunCartExpiry(ctx, cfg.Cart.ExpiryInterval)
+	}))
+	app.Append(lifecycle.Worker("pending order reaper", func(ctx context.Context) {
+		dep.OrderReaper.RunPendingOrderReaper(ctx, cfg.Payment.ReaperInterval, cfg.Payment.PendingTimeout)
+	}))
+	app.Append(lifecycle.Worker("pending refund reconciler", func(ctx context.Context) {
+		dep.RefundReconciler.RunPendingRefundReconciler(ctx, cfg.Payment.ReaperInterval, cfg.Payment.PendingTimeout)
+	}))
+	app.Append(lifecycle.Server(app, server, listener, dep.Health.ShutDown, cfg.Server.DrainDelay))
+
+	return app.Run(ctx, cfg.Server.ShutdownTimeout)
+}
+
+// newServer applies the timeouts and the limits of cfg to the server of handler, and loads the
+// certificate when it is served over TLS. HTTP/1.1 is always served, HTTP/2 when enabled: over
+// TLS it is negotiated with ALPN, over cleartext the clients must use prior knowledge (h2c).
+func newServer(addr string, cfg config.Server, handler http.Handler, logger *slog.Logger) (*http.Server, error) {
 	server := &http.Server{
-		Addr:    port,
-		Handler: mux,
+		Addr:              addr,
+		Handler:           handler,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+		MaxHeaderBytes:    cfg.MaxHeaderBytes,
+		ErrorLog:          slog.NewLogLogger(logger.Handler(), slog.LevelError),
+		Protocols:         new(http.Protocols),
+	}
+
+	server.Protocols.SetHTTP1(true)
+	if cfg.HTTP2 {
+		server.Protocols.SetHTTP2(cfg.TLS())
+		server.Protocols.SetUnencryptedHTTP2(!cfg.TLS())
 	}
 
-	// Start the server
-	if err := server.ListenAndServe(); err != nil {
-		log.Fatal(err)
+	if cfg.TLS() {
+		certificate, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+		}
+		server.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{certificate},
+			MinVersion:   tls.VersionTLS12,
+		}
 	}
+	return server, nil
 }
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/cmd/http/config"
+	"testing"
+)
+
+func TestRun_InvalidConfig(t *testing.T) {
+	cfg := config.Default()
+	cfg.Environment = config.EnvironmentDev
+	cfg.Log.Format = "xml"
+
+	err := run(context.Background(), cfg)
+
+	assert.ErrorContains(t, err, "failed to init logger")
+}
+
+func TestRun_ValidatesConfig(t *testing.T) {
+	cfg := config.Default()
+	cfg.Environment = config.EnvironmentDev
+	cfg.Server.TLSKeyFile = "key.pem"
+
+	err := run(context.Background(), cfg)
+
+	assert.ErrorContains(t, err, "invalid config")
+	assert.ErrorContains(t, err, "TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+}
+
+func TestRun_DependenciesError(t *testing.T) {
+	cfg := config.Default()
+	cfg.Environment = config.EnvironmentDev
+	cfg.MySQL.Host = "127.0.0.1"
+	cfg.MySQL.Port = 1
+
+	err := run(context.Background(), cfg)
+
+	assert.ErrorContains(t, err, "failed to connect mysql")
+}
@@ -8,24 +8,372 @@ CREATE TABLE products (
                           description TEXT,
                           price DECIMAL(10,2) NOT NULL CHECK (price >= 0),
                           stock INT NOT NULL CHECK (stock >= 0),
+                          reorder_point INT NOT NULL DEFAULT 0 CHECK (reorder_point >= 0),
+                          reorder_quantity INT NOT NULL DEFAULT 0 CHECK (reorder_quantity >= 0),
+                          external_sku VARCHAR(64) NULL,
                           created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
                           updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
 
-                          UNIQUE KEY uq_product_name (name)
+                          UNIQUE KEY uq_product_name (name),
+                          UNIQUE KEY uq_product_external_sku (external_sku)
 ) ENGINE=InnoDB;
 
 
+-- VARIANTS
+-- a product without rows here is sold as a single implicit variant using its own price and stock
+CREATE TABLE variants (
+                          id CHAR(36) PRIMARY KEY,
+                          product_id CHAR(36) NOT NULL,
+                          sku VARCHAR(64) NOT NULL,
+                          options JSON NOT NULL,
+                          price DECIMAL(10,2) NULL CHECK (price >= 0),
+                          stock INT NOT NULL CHECK (stock >= 0),
+                          created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                          updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+                          UNIQUE KEY uq_variant_sku (sku),
+                          CONSTRAINT fk_variants_product
+                              FOREIGN KEY (product_id)
+                                  REFERENCES products(id)
+                                  ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+
+CREATE INDEX idx_variants_product_id ON variants(product_id);
+
+
+-- PRODUCT PRICES
+-- list prices in currencies other than the base one, products without a row here are converted with the exchange rates
+CREATE TABLE product_prices (
+                                product_id CHAR(36) NOT NULL,
+                                currency CHAR(3) NOT NULL,
+                                amount DECIMAL(12,2) NOT NULL CHECK (amount >= 0),
+                                updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+                                PRIMARY KEY (product_id, currency),
+                                CONSTRAINT fk_product_prices_product
+                                    FOREIGN KEY (product_id)
+                                        REFERENCES products(id)
+                                        ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+
+-- SCHEDULED PRICES
+-- changes of the base price applied by the price scheduler, previous_price is the price restored at ends_at
+-- status moves pending -> active -> completed (pending -> completed for permanent changes), the row is locked while it moves
+CREATE TABLE scheduled_prices (
+                                  id CHAR(36) PRIMARY KEY,
+                                  product_id CHAR(36) NOT NULL,
+                                  price DECIMAL(10,2) NOT NULL CHECK (price >= 0),
+                                  effective_at TIMESTAMP NOT NULL,
+                                  ends_at TIMESTAMP NULL,
+                                  status VARCHAR(16) NOT NULL DEFAULT 'pending',
+                                  previous_price DECIMAL(10,2) NULL,
+                                  applied_at TIMESTAMP NULL,
+                                  ended_at TIMESTAMP NULL,
+                                  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                  CONSTRAINT fk_scheduled_prices_product
+                                      FOREIGN KEY (product_id)
+                                          REFERENCES products(id)
+                                          ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+
+CREATE INDEX idx_scheduled_prices_product_id ON scheduled_prices(product_id);
+CREATE INDEX idx_scheduled_prices_status_effective_at ON scheduled_prices(status, effective_at);
+CREATE INDEX idx_scheduled_prices_status_ends_at ON scheduled_prices(status, ends_at);
+
+
+-- PRICE HISTORY
+-- written in the transaction that changes the base price, source is manual, import, scheduled or schedule_ended
+CREATE TABLE price_history (
+                               id BIGINT AUTO_INCREMENT PRIMARY KEY,
+                               product_id CHAR(36) NOT NULL,
+                               old_price DECIMAL(10,2) NOT NULL,
+                               new_price DECIMAL(10,2) NOT NULL,
+                               source VARCHAR(16) NOT NULL,
+                               scheduled_price_id CHAR(36) NULL,
+                               changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+                               CONSTRAINT fk_price_history_product
+                                   FOREIGN KEY (product_id)
+                                       REFERENCES products(id)
+                                       ON DELETE CASCADE,
+                               CONSTRAINT fk_price_history_scheduled_price
+                                   FOREIGN KEY (scheduled_price_id)
+                                       REFERENCES scheduled_prices(id)
+                                       ON DELETE SET NULL
+) ENGINE=InnoDB;
+
+
+CREATE INDEX idx_price_history_product_id_changed_at ON price_history(product_id, changed_at);
+
+
+-- PROMOTIONS
+-- promotions without coupon_code apply automatically, usage_limit only caps the redemptions of a coupon
+CREATE TABLE promotions (
+                            id CHAR(36) PRIMARY KEY,
+                            name VARCHAR(255) NOT NULL,
+                            type VARCHAR(32) NOT NULL,
+                            percentage INT NOT NULL DEFAULT 0 CHECK (percentage BETWEEN 0 AND 100),
+                            amount DECIMAL(12,2) NULL CHECK (amount > 0),
+                            currency CHAR(3) NULL,
+                            buy_quantity INT NOT NULL DEFAULT 0,
+                            get_quantity INT NOT NULL DEFAULT 0,
+                            product_id CHAR(36) NULL,
+                            coupon_code VARCHAR(32) NULL,
+                            usage_limit INT NULL CHECK (usage_limit > 0),
+                            redemption_count INT NOT NULL DEFAULT 0,
+                            starts_at TIMESTAMP NULL,
+                            ends_at TIMESTAMP NULL,
+                            active BOOLEAN NOT NULL DEFAULT TRUE,
+                            created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                            updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+                            UNIQUE KEY uq_promotion_coupon_code (coupon_code),
+                            CONSTRAINT fk_promotions_product
+                                FOREIGN KEY (product_id)
+                                    REFERENCES products(id)
+                                    ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+
+-- CUSTOMERS
+-- id is the subject of the tokens issued to the customer
+CREATE TABLE customers (
+                           id CHAR(36) PRIMARY KEY,
+                           email VARCHAR(255) NOT NULL,
+                           name VARCHAR(255) NOT NULL,
+                           created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                           updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+                           UNIQUE KEY uq_customer_email (email)
+) ENGINE=InnoDB;
+
+
+-- CARTS
+-- open carts are deleted once expires_at passes, every change of the items pushes it forward
+CREATE TABLE carts (
+                       id CHAR(36) PRIMARY KEY,
+                       customer_id CHAR(36) NOT NULL,
+                       status VARCHAR(16) NOT NULL DEFAULT 'open',
+                       expires_at TIMESTAMP NOT NULL,
+                       created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                       updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                       CONSTRAINT fk_carts_customer
+                           FOREIGN KEY (customer_id)
+                               REFERENCES customers(id)
+                               ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+
+CREATE INDEX idx_carts_status_expires_at ON carts(status, expires_at);
+
+
+-- CART ITEMS
+-- the prices are not kept, the cart shows the current ones
+CREATE TABLE cart_items (
+                            id CHAR(36) PRIMARY KEY,
+                            cart_id CHAR(36) NOT NULL,
+                            product_id CHAR(36) NOT NULL,
+                            variant_id CHAR(36) NULL,
+                            quantity INT NOT NULL CHECK (quantity > 0),
+                            added_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                            UNIQUE KEY uq_cart_item_product_variant (cart_id, product_id, variant_id),
+                            CONSTRAINT fk_cart_items_cart
+                                FOREIGN KEY (cart_id)
+                                    REFERENCES carts(id)
+                                    ON DELETE CASCADE,
+                            CONSTRAINT fk_cart_items_product
+                                FOREIGN KEY (product_id)
+                                    REFERENCES products(id)
+                                    ON DELETE CASCADE,
+                            CONSTRAINT fk_cart_items_variant
+                                FOREIGN KEY (variant_id)
+                                    REFERENCES variants(id)
+                                    ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+
+-- INVOICE SEQUENCES
+-- next_value is the next invoice number, the row is locked in the order transaction so the numbers have no gaps
+CREATE TABLE invoice_sequences (
+                                   name VARCHAR(32) PRIMARY KEY,
+                                   next_value BIGINT NOT NULL CHECK (next_value > 0)
+) ENGINE=InnoDB;
+
+INSERT INTO invoice_sequences (name, next_value) VALUES ('orders', 1);
+
+
 -- ORDERS
+-- amounts are in currency, exchange_rate is the rate applied to the base price at purchase time (NULL for base or list prices)
+-- subtotal is after discounts and before tax, total = subtotal + tax is the grand total charged
 CREATE TABLE orders (
                         id CHAR(36) PRIMARY KEY,
+                        invoice_number BIGINT NULL,
                         product_id CHAR(36) NOT NULL,
+                        product_name VARCHAR(255) NULL,
+                        variant_id CHAR(36) NULL,
+                        variant_sku VARCHAR(64) NULL,
+                        customer_id CHAR(36) NULL,
                         quantity INT NOT NULL CHECK (quantity > 0),
-                        total DECIMAL(10,2) NOT NULL CHECK (total >= 0),
+                        unit_price DECIMAL(14,2) NOT NULL DEFAULT 0 CHECK (unit_price >= 0),
+                        subtotal DECIMAL(14,2) NOT NULL DEFAULT 0 CHECK (subtotal >= 0),
+                        tax DECIMAL(14,2) NOT NULL DEFAULT 0 CHECK (tax >= 0),
+                        total DECIMAL(14,2) NOT NULL CHECK (total >= 0),
+                        currency CHAR(3) NOT NULL DEFAULT 'USD',
+                        tax_region VARCHAR(16) NULL,
+                        exchange_rate DECIMAL(18,8) NULL,
+                        status VARCHAR(16) NOT NULL DEFAULT 'confirmed',
+                        payment_id VARCHAR(64) NULL,
                         date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                        UNIQUE KEY uq_orders_invoice_number (invoice_number),
                         CONSTRAINT fk_orders_product
                             FOREIGN KEY (product_id)
-                                REFERENCES products(id)
+                                REFERENCES products(id),
+                        CONSTRAINT fk_orders_variant
+                            FOREIGN KEY (variant_id)
+                                REFERENCES variants(id),
+                        CONSTRAINT fk_orders_customer
+                            FOREIGN KEY (customer_id)
+                                REFERENCES customers(id)
+) ENGINE=InnoDB;
+
+
+CREATE INDEX idx_orders_product_id ON orders(product_id);
+CREATE INDEX idx_orders_customer_id_date ON orders(customer_id, date);
+CREATE INDEX idx_orders_status_date ON orders(status, date);
+
+
+-- ORDER DISCOUNTS
+-- breakdown of the promotions applied to an order, name and coupon_code are kept when the promotion is deleted
+CREATE TABLE order_discounts (
+                                 id BIGINT AUTO_INCREMENT PRIMARY KEY,
+                                 order_id CHAR(36) NOT NULL,
+                                 promotion_id CHAR(36) NULL,
+                                 name VARCHAR(255) NOT NULL,
+                                 coupon_code VARCHAR(32) NULL,
+                                 amount DECIMAL(14,2) NOT NULL CHECK (amount >= 0),
+                                 currency CHAR(3) NOT NULL,
+                                 CONSTRAINT fk_order_discounts_order
+                                     FOREIGN KEY (order_id)
+                                         REFERENCES orders(id)
+                                         ON DELETE CASCADE,
+                                 CONSTRAINT fk_order_discounts_promotion
+                                     FOREIGN KEY (promotion_id)
+                                         REFERENCES promotions(id)
+                                         ON DELETE SET NULL
+) ENGINE=InnoDB;
+
+
+CREATE INDEX idx_order_discounts_order_id ON order_discounts(order_id);
+
+
+-- ORDER REFUNDS
+CREATE TABLE order_refunds (
+                               id CHAR(36) PRIMARY KEY,
+                               order_id CHAR(36) NOT NULL,
+                               quantity INT NOT NULL CHECK (quantity > 0),
+                               amount DECIMAL(14,2) NOT NULL CHECK (amount >= 0),
+                               currency CHAR(3) NOT NULL,
+                               reason VARCHAR(255) NOT NULL,
+                               restock BOOLEAN NOT NULL DEFAULT FALSE,
+                               status VARCHAR(16) NOT NULL DEFAULT 'completed',
+                               created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                               CONSTRAINT fk_order_refunds_order
+                                   FOREIGN KEY (order_id)
+                                       REFERENCES orders(id)
+                                       ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+
+CREATE INDEX idx_order_refunds_order_id ON order_refunds(order_id);
+CREATE INDEX idx_order_refunds_status_created_at ON order_refunds(status, created_at);
+
+
+-- STOCK ALERTS
+-- open_product_id is only set while the alert is open, the unique key keeps a single open alert per product
+CREATE TABLE stock_alerts (
+                              id CHAR(36) PRIMARY KEY,
+                              product_id CHAR(36) NOT NULL,
+                              stock INT NOT NULL,
+                              reorder_point INT NOT NULL,
+                              reorder_quantity INT NOT NULL,
+                              created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                              resolved_at TIMESTAMP NULL DEFAULT NULL,
+                              open_product_id CHAR(36) AS (IF(resolved_at IS NULL, product_id, NULL)) STORED,
+
+                              UNIQUE KEY uq_stock_alert_open (open_product_id),
+                              CONSTRAINT fk_stock_alerts_product
+                                  FOREIGN KEY (product_id)
+                                      REFERENCES products(id)
+                                      ON DELETE CASCADE
 ) ENGINE=InnoDB;
 
 
-CREATE INDEX idx_orders_product_id ON orders(product_id);
\ No newline at end of file
+CREATE INDEX idx_stock_alerts_product_id ON stock_alerts(product_id);
+
+
+-- CATEGORIES
+-- parent_id is NULL for the roots, a category with subcategories cannot be deleted
+CREATE TABLE categories (
+                            id CHAR(36) PRIMARY KEY,
+                            name VARCHAR(255) NOT NULL,
+                            description TEXT,
+                            parent_id CHAR(36) NULL,
+                            created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                            updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+                            CONSTRAINT fk_categories_parent
+                                FOREIGN KEY (parent_id)
+                                    REFERENCES categories(id)
+                                    ON DELETE RESTRICT
+) ENGINE=InnoDB;
+
+
+CREATE INDEX idx_categories_parent_id ON categories(parent_id);
+
+
+-- PRODUCT CATEGORIES
+CREATE TABLE product_categories (
+                                    product_id CHAR(36) NOT NULL,
+                                    category_id CHAR(36) NOT NULL,
+
+                                    PRIMARY KEY (product_id, category_id),
+                                    CONSTRAINT fk_product_categories_product
+                                        FOREIGN KEY (product_id)
+                                            REFERENCES products(id)
+                                            ON DELETE CASCADE,
+                                    CONSTRAINT fk_product_categories_category
+                                        FOREIGN KEY (category_id)
+                                            REFERENCES categories(id)
+                                            ON DELETE CASCADE
+) ENGINE=InnoDB;
+
+
+CREATE INDEX idx_product_categories_category_id ON product_categories(category_id);
+
+
+-- RATE LIMIT BUCKETS
+-- the token buckets shared by the replicas, see my_sql.RateLimitStore; a row is deleted once its bucket is full again
+CREATE TABLE rate_limit_buckets (
+                                    bucket_key VARCHAR(255) NOT NULL PRIMARY KEY,
+                                    tokens DOUBLE NOT NULL,
+                                    updated_at DATETIME(6) NOT NULL,
+                                    full_at DATETIME(6) NOT NULL
+) ENGINE=InnoDB;
+
+
+CREATE INDEX idx_rate_limit_buckets_full_at ON rate_limit_buckets(full_at);
+
+
+-- SCHEMA VERSION
+-- the version of this script, the service is not ready until it matches my_sql.SchemaVersion; bump both with every schema change
+CREATE TABLE schema_version (
+                                version INT NOT NULL PRIMARY KEY,
+                                applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+) ENGINE=InnoDB;
+
+INSERT INTO schema_version (version) VALUES (4);
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrCartNotFound = errors.New("cart not found")
+var ErrCartExpired = errors.New("cart expired")
+var ErrCartCheckedOut = errors.New("cart already checked out")
+var ErrCartChanged = errors.New("cart changed during the checkout, retry")
+var ErrCartEmpty = errors.New("cart is empty")
+var ErrCartItemNotFound = errors.New("cart item not found")
+var ErrCheckoutConflict = errors.New("the stock changed since the items were added to the cart")
+
+type CartStatus string
+
+const (
+	CartOpen       CartStatus = "open"
+	CartCheckedOut CartStatus = "checked_out"
+)
+
+// Cart is kept server side so it follows the customer across devices. It expires ExpiresAt,
+// every change of its items pushes ExpiresAt forward.
+type Cart struct {
+	ID         string     `sql:"id" json:"id"`
+	CustomerID string     `sql:"customer_id" json:"customer_id"`
+	Status     CartStatus `sql:"status" json:"status"`
+	ExpiresAt  time.Time  `sql:"expires_at" json:"expires_at"`
+	CreatedAt  time.Time  `sql:"created_at" json:"created_at"`
+	UpdatedAt  time.Time  `sql:"updated_at" json:"updated_at"`
+	Items      []CartItem `sql:"-" json:"items,omitempty" gorm:"-"`
+}
+
+// ExpiredAt reports whether the cart was inactive for too long at the given time.
+func (c Cart) ExpiredAt(at time.Time) bool {
+	return !at.Before(c.ExpiresAt)
+}
+
+// Item returns the item of the product and variant, nil when the cart does not have it.
+func (c Cart) Item(productID string, variantID string) *CartItem {
+	for i := range c.Items {
+		item := &c.Items[i]
+		if item.ProductID == productID && item.variantID() == variantID {
+			return item
+		}
+	}
+	return nil
+}
+
+// CartItem is a product, or a variant of it, and the quantity to buy. The cart has at most
+// one item per product and variant.
+type CartItem struct {
+	ID        string    `sql:"id" json:"id"`
+	CartID    string    `sql:"cart_id" json:"-"`
+	ProductID string    `sql:"product_id" json:"product_id"`
+	VariantID *string   `sql:"variant_id" json:"variant_id,omitempty"`
+	Quantity  int       `sql:"quantity" json:"quantity"`
+	AddedAt   time.Time `sql:"added_at" json:"added_at"`
+}
+
+func (i CartItem) variantID() string {
+	if i.VariantID == nil {
+		return ""
+	}
+	return *i.VariantID
+}
+
+// CartView is the cart with the current price and availability of its items, the prices are
+// not kept in the cart so the view always shows what the checkout would charge before taxes
+// and promotions. The items are only listed in Lines.
+type CartView struct {
+	Cart
+	Lines    []CartLine `json:"lines"`
+	Subtotal Money      `json:"subtotal"`
+}
+
+// CartLine is an item of a CartView, Conflict is set when the item cannot be bought as is.
+type CartLine struct {
+	CartItem
+	UnitPrice Money         `json:"unit_price"`
+	Total     Money         `json:"total"`
+	Stock     int           `json:"stock"`
+	Conflict  *LineConflict `json:"conflict,omitempty"`
+}
+
+// CheckoutOptions are the order options shared by every line of the cart.
+type CheckoutOptions struct {
+	Currency string
+	Region   string
+}
+
+const (
+	LineProductNotFound   = "product_not_found"
+	LineVariantNotFound   = "variant_not_found"
+	LineVariantRequired   = "variant_required"
+	LineInsufficientStock = "insufficient_stock"
+)
+
+// LineConflict explains why a line cannot be ordered, Available is the stock left.
+type LineConflict struct {
+	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id,omitempty"`
+	Requested int    `json:"requested"`
+	Available int    `json:"available"`
+	Reason    string `json:"reason"`
+}
+
+// CheckoutConflictError reports every line that could not be ordered, no order is placed
+// when there is one. It matches ErrCheckoutConflict with errors.Is.
+type CheckoutConflictError struct {
+	Lines []LineConflict
+}
+
+func (e *CheckoutConflictError) Error() string {
+	return fmt.Sprintf("%s: %d line(s) cannot be ordered", ErrCheckoutConflict, len(e.Lines))
+}
+
+func (e *CheckoutConflictError) Unwrap() error {
+	return ErrCheckoutConflict
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrCustomerNotFound = errors.New("customer not found")
+var ErrCustomerAlreadyExists = errors.New("customer already exists")
+var ErrCustomerHasOrders = errors.New("customer has orders")
+var ErrCustomerRequired = errors.New("order requires a customer")
+
+// Customer is a buyer, its ID is the subject of the tokens issued to it.
+type Customer struct {
+	ID        string    `sql:"id" json:"id"`
+	Email     string    `sql:"email" json:"email"`
+	Name      string    `sql:"name" json:"name"`
+	CreatedAt time.Time `sql:"created_at" json:"created_at"`
+	UpdatedAt time.Time `sql:"updated_at" json:"updated_at"`
+}
+
+type CustomerUpdate struct {
+	Email *string
+	Name  *string
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvoiceNotFound = errors.New("the order has no invoice")
+var ErrInvoiceFormatNotSupported = errors.New("invoice format not supported")
+
+// Invoice is the receipt of a confirmed order. The numbers are allocated in the transaction
+// that confirms the order so they have no gaps, the orders whose payment failed have none.
+type Invoice struct {
+	Number   string
+	IssuedAt time.Time
+	Order    Order
+	Customer *Customer
+}
+
+// FormatInvoiceNumber formats the sequence number of an invoice, e.g. INV-000042.
+func FormatInvoiceNumber(number int64) string {
+	return fmt.Sprintf("INV-%06d", number)
+}
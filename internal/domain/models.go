@@ -1,25 +1,318 @@
 package domain
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"iter"
+	"strings"
 	"time"
 )
 
 var ErrProductNotFound = errors.New("product not found")
+var ErrProductAlreadyExists = errors.New("a product with the same name or external sku already exists")
 var ErrInsufficientStock = errors.New("insufficient stock")
+var ErrStockAlertNotFound = errors.New("stock alert not found")
+var ErrVariantNotFound = errors.New("variant not found")
+var ErrVariantRequired = errors.New("product has variants, a variant must be selected")
+var ErrVariantSKUAlreadyExists = errors.New("variant sku already exists")
+var ErrVariantHasOrders = errors.New("variant has orders")
+var ErrExchangeRateNotFound = errors.New("exchange rate not found")
+var ErrPriceNotFound = errors.New("price not found")
+var ErrBaseCurrencyPrice = errors.New("the base price is set on the product, not as a list price")
+var ErrCategoryNotFound = errors.New("category not found")
+var ErrCategoryParentNotFound = errors.New("parent category not found")
+var ErrCategoryCycle = errors.New("category cannot be moved under itself or one of its descendants")
+var ErrCategoryHasChildren = errors.New("category has child categories")
+var ErrOrderNotFound = errors.New("order not found")
 
 type Product struct {
+	ID              string  `sql:"id" json:"id"`
+	Name            string  `sql:"name" json:"name"`
+	Description     string  `sql:"description" json:"description"`
+	Price           Money   `sql:"price" json:"price"`
+	Stock           int     `sql:"stock" json:"stock"`
+	ReorderPoint    int     `sql:"reorder_point" json:"reorder_point"`
+	ReorderQuantity int     `sql:"reorder_quantity" json:"reorder_quantity"`
+	ExternalSKU     *string `sql:"external_sku" json:"external_sku,omitempty"`
+	// CategoryIDs are the categories the product belongs to, the tax rules can depend on them.
+	CategoryIDs []string `sql:"-" json:"category_ids" gorm:"-"`
+	// Prices are list prices in other currencies, they take precedence over converting Price.
+	Prices []Money `sql:"-" json:"prices" gorm:"-"`
+	// Variants are loaded alongside the product and written through their own repository methods.
+	Variants []Variant `sql:"-" json:"variants" gorm:"-"`
+}
+
+// PriceQuote is a price in the requested currency. ExchangeRate is set when the price was
+// converted from the base price and nil when it is the base or a list price.
+type PriceQuote struct {
+	Price        Money
+	ExchangeRate *float64
+}
+
+// Quoter prices products in Currency with the exchange rate from BaseCurrency resolved
+// beforehand, so quoting makes no call to the rate provider and can run while rows are
+// locked. RateErr is why the rate could not be resolved, it is only returned by the quotes
+// that need a conversion.
+type Quoter struct {
+	Currency string
+	Rate     float64
+	RateErr  error
+}
+
+// Quote prices the product, or its variant when it is not nil. The list price of the product
+// in Currency wins, otherwise the base price is converted with Rate. Variant price overrides
+// are always converted, list prices belong to the product.
+func (q Quoter) Quote(product Product, variant *Variant) (PriceQuote, error) {
+	base := product.Price
+	if variant != nil {
+		base = variant.EffectivePrice(product.Price)
+	}
+
+	currency := strings.ToUpper(q.Currency)
+	if currency == "" || currency == base.Currency {
+		return PriceQuote{Price: base}, nil
+	}
+
+	if variant == nil || variant.Price == nil {
+		for _, price := range product.Prices {
+			if price.Currency == currency {
+				return PriceQuote{Price: price}, nil
+			}
+		}
+	}
+
+	if base.Currency != BaseCurrency {
+		return PriceQuote{}, fmt.Errorf("error converting %s to %s: %w", base.Currency, currency, ErrExchangeRateNotFound)
+	}
+	if q.RateErr != nil {
+		return PriceQuote{}, q.RateErr
+	}
+	rate := q.Rate
+	return PriceQuote{Price: base.Convert(rate, currency), ExchangeRate: &rate}, nil
+}
+
+// AvailableStock is the stock of the product, or the total stock of its variants once it
+// has variants.
+func (p Product) AvailableStock() int {
+	if len(p.Variants) == 0 {
+		return p.Stock
+	}
+	stock := 0
+	for _, variant := range p.Variants {
+		stock += variant.Stock
+	}
+	return stock
+}
+
+// BelowReorderPoint reports whether the available stock reached the reorder point. A zero
+// reorder point disables the low-stock alerts for the product.
+func (p Product) BelowReorderPoint() bool {
+	return p.ReorderPoint > 0 && p.AvailableStock() <= p.ReorderPoint
+}
+
+// Variant is a purchasable option of a product (e.g. size and colour) with its own SKU
+// and stock. A product without variants is sold as a single implicit variant, using the
+// price and stock of the product itself.
+type Variant struct {
+	ID        string         `sql:"id" json:"id"`
+	ProductID string         `sql:"product_id" json:"product_id"`
+	SKU       string         `sql:"sku" json:"sku"`
+	Options   VariantOptions `sql:"options" json:"options"`
+	Price     *Money         `sql:"price" json:"price,omitempty"`
+	Stock     int            `sql:"stock" json:"stock"`
+}
+
+// EffectivePrice returns the price override of the variant or, when it is not set, the product price.
+func (v Variant) EffectivePrice(productPrice Money) Money {
+	if v.Price != nil {
+		return *v.Price
+	}
+	return productPrice
+}
+
+// VariantUpdate holds the fields to change, nil fields are kept.
+type VariantUpdate struct {
+	SKU     *string
+	Options VariantOptions
+	Price   *Money
+	Stock   *int
+}
+
+// VariantOptions are the option values of a variant, e.g. {"size": "M", "colour": "blue"},
+// stored as a JSON column.
+type VariantOptions map[string]string
+
+func (o VariantOptions) Value() (driver.Value, error) {
+	if o == nil {
+		return "{}", nil
+	}
+	bytes, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+func (o *VariantOptions) Scan(src any) error {
+	var bytes []byte
+	switch value := src.(type) {
+	case nil:
+		*o = VariantOptions{}
+		return nil
+	case []byte:
+		bytes = value
+	case string:
+		bytes = []byte(value)
+	default:
+		return fmt.Errorf("cannot scan %T into variant options", src)
+	}
+	return json.Unmarshal(bytes, o)
+}
+
+// Order records the currency and the exchange rate used at purchase time, so the total
+// does not change when the rates do. Subtotal is the amount after discounts and before
+// tax, Total is the grand total charged (Subtotal + Tax). PaymentID is the reference of the
+// captured payment of a confirmed order. ProductName, VariantSKU and UnitPrice are copied at
+// purchase time so the invoice does not change with the catalog.
+type Order struct {
+	ID            string      `sql:"id" json:"id"`
+	InvoiceNumber *int64      `sql:"invoice_number" json:"invoice_number,omitempty"`
+	ProductID     string      `sql:"product_id" json:"product_id"`
+	ProductName   string      `sql:"product_name" json:"product_name,omitempty"`
+	VariantID     *string     `sql:"variant_id" json:"variant_id,omitempty"`
+	VariantSKU    *string     `sql:"variant_sku" json:"variant_sku,omitempty"`
+	CustomerID    *string     `sql:"customer_id" json:"customer_id,omitempty"`
+	Quantity      int         `sql:"quantity" json:"quantity"`
+	UnitPrice     Money       `sql:"unit_price" json:"unit_price"`
+	Subtotal      Money       `sql:"subtotal" json:"subtotal"`
+	Tax           Money       `sql:"tax" json:"tax"`
+	Total         Money       `sql:"total" json:"total"`
+	Currency      string      `sql:"currency" json:"currency"`
+	TaxRegion     string      `sql:"tax_region" json:"tax_region"`
+	ExchangeRate  *float64    `sql:"exchange_rate" json:"exchange_rate,omitempty"`
+	Status        OrderStatus `sql:"status" json:"status"`
+	PaymentID     *string     `sql:"payment_id" json:"payment_id,omitempty"`
+	Date          time.Time   `sql:"created_at" json:"created_at"`
+	// Discounts is the breakdown of the promotions applied, Total is already net of them.
+	Discounts []OrderDiscount `sql:"-" json:"discounts" gorm:"-"`
+	// Refunds are only loaded with the order alone, NetPaid is Total minus Refunded.
+	Refunds  []Refund `sql:"-" json:"refunds,omitempty" gorm:"-"`
+	Refunded *Money   `sql:"-" json:"refunded,omitempty" gorm:"-"`
+	NetPaid  *Money   `sql:"-" json:"net_paid,omitempty" gorm:"-"`
+}
+
+// OrderRequest is a purchase of Quantity units of a product, VariantID is empty for
+// products without variants, Currency empty for the base currency, CouponCode empty
+// when no coupon is redeemed and Region empty for the default tax region. CustomerID is
+// the buyer and is required.
+type OrderRequest struct {
+	CustomerID string
+	ProductID  string
+	VariantID  string
+	Quantity   int
+	Currency   string
+	CouponCode string
+	Region     string
+}
+
+type Category struct {
 	ID          string  `sql:"id" json:"id"`
 	Name        string  `sql:"name" json:"name"`
 	Description string  `sql:"description" json:"description"`
-	Price       float64 `sql:"price" json:"price"`
-	Stock       int     `sql:"stock" json:"stock"`
+	ParentID    *string `sql:"parent_id" json:"parent_id"`
 }
 
-type Order struct {
-	ID        string    `sql:"id" json:"id"`
-	ProductID string    `sql:"product_id" json:"product_id"`
-	Quantity  int       `sql:"quantity" json:"quantity"`
-	Total     float64   `sql:"total" json:"total"`
-	Date      time.Time `sql:"created_at" json:"created_at"`
+// CategoryNode is a category with its subcategories, used to render the navigation tree.
+type CategoryNode struct {
+	Category
+	Children []CategoryNode `json:"children"`
+}
+
+// CategoryUpdate holds the fields to change, nil fields are kept. An empty ParentID
+// moves the category to the root of the tree.
+type CategoryUpdate struct {
+	Name        *string
+	Description *string
+	ParentID    *string
+}
+
+// ProductFilter narrows the products of an export, zero values do not filter.
+type ProductFilter struct {
+	Limit int
+}
+
+// OrderFilter narrows the orders of a listing or an export, zero values do not filter.
+// From is inclusive and To exclusive.
+type OrderFilter struct {
+	CustomerID string
+	ProductID  string
+	Status     OrderStatus
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+}
+
+// StockAlert is raised once when a product reaches its reorder point and stays
+// open until the stock is replenished above it.
+type StockAlert struct {
+	ID              string     `sql:"id" json:"id"`
+	ProductID       string     `sql:"product_id" json:"product_id"`
+	Stock           int        `sql:"stock" json:"stock"`
+	ReorderPoint    int        `sql:"reorder_point" json:"reorder_point"`
+	ReorderQuantity int        `sql:"reorder_quantity" json:"reorder_quantity"`
+	CreatedAt       time.Time  `sql:"created_at" json:"created_at"`
+	ResolvedAt      *time.Time `sql:"resolved_at" json:"resolved_at,omitempty"`
+}
+
+const (
+	ImportRowCreated = "created"
+	ImportRowUpdated = "updated"
+	ImportRowFailed  = "failed"
+)
+
+// ProductImportRow is a parsed row of a bulk import, Error is set when the row could
+// not be parsed or validated and must be reported as failed without touching the storage.
+type ProductImportRow struct {
+	Line    int
+	Product Product
+	Error   string
+}
+
+// ProductImportRows yields the rows as they are parsed from the request body.
+type ProductImportRows = iter.Seq[ProductImportRow]
+
+type ProductImportOptions struct {
+	DryRun    bool
+	BatchSize int
+}
+
+type ProductImportRowResult struct {
+	Line        int     `json:"line"`
+	Status      string  `json:"status"`
+	ProductID   string  `json:"product_id,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	ExternalSKU *string `json:"external_sku,omitempty"`
+	Reason      string  `json:"reason,omitempty"`
+}
+
+type ProductImportReport struct {
+	DryRun  bool                     `json:"dry_run"`
+	Created int                      `json:"created"`
+	Updated int                      `json:"updated"`
+	Failed  int                      `json:"failed"`
+	Rows    []ProductImportRowResult `json:"rows"`
+}
+
+func (r *ProductImportReport) Add(result ProductImportRowResult) {
+	switch result.Status {
+	case ImportRowCreated:
+		r.Created++
+	case ImportRowUpdated:
+		r.Updated++
+	case ImportRowFailed:
+		r.Failed++
+	}
+	r.Rows = append(r.Rows, result)
 }
@@ -0,0 +1,318 @@
+package domain
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// BaseCurrency is the currency of the amounts stored without an explicit currency.
+const BaseCurrency = "USD"
+
+// minorUnitDigits is the number of decimals kept by Money, every supported currency
+// (ARS, USD, EUR) uses cents.
+const minorUnitDigits = 2
+
+const minorUnitsPerUnit = 100
+
+var ErrInvalidMoney = errors.New("invalid money amount")
+var ErrInvalidCurrency = errors.New("invalid currency, must be a three letter ISO 4217 code")
+
+// Money is an amount in integer minor units (cents) of a currency, so sums and
+// multiplications by quantities are exact.
+//
+// Rounding rules: amounts with more decimals than the currency minor unit are
+// rounded half away from zero when they are parsed (12.345 -> 12.35, -12.345 -> -12.35),
+// which is also what MySQL does when it stores a value in a DECIMAL(10,2) column.
+// Operations between Money values never round.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// NewMoney builds an amount from minor units, an empty currency means BaseCurrency.
+func NewMoney(minorUnits int64, currency string) Money {
+	return Money{Amount: minorUnits, Currency: normalizeCurrency(currency)}
+}
+
+// ParseMoney parses a decimal amount such as "12.21", rounding it to the minor unit.
+func ParseMoney(value string, currency string) (Money, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return Money{}, fmt.Errorf("%w: empty amount", ErrInvalidMoney)
+	}
+
+	negative := false
+	switch value[0] {
+	case '-':
+		negative = true
+		value = value[1:]
+	case '+':
+		value = value[1:]
+	}
+
+	integerPart, fractionPart, _ := strings.Cut(value, ".")
+	if integerPart == "" {
+		integerPart = "0"
+	}
+	if !isDigits(integerPart) || !isDigits(fractionPart) || (fractionPart == "" && strings.HasSuffix(value, ".")) {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidMoney, value)
+	}
+
+	units, err := strconv.ParseInt(integerPart, 10, 64)
+	if err != nil || units > math.MaxInt64/minorUnitsPerUnit-1 {
+		return Money{}, fmt.Errorf("%w: %q is out of range", ErrInvalidMoney, value)
+	}
+
+	// keep the minor unit digits and round half away from zero with the next one
+	fractionPart += strings.Repeat("0", minorUnitDigits+1)
+	cents, _ := strconv.ParseInt(fractionPart[:minorUnitDigits], 10, 64)
+	amount := units*minorUnitsPerUnit + cents
+	if fractionPart[minorUnitDigits] >= '5' {
+		amount++
+	}
+
+	if negative {
+		amount = -amount
+	}
+	return NewMoney(amount, currency), nil
+}
+
+// MoneyFromFloat converts the legacy float amounts using their shortest decimal
+// representation, so 29.99 is read as 2999 cents and not as 29.989999.
+func MoneyFromFloat(value float64, currency string) (Money, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Money{}, fmt.Errorf("%w: %v", ErrInvalidMoney, value)
+	}
+	return ParseMoney(strconv.FormatFloat(value, 'f', -1, 64), currency)
+}
+
+// Convert changes the amount to another currency, rate is the units of currency per
+// unit of m.Currency. The result is rounded half away from zero to the minor unit.
+func (m Money) Convert(rate float64, currency string) Money {
+	return NewMoney(int64(math.Round(float64(m.Amount)*rate)), currency)
+}
+
+// Mul multiplies the amount by a quantity, it is exact. It panics when the result does not
+// fit in an int64.
+func (m Money) Mul(quantity int) Money {
+	product, ok := mulInt64(m.Amount, int64(quantity))
+	if !ok {
+		panic(fmt.Sprintf("money: %s times %d overflows", m, quantity))
+	}
+	return NewMoney(product, m.Currency)
+}
+
+// Percent returns percent percent of the amount, rounded half away from zero to the minor unit.
+func (m Money) Percent(percent int) Money {
+	product, ok := mulInt64(m.Amount, int64(percent))
+	if !ok {
+		panic(fmt.Sprintf("money: %d%% of %s overflows", percent, m))
+	}
+	half := int64(50)
+	if product < 0 {
+		half = -half
+	}
+	return NewMoney((product+half)/100, m.Currency)
+}
+
+// Add sums two amounts of the same currency. It panics when the currencies differ or the
+// sum does not fit in an int64, rather than returning a wrong amount.
+func (m Money) Add(other Money) Money {
+	m.mustMatch(other, "add")
+	sum := m.Amount + other.Amount
+	if (other.Amount > 0 && sum < m.Amount) || (other.Amount < 0 && sum > m.Amount) {
+		panic(fmt.Sprintf("money: %s plus %s overflows", m, other))
+	}
+	return NewMoney(sum, m.Currency)
+}
+
+// Sub subtracts two amounts of the same currency, it panics as Add does.
+func (m Money) Sub(other Money) Money {
+	m.mustMatch(other, "subtract")
+	difference := m.Amount - other.Amount
+	if (other.Amount > 0 && difference > m.Amount) || (other.Amount < 0 && difference < m.Amount) {
+		panic(fmt.Sprintf("money: %s minus %s overflows", m, other))
+	}
+	return NewMoney(difference, m.Currency)
+}
+
+// mustMatch panics when other is in another currency, an empty currency is BaseCurrency.
+func (m Money) mustMatch(other Money, operation string) {
+	if normalizeCurrency(m.Currency) != normalizeCurrency(other.Currency) {
+		panic(fmt.Sprintf("money: cannot %s %s and %s", operation, normalizeCurrency(m.Currency), normalizeCurrency(other.Currency)))
+	}
+}
+
+// mulInt64 multiplies a and b, ok is false when the product overflows.
+func mulInt64(a int64, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return product, true
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Decimal formats the amount with the minor unit digits, e.g. "12.20".
+func (m Money) Decimal() string {
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/minorUnitsPerUnit, minorUnitDigits, amount%minorUnitsPerUnit)
+}
+
+func (m Money) String() string {
+	return m.Decimal() + " " + normalizeCurrency(m.Currency)
+}
+
+type moneyJSON struct {
+	Amount   json.RawMessage `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+// MarshalJSON writes {"amount": "12.21", "currency": "USD"}, the amount is a string so
+// clients never parse it as a binary float.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{Amount: m.Decimal(), Currency: normalizeCurrency(m.Currency)})
+}
+
+// UnmarshalJSON accepts the object written by MarshalJSON, a decimal string, and the
+// deprecated bare JSON numbers sent by the float-based clients.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if data[0] == '{' {
+		var object moneyJSON
+		if err := json.Unmarshal(data, &object); err != nil {
+			return err
+		}
+		if len(object.Amount) == 0 {
+			return fmt.Errorf("%w: amount is required", ErrInvalidMoney)
+		}
+		parsed, err := parseJSONAmount(object.Amount, object.Currency)
+		if err != nil {
+			return err
+		}
+		*m = parsed
+		return nil
+	}
+
+	parsed, err := parseJSONAmount(data, BaseCurrency)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// parseJSONAmount reads a JSON string or number, the number literal is parsed as a decimal
+// without going through float64.
+func parseJSONAmount(data json.RawMessage, currency string) (Money, error) {
+	if len(data) > 0 && data[0] == '"' {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return Money{}, err
+		}
+		return ParseMoney(value, currency)
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return Money{}, fmt.Errorf("%w: %s", ErrInvalidMoney, data)
+	}
+	if strings.ContainsAny(number.String(), "eE") {
+		value, err := number.Float64()
+		if err != nil {
+			return Money{}, fmt.Errorf("%w: %s", ErrInvalidMoney, data)
+		}
+		return MoneyFromFloat(value, currency)
+	}
+	return ParseMoney(number.String(), currency)
+}
+
+// Value stores the amount in a DECIMAL column, the currency lives in its own column.
+func (m Money) Value() (driver.Value, error) {
+	return m.Decimal(), nil
+}
+
+// Scan reads a DECIMAL column, the amount is in BaseCurrency until a currency column sets it.
+func (m *Money) Scan(src any) error {
+	var parsed Money
+	var err error
+
+	switch value := src.(type) {
+	case nil:
+		*m = Money{}
+		return nil
+	case []byte:
+		parsed, err = ParseMoney(string(value), m.Currency)
+	case string:
+		parsed, err = ParseMoney(value, m.Currency)
+	case int64:
+		parsed = NewMoney(value*minorUnitsPerUnit, m.Currency)
+	case float64:
+		parsed, err = MoneyFromFloat(value, m.Currency)
+	default:
+		return fmt.Errorf("cannot scan %T into money", src)
+	}
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// ParseCurrency validates a three letter currency code and returns it in upper case.
+func ParseCurrency(value string) (string, error) {
+	if len(value) != 3 {
+		return "", ErrInvalidCurrency
+	}
+	for _, r := range value {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return "", ErrInvalidCurrency
+		}
+	}
+	return strings.ToUpper(value), nil
+}
+
+func normalizeCurrency(currency string) string {
+	if currency == "" {
+		return BaseCurrency
+	}
+	return strings.ToUpper(currency)
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
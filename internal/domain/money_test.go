@@ -0,0 +1,169 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"math"
+	"microservice-products-catalog/internal/domain"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	type testCase struct {
+		testName      string
+		input         string
+		expected      int64
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{testName: "Success - Integer amount", input: "12", expected: 1200},
+		{testName: "Success - Two decimals", input: "29.99", expected: 2999},
+		{testName: "Success - One decimal", input: "21.9", expected: 2190},
+		{testName: "Success - Leading dot", input: ".5", expected: 50},
+		{testName: "Success - Rounds half away from zero", input: "12.345", expected: 1235},
+		{testName: "Success - Rounds down below the half", input: "12.3449", expected: 1234},
+		{testName: "Success - Rounds the carry into the units", input: "0.999", expected: 100},
+		{testName: "Success - Negative rounds away from zero", input: "-12.345", expected: -1235},
+		{testName: "Failure - Not a number", input: "12,21", expectedError: domain.ErrInvalidMoney},
+		{testName: "Failure - Trailing dot", input: "12.", expectedError: domain.ErrInvalidMoney},
+		{testName: "Failure - Empty", input: "", expectedError: domain.ErrInvalidMoney},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			money, err := domain.ParseMoney(tc.input, "usd")
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, domain.NewMoney(tc.expected, "USD"), money)
+		})
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	price := domain.NewMoney(1000, "USD").Add(domain.NewMoney(-1, "USD")) // 9.99
+
+	assert.Equal(t, "29.97", price.Mul(3).Decimal(), "multiplying by a quantity must not drift like 9.99 * 3 in float64")
+	assert.Equal(t, "-0.05", domain.NewMoney(-5, "USD").Decimal())
+	assert.Equal(t, "9.99 USD", price.String())
+	assert.Equal(t, domain.NewMoney(0, "USD"), price.Sub(price))
+	assert.Equal(t, domain.NewMoney(450, "USD"), domain.NewMoney(2997, "USD").Percent(15), "15% of 29.97 is 4.4955")
+	assert.Equal(t, domain.NewMoney(-450, "USD"), domain.NewMoney(-2997, "USD").Percent(15))
+	assert.Equal(t, domain.NewMoney(150, "USD"), domain.NewMoney(100, "").Add(domain.NewMoney(50, "usd")), "an empty currency is the base currency")
+}
+
+func TestMoneyArithmetic_Panics(t *testing.T) {
+	maxMoney := domain.NewMoney(math.MaxInt64, "USD")
+	minMoney := domain.NewMoney(math.MinInt64, "USD")
+
+	testCases := []struct {
+		name      string
+		operation func()
+	}{
+		{name: "add another currency", operation: func() { domain.NewMoney(100, "USD").Add(domain.NewMoney(100, "EUR")) }},
+		{name: "subtract another currency", operation: func() { domain.NewMoney(100, "USD").Sub(domain.NewMoney(100, "EUR")) }},
+		{name: "add overflow", operation: func() { maxMoney.Add(domain.NewMoney(1, "USD")) }},
+		{name: "subtract overflow", operation: func() { minMoney.Sub(domain.NewMoney(1, "USD")) }},
+		{name: "multiply overflow", operation: func() { maxMoney.Mul(2) }},
+		{name: "multiply min by -1", operation: func() { minMoney.Mul(-1) }},
+		{name: "percent overflow", operation: func() { maxMoney.Percent(15) }},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Panics(t, tc.operation)
+		})
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	type testCase struct {
+		testName      string
+		input         string
+		expected      domain.Money
+		expectedError bool
+	}
+
+	testCases := []testCase{
+		{testName: "Success - Object", input: `{"amount":"12.21","currency":"EUR"}`, expected: domain.NewMoney(1221, "EUR")},
+		{testName: "Success - Object with numeric amount", input: `{"amount":12.21,"currency":"ARS"}`, expected: domain.NewMoney(1221, "ARS")},
+		{testName: "Success - Decimal string", input: `"12.21"`, expected: domain.NewMoney(1221, "USD")},
+		{testName: "Success - Legacy float", input: `29.99`, expected: domain.NewMoney(2999, "USD")},
+		{testName: "Success - Legacy float in exponent notation", input: `1.5e1`, expected: domain.NewMoney(1500, "USD")},
+		{testName: "Failure - Object without amount", input: `{"currency":"USD"}`, expectedError: true},
+		{testName: "Failure - Boolean", input: `true`, expectedError: true},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			var money domain.Money
+			err := json.Unmarshal([]byte(tc.input), &money)
+
+			// Assert
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, money)
+		})
+	}
+
+	t.Run("Success - Marshal as object with a string amount", func(t *testing.T) {
+		t.Parallel()
+
+		bytes, err := json.Marshal(domain.NewMoney(1220, "EUR"))
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"amount":"12.20","currency":"EUR"}`, string(bytes))
+	})
+}
+
+func TestMoneySQL(t *testing.T) {
+	value, err := domain.NewMoney(2999, "USD").Value()
+	require.NoError(t, err)
+	assert.Equal(t, "29.99", value)
+
+	var scanned domain.Money
+	require.NoError(t, scanned.Scan([]byte("29.99")))
+	assert.Equal(t, domain.NewMoney(2999, domain.BaseCurrency), scanned)
+
+	var empty domain.Money
+	require.NoError(t, empty.Scan(nil))
+	assert.Equal(t, domain.Money{}, empty)
+}
+
+func TestParseCurrency(t *testing.T) {
+	currency, err := domain.ParseCurrency("eur")
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", currency)
+
+	for _, input := range []string{"", "EU", "EURO", "E1R"} {
+		_, err := domain.ParseCurrency(input)
+		assert.ErrorIs(t, err, domain.ErrInvalidCurrency, input)
+	}
+}
+
+func TestMoneyConvert(t *testing.T) {
+	// 12.21 USD * 0.92 = 11.2332 EUR, rounded to the cent
+	assert.Equal(t, domain.NewMoney(1123, "EUR"), domain.NewMoney(1221, "USD").Convert(0.92, "EUR"))
+	// 0.05 USD * 0.9 = 0.045 EUR, the half rounds away from zero
+	assert.Equal(t, domain.NewMoney(5, "EUR"), domain.NewMoney(5, "USD").Convert(0.9, "EUR"))
+}
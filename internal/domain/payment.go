@@ -0,0 +1,38 @@
+package domain
+
+import "errors"
+
+var ErrPaymentDeclined = errors.New("payment declined")
+var ErrPaymentTimeout = errors.New("payment gateway timed out")
+var ErrPaymentFailed = errors.New("payment could not be completed")
+var ErrPaymentNotFound = errors.New("payment not found")
+var ErrInvalidPaymentState = errors.New("payment operation not allowed in its current state")
+var ErrOrderNotPending = errors.New("order is no longer pending")
+var ErrCompensationFailed = errors.New("order could not be compensated")
+
+type OrderStatus string
+
+const (
+	// OrderPending holds the stock while the payment is authorised. An order pending for longer
+	// than the payment timeout is compensated by the reaper, see order.Service.ReapPendingOrders.
+	OrderPending OrderStatus = "pending"
+	// OrderConfirmed is paid, the payment was authorised and captured.
+	OrderConfirmed OrderStatus = "confirmed"
+	// OrderPaymentFailed was compensated: its stock and coupon redemptions were released.
+	OrderPaymentFailed OrderStatus = "payment_failed"
+)
+
+// PaymentRequest charges Amount for an order, gateways must use OrderID as idempotency key so
+// a retried authorisation never charges twice.
+type PaymentRequest struct {
+	OrderID    string
+	CustomerID string
+	Amount     Money
+}
+
+// PaymentAuthorization is the amount reserved on the customer's payment method, ID is the
+// reference of the gateway used to capture, void or refund it.
+type PaymentAuthorization struct {
+	ID     string
+	Amount Money
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidScheduledPrice = errors.New("invalid scheduled price")
+var ErrScheduledPriceConflict = errors.New("scheduled price overlaps another scheduled price")
+var ErrScheduledPriceNotFound = errors.New("scheduled price not found")
+
+type PriceChangeSource string
+
+const (
+	PriceChangeManual PriceChangeSource = "manual"
+	PriceChangeImport PriceChangeSource = "import"
+	// PriceChangeScheduled is a scheduled price reaching its effective_at.
+	PriceChangeScheduled PriceChangeSource = "scheduled"
+	// PriceChangeScheduleEnded is the previous price restored when a scheduled price reaches its ends_at.
+	PriceChangeScheduleEnded PriceChangeSource = "schedule_ended"
+)
+
+// PriceChange is an entry of the price history of a product, the prices are in the base currency.
+type PriceChange struct {
+	ProductID        string            `sql:"product_id" json:"product_id"`
+	OldPrice         Money             `sql:"old_price" json:"old_price"`
+	NewPrice         Money             `sql:"new_price" json:"new_price"`
+	Source           PriceChangeSource `sql:"source" json:"source"`
+	ScheduledPriceID *string           `sql:"scheduled_price_id" json:"scheduled_price_id,omitempty"`
+	ChangedAt        time.Time         `sql:"changed_at" json:"changed_at"`
+}
+
+type ScheduledPriceStatus string
+
+const (
+	// ScheduledPricePending is waiting for its effective_at.
+	ScheduledPricePending ScheduledPriceStatus = "pending"
+	// ScheduledPriceActive is applied and waiting for its ends_at to restore the previous price.
+	ScheduledPriceActive ScheduledPriceStatus = "active"
+	// ScheduledPriceCompleted is applied for good, ended, or skipped because its window passed
+	// while no scheduler was running.
+	ScheduledPriceCompleted ScheduledPriceStatus = "completed"
+)
+
+// ScheduledPrice changes the base price of a product at EffectiveAt. With EndsAt the change is a
+// window, the price the product had when the schedule was applied (PreviousPrice) comes back at
+// EndsAt. Without it the change is permanent.
+type ScheduledPrice struct {
+	ID            string               `sql:"id" json:"id"`
+	ProductID     string               `sql:"product_id" json:"product_id"`
+	Price         Money                `sql:"price" json:"price"`
+	EffectiveAt   time.Time            `sql:"effective_at" json:"effective_at"`
+	EndsAt        *time.Time           `sql:"ends_at" json:"ends_at,omitempty"`
+	Status        ScheduledPriceStatus `sql:"status" json:"status"`
+	PreviousPrice *Money               `sql:"previous_price" json:"previous_price,omitempty"`
+	AppliedAt     *time.Time           `sql:"applied_at" json:"applied_at,omitempty"`
+	EndedAt       *time.Time           `sql:"ended_at" json:"ended_at,omitempty"`
+	CreatedAt     time.Time            `sql:"created_at" json:"created_at"`
+}
+
+// Validate checks a new scheduled price, a schedule may start in the past (it is applied by the
+// next run of the scheduler) but its window cannot be over already.
+func (s ScheduledPrice) Validate(now time.Time) error {
+	if s.Price.Amount < 10 {
+		return fmt.Errorf("%w: price must be at least 0.10", ErrInvalidScheduledPrice)
+	}
+	if s.EffectiveAt.IsZero() {
+		return fmt.Errorf("%w: effective_at is required", ErrInvalidScheduledPrice)
+	}
+	if s.EndsAt != nil {
+		if !s.EndsAt.After(s.EffectiveAt) {
+			return fmt.Errorf("%w: ends_at must be after effective_at", ErrInvalidScheduledPrice)
+		}
+		if !s.EndsAt.After(now) {
+			return fmt.Errorf("%w: ends_at is in the past", ErrInvalidScheduledPrice)
+		}
+	}
+	return nil
+}
+
+// Overlaps reports whether two schedules of a product would be in effect at the same time. A
+// window covers [EffectiveAt, EndsAt), a permanent change only its EffectiveAt instant, so a
+// permanent change can be followed by a window but never fall inside one.
+func (s ScheduledPrice) Overlaps(other ScheduledPrice) bool {
+	switch {
+	case s.EndsAt != nil && other.EndsAt != nil:
+		return s.EffectiveAt.Before(*other.EndsAt) && other.EffectiveAt.Before(*s.EndsAt)
+	case s.EndsAt != nil:
+		return s.covers(other.EffectiveAt)
+	case other.EndsAt != nil:
+		return other.covers(s.EffectiveAt)
+	default:
+		return s.EffectiveAt.Equal(other.EffectiveAt)
+	}
+}
+
+func (s ScheduledPrice) covers(at time.Time) bool {
+	return !at.Before(s.EffectiveAt) && at.Before(*s.EndsAt)
+}
+
+// DueAt reports whether the scheduler has something to do with the schedule at the given time.
+func (s ScheduledPrice) DueAt(at time.Time) bool {
+	switch s.Status {
+	case ScheduledPricePending:
+		return !s.EffectiveAt.After(at)
+	case ScheduledPriceActive:
+		return s.EndsAt != nil && !s.EndsAt.After(at)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrPromotionNotFound = errors.New("promotion not found")
+var ErrInvalidPromotion = errors.New("invalid promotion")
+var ErrCouponNotFound = errors.New("coupon not found")
+var ErrCouponAlreadyExists = errors.New("coupon code already exists")
+var ErrCouponNotActive = errors.New("coupon is not valid at this time")
+var ErrCouponUsageLimitReached = errors.New("coupon usage limit reached")
+var ErrCouponNotApplicable = errors.New("coupon does not apply to this order")
+
+type PromotionType string
+
+const (
+	// PromotionPercentage takes Percentage percent off the line.
+	PromotionPercentage PromotionType = "percentage"
+	// PromotionFixedAmount takes Amount off the line, only for orders in the currency of Amount.
+	PromotionFixedAmount PromotionType = "fixed_amount"
+	// PromotionBuyXGetY gives GetQuantity units for free for every BuyQuantity units bought.
+	PromotionBuyXGetY PromotionType = "buy_x_get_y"
+)
+
+// Promotion is a discount applied by the order service. Promotions without a CouponCode
+// apply automatically to every matching order, the others only when the order sends the
+// code. ProductID restricts the promotion to one product, UsageLimit caps the redemptions
+// of a coupon and StartsAt/EndsAt bound its validity window.
+type Promotion struct {
+	ID              string        `sql:"id" json:"id"`
+	Name            string        `sql:"name" json:"name"`
+	Type            PromotionType `sql:"type" json:"type"`
+	Percentage      int           `sql:"percentage" json:"percentage,omitempty"`
+	Amount          *Money        `sql:"amount" json:"amount,omitempty"`
+	BuyQuantity     int           `sql:"buy_quantity" json:"buy_quantity,omitempty"`
+	GetQuantity     int           `sql:"get_quantity" json:"get_quantity,omitempty"`
+	ProductID       *string       `sql:"product_id" json:"product_id,omitempty"`
+	CouponCode      *string       `sql:"coupon_code" json:"coupon_code,omitempty"`
+	UsageLimit      *int          `sql:"usage_limit" json:"usage_limit,omitempty"`
+	RedemptionCount int           `sql:"redemption_count" json:"redemption_count"`
+	StartsAt        *time.Time    `sql:"starts_at" json:"starts_at,omitempty"`
+	EndsAt          *time.Time    `sql:"ends_at" json:"ends_at,omitempty"`
+	Active          bool          `sql:"active" json:"active"`
+}
+
+type PromotionUpdate struct {
+	Name        *string
+	Percentage  *int
+	Amount      *Money
+	BuyQuantity *int
+	GetQuantity *int
+	UsageLimit  *int
+	StartsAt    *time.Time
+	EndsAt      *time.Time
+	Active      *bool
+}
+
+// Validate checks the fields required by the promotion type.
+func (p Promotion) Validate() error {
+	switch p.Type {
+	case PromotionPercentage:
+		if p.Percentage < 1 || p.Percentage > 100 {
+			return fmt.Errorf("%w: percentage must be between 1 and 100", ErrInvalidPromotion)
+		}
+	case PromotionFixedAmount:
+		if p.Amount == nil || p.Amount.Amount <= 0 {
+			return fmt.Errorf("%w: amount must be positive", ErrInvalidPromotion)
+		}
+	case PromotionBuyXGetY:
+		if p.BuyQuantity < 1 || p.GetQuantity < 1 {
+			return fmt.Errorf("%w: buy_quantity and get_quantity must be positive", ErrInvalidPromotion)
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidPromotion, p.Type)
+	}
+
+	if p.StartsAt != nil && p.EndsAt != nil && !p.EndsAt.After(*p.StartsAt) {
+		return fmt.Errorf("%w: ends_at must be after starts_at", ErrInvalidPromotion)
+	}
+	if p.UsageLimit != nil && p.CouponCode == nil {
+		return fmt.Errorf("%w: usage_limit requires a coupon_code", ErrInvalidPromotion)
+	}
+	return nil
+}
+
+// ActiveAt reports whether the promotion is enabled and inside its validity window.
+func (p Promotion) ActiveAt(at time.Time) bool {
+	if !p.Active {
+		return false
+	}
+	if p.StartsAt != nil && at.Before(*p.StartsAt) {
+		return false
+	}
+	return p.EndsAt == nil || at.Before(*p.EndsAt)
+}
+
+// Discount computes the discount of the promotion on quantity units at unitPrice, it is
+// never larger than the line and zero when the promotion does not apply.
+func (p Promotion) Discount(unitPrice Money, quantity int) Money {
+	line := unitPrice.Mul(quantity)
+	discount := NewMoney(0, unitPrice.Currency)
+
+	switch p.Type {
+	case PromotionPercentage:
+		discount = line.Percent(p.Percentage)
+	case PromotionFixedAmount:
+		if p.Amount != nil && p.Amount.Currency == line.Currency {
+			discount = NewMoney(p.Amount.Amount, line.Currency)
+		}
+	case PromotionBuyXGetY:
+		if p.BuyQuantity > 0 && p.GetQuantity > 0 {
+			free := quantity / (p.BuyQuantity + p.GetQuantity) * p.GetQuantity
+			discount = unitPrice.Mul(free)
+		}
+	}
+
+	if discount.Amount > line.Amount {
+		return line
+	}
+	return discount
+}
+
+// OrderDiscount is a line of the discount breakdown of an order. PromotionID is nil once
+// the promotion is deleted, Name keeps what the customer saw.
+type OrderDiscount struct {
+	OrderID     string  `sql:"order_id" json:"-"`
+	PromotionID *string `sql:"promotion_id" json:"promotion_id,omitempty"`
+	Name        string  `sql:"name" json:"name"`
+	CouponCode  *string `sql:"coupon_code" json:"coupon_code,omitempty"`
+	Amount      Money   `sql:"amount" json:"amount"`
+}
+
+// OrderLine is what the promotions are evaluated against, UnitPrice is already in the
+// currency of the order.
+type OrderLine struct {
+	ProductID  string
+	VariantID  string
+	Quantity   int
+	UnitPrice  Money
+	CouponCode string
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrOrderNotRefundable = errors.New("only confirmed orders can be refunded")
+var ErrRefundQuantityExceeded = errors.New("refund quantity exceeds the quantity not refunded yet")
+var ErrRefundNotPending = errors.New("refund is no longer pending")
+
+type RefundStatus string
+
+const (
+	// RefundPending is recorded before the payment is refunded, it holds its units so a
+	// concurrent refund cannot take them.
+	RefundPending RefundStatus = "pending"
+	// RefundCompleted was refunded by the gateway.
+	RefundCompleted RefundStatus = "completed"
+	// RefundFailed was refused by the gateway, its units can be refunded again.
+	RefundFailed RefundStatus = "failed"
+)
+
+// Refund gives back Quantity units of an order, Amount is their share of the order total.
+// Restock tells whether the returned units are put back in stock once the refund completes.
+type Refund struct {
+	ID        string       `json:"id"`
+	OrderID   string       `json:"order_id"`
+	Quantity  int          `json:"quantity"`
+	Amount    Money        `json:"amount"`
+	Reason    string       `json:"reason"`
+	Restock   bool         `json:"restock"`
+	Status    RefundStatus `json:"status"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// RefundRequest refunds Quantity units of an order, Restock puts them back in stock.
+type RefundRequest struct {
+	OrderID  string
+	Quantity int
+	Reason   string
+	Restock  bool
+}
+
+// ApplyRefunds sets the refunds of the order, what was refunded and what is left paid. The
+// pending refunds count as refunded, the failed ones do not.
+func (o *Order) ApplyRefunds(refunds []Refund) {
+	refunded := NewMoney(0, o.Total.Currency)
+	for _, refund := range refunds {
+		if refund.Status == RefundFailed {
+			continue
+		}
+		refunded = refunded.Add(refund.Amount)
+	}
+	netPaid := o.Total.Sub(refunded)
+
+	o.Refunds = refunds
+	o.Refunded = &refunded
+	o.NetPaid = &netPaid
+}
+
+// RefundedQuantity is the number of units already refunded or being refunded.
+func (o Order) RefundedQuantity() int {
+	quantity := 0
+	for _, refund := range o.Refunds {
+		if refund.Status == RefundFailed {
+			continue
+		}
+		quantity += refund.Quantity
+	}
+	return quantity
+}
+
+// RefundAmount is the share of the order total of quantity units, rounded to the minor unit.
+// The last units refunded take what is left paid, so the refunds add up to the total.
+func (o Order) RefundAmount(quantity int) Money {
+	if o.NetPaid != nil && o.RefundedQuantity()+quantity >= o.Quantity {
+		return *o.NetPaid
+	}
+	share := (2*o.Total.Amount*int64(quantity) + int64(o.Quantity)) / (2 * int64(o.Quantity))
+	return NewMoney(share, o.Total.Currency)
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "errors"
+
+var ErrTaxRegionNotFound = errors.New("tax region not found")
+
+// TaxLine is an order line as charged to the customer, Amount is the line after discounts
+// in the currency of the order. It includes the tax when the region prices are inclusive.
+type TaxLine struct {
+	ProductID   string
+	CategoryIDs []string
+	Quantity    int
+	Amount      Money
+}
+
+// TaxRequest asks for the taxes of the lines shipped to Region, an empty Region uses the
+// default region of the rules.
+type TaxRequest struct {
+	Region string
+	Lines  []TaxLine
+}
+
+// LineTax splits a line in its amount before tax, the tax and the amount with tax.
+type LineTax struct {
+	Rate     float64
+	Subtotal Money
+	Tax      Money
+	Total    Money
+}
+
+// TaxBreakdown is the tax of every line and of the whole order. The order Tax is rounded
+// according to the rules of the region, so it can differ by a cent from the sum of the
+// line taxes when the taxes are rounded at the order level.
+type TaxBreakdown struct {
+	Region    string
+	Inclusive bool
+	Lines     []LineTax
+	Subtotal  Money
+	Tax       Money
+	Total     Money
+}
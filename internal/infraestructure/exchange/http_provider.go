@@ -0,0 +1,159 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// HTTPProvider fetches the rates document from an HTTP endpoint and caches it for ttl.
+// When a refresh fails the stale rates are kept, so a flaky provider does not block the
+// catalog, for up to maxStale since the last successful fetch. A failed refresh is not
+// retried before retry, the calls meanwhile answer the stale rates, or the refresh error
+// once they are too old, without waiting on the provider.
+type HTTPProvider struct {
+	url      string
+	ttl      time.Duration
+	retry    time.Duration
+	maxStale time.Duration
+	client   *http.Client
+	now      func() time.Time
+
+	mu        sync.Mutex
+	rates     *Rates
+	fetchedAt time.Time
+	failedAt  time.Time
+	failure   error
+	// refreshing is closed when the fetch in flight ends, nil when there is none
+	refreshing chan struct{}
+}
+
+func NewHTTPProvider(url string, ttl time.Duration, retry time.Duration, maxStale time.Duration, timeout time.Duration) *HTTPProvider {
+	return &HTTPProvider{
+		url:      url,
+		ttl:      ttl,
+		retry:    retry,
+		maxStale: maxStale,
+		client:   &http.Client{Timeout: timeout},
+		now:      time.Now,
+	}
+}
+
+func (p *HTTPProvider) GetRate(ctx context.Context, from string, to string) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+
+	rates, err := p.current(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return rates.Rate(from, to)
+}
+
+// current returns the cached rates, refreshing them once they are older than ttl. A single
+// fetch is in flight at a time and the lock is not held during it: the concurrent calls
+// answer the stale rates while they are usable and wait for the fetch otherwise.
+func (p *HTTPProvider) current(ctx context.Context) (Rates, error) {
+	for {
+		p.mu.Lock()
+		now := p.now()
+		if p.rates != nil && now.Sub(p.fetchedAt) < p.ttl {
+			rates := *p.rates
+			p.mu.Unlock()
+			return rates, nil
+		}
+
+		if refreshing := p.refreshing; refreshing != nil {
+			if rates, ok := p.stale(now); ok {
+				p.mu.Unlock()
+				return rates, nil
+			}
+			p.mu.Unlock()
+			select {
+			case <-refreshing:
+				continue
+			case <-ctx.Done():
+				return Rates{}, ctx.Err()
+			}
+		}
+
+		if p.failure != nil && now.Sub(p.failedAt) < p.retry {
+			rates, ok := p.stale(now)
+			failure := p.failure
+			p.mu.Unlock()
+			if ok {
+				return rates, nil
+			}
+			return Rates{}, failure
+		}
+
+		refreshing := make(chan struct{})
+		p.refreshing = refreshing
+		p.mu.Unlock()
+
+		// a cancelled request must not fail the refresh the other requests wait for
+		rates, err := p.fetch(context.WithoutCancel(ctx))
+
+		p.mu.Lock()
+		p.refreshing = nil
+		close(refreshing)
+		if err != nil {
+			p.failedAt = p.now()
+			p.failure = err
+			stale, ok := p.stale(p.failedAt)
+			fetchedAt := p.fetchedAt
+			p.mu.Unlock()
+			if ok {
+				logging.FromContext(ctx).Error("error refreshing exchange rates, using the cached rates", "fetched_at", fetchedAt, "error", err)
+				return stale, nil
+			}
+			return Rates{}, err
+		}
+
+		p.rates = &rates
+		p.fetchedAt = p.now()
+		p.failure = nil
+		p.mu.Unlock()
+		return rates, nil
+	}
+}
+
+// stale returns the cached rates while they are younger than maxStale, p.mu must be held.
+func (p *HTTPProvider) stale(now time.Time) (Rates, bool) {
+	if p.rates == nil || now.Sub(p.fetchedAt) >= p.maxStale {
+		return Rates{}, false
+	}
+	return *p.rates, true
+}
+
+func (p *HTTPProvider) fetch(ctx context.Context) (Rates, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url, nil)
+	if err != nil {
+		return Rates{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return Rates{}, fmt.Errorf("exchange rates request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Rates{}, fmt.Errorf("exchange rates request failed with status %d", resp.StatusCode)
+	}
+
+	var rates Rates
+	if err := json.NewDecoder(resp.Body).Decode(&rates); err != nil {
+		return Rates{}, fmt.Errorf("error parsing exchange rates: %w", err)
+	}
+	if err := rates.validate(); err != nil {
+		return Rates{}, err
+	}
+	return rates, nil
+}
@@ -0,0 +1,140 @@
+package exchange
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/internal/domain"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestHTTPProvider_GetRate(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	var failing atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"base":"USD","rates":{"EUR":0.8,"ARS":1000}}`))
+	}))
+	defer server.Close()
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	provider := NewHTTPProvider(server.URL, time.Hour, time.Minute, 24*time.Hour, time.Second)
+	provider.now = func() time.Time { return now }
+
+	// Act & Assert: cross rates are derived from the base
+	rate, err := provider.GetRate(context.Background(), "EUR", "ARS")
+	require.NoError(t, err)
+	assert.Equal(t, 1250.0, rate)
+
+	_, err = provider.GetRate(context.Background(), "USD", "BRL")
+	assert.ErrorIs(t, err, domain.ErrExchangeRateNotFound)
+	assert.Equal(t, int32(1), requests.Load(), "the rates are cached during the ttl")
+
+	// a failed refresh keeps serving the stale rates
+	now = now.Add(2 * time.Hour)
+	failing.Store(true)
+	rate, err = provider.GetRate(context.Background(), "USD", "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, 0.8, rate)
+	assert.Equal(t, int32(2), requests.Load())
+
+	// the failed refresh is not retried before the retry delay
+	now = now.Add(30 * time.Second)
+	_, err = provider.GetRate(context.Background(), "USD", "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load(), "the provider is backing off")
+
+	now = now.Add(time.Minute)
+	_, err = provider.GetRate(context.Background(), "USD", "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), requests.Load())
+
+	// past the max staleness the refresh error is returned, also while backing off
+	now = now.Add(24 * time.Hour)
+	_, err = provider.GetRate(context.Background(), "USD", "EUR")
+	assert.EqualError(t, err, "exchange rates request failed with status 502")
+	_, err = provider.GetRate(context.Background(), "USD", "EUR")
+	assert.EqualError(t, err, "exchange rates request failed with status 502")
+	assert.Equal(t, int32(4), requests.Load())
+
+	// a successful refresh serves fresh rates again
+	now = now.Add(time.Minute)
+	failing.Store(false)
+	rate, err = provider.GetRate(context.Background(), "USD", "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, 0.8, rate)
+}
+
+func TestHTTPProvider_GetRate_RefreshInFlight(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) > 1 {
+			// the refresh hangs until the test releases it
+			<-release
+		}
+		_, _ = w.Write([]byte(`{"base":"USD","rates":{"EUR":0.8}}`))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	var mu sync.Mutex
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	provider := NewHTTPProvider(server.URL, time.Hour, time.Minute, 24*time.Hour, 10*time.Second)
+	provider.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+
+	_, err := provider.GetRate(context.Background(), "USD", "EUR")
+	require.NoError(t, err)
+
+	mu.Lock()
+	now = now.Add(2 * time.Hour)
+	mu.Unlock()
+
+	refreshed := make(chan error, 1)
+	go func() {
+		_, err := provider.GetRate(context.Background(), "USD", "EUR")
+		refreshed <- err
+	}()
+	require.Eventually(t, func() bool { return requests.Load() == 2 }, time.Second, time.Millisecond)
+
+	// Act: the calls during the refresh do not wait for it
+	start := time.Now()
+	rate, err := provider.GetRate(context.Background(), "USD", "EUR")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 0.8, rate)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	assert.Equal(t, int32(2), requests.Load(), "a single refresh is in flight")
+
+	release <- struct{}{}
+	require.NoError(t, <-refreshed)
+}
+
+func TestHTTPProvider_GetRate_WithoutRates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	provider := NewHTTPProvider(server.URL, time.Hour, time.Minute, 24*time.Hour, time.Second)
+
+	_, err := provider.GetRate(context.Background(), "USD", "EUR")
+
+	assert.EqualError(t, err, "exchange rates request failed with status 500")
+}
@@ -0,0 +1,54 @@
+package exchange
+
+import (
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+	"strings"
+)
+
+// Rates is the document read by both providers, the rates are the units of each currency
+// per unit of Base:
+//
+//	{"base": "USD", "rates": {"EUR": 0.92, "ARS": 1050.5}}
+type Rates struct {
+	Base  string             `json:"base"`
+	Rates map[string]float64 `json:"rates"`
+}
+
+// Rate derives the cross rate between two currencies of the document.
+func (r Rates) Rate(from string, to string) (float64, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return 1, nil
+	}
+
+	fromRate, ok := r.rateFromBase(from)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", domain.ErrExchangeRateNotFound, from)
+	}
+	toRate, ok := r.rateFromBase(to)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", domain.ErrExchangeRateNotFound, to)
+	}
+	return toRate / fromRate, nil
+}
+
+func (r Rates) rateFromBase(currency string) (float64, bool) {
+	if currency == strings.ToUpper(r.Base) {
+		return 1, true
+	}
+	rate, ok := r.Rates[currency]
+	return rate, ok && rate > 0
+}
+
+func (r Rates) validate() error {
+	if r.Base == "" {
+		return fmt.Errorf("exchange rates without base currency")
+	}
+	for currency, rate := range r.Rates {
+		if rate <= 0 {
+			return fmt.Errorf("exchange rate of %s must be positive", currency)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// StaticProvider serves the rates of a JSON file read once at startup, it is meant for
+// local development and for catalogs whose rates are updated with a deploy.
+type StaticProvider struct {
+	rates Rates
+}
+
+func NewStaticProvider(path string) (*StaticProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading exchange rates file: %w", err)
+	}
+
+	var rates Rates
+	if err := json.Unmarshal(content, &rates); err != nil {
+		return nil, fmt.Errorf("error parsing exchange rates file: %w", err)
+	}
+	if err := rates.validate(); err != nil {
+		return nil, err
+	}
+	return &StaticProvider{rates: rates}, nil
+}
+
+func (p *StaticProvider) GetRate(_ context.Context, from string, to string) (float64, error) {
+	return p.rates.Rate(from, to)
+}
@@ -0,0 +1,129 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOK           = "ok"
+	StatusFailing      = "failing"
+	StatusReady        = "ready"
+	StatusNotReady     = "not_ready"
+	StatusShuttingDown = "shutting_down"
+)
+
+// Check is a dependency the service needs to serve requests, Run returns an error when the
+// dependency is not usable. Run gets a context with the timeout of the checker and must
+// return once it is done.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// CheckResult is the last outcome of a check, Latency is how long Run took.
+type CheckResult struct {
+	Status    string    `json:"status"`
+	Latency   string    `json:"latency"`
+	Error     string    `json:"error,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Report is the readiness of the service, it is ready when every check is ok and it is not
+// shutting down.
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+func (r Report) Ready() bool {
+	return r.Status == StatusReady
+}
+
+// Checker runs the readiness checks. The results are cached for ttl so frequent probes do
+// not hammer the dependencies, and concurrent probes wait for a single run.
+type Checker struct {
+	checks  []Check
+	timeout time.Duration
+	ttl     time.Duration
+
+	mu           sync.Mutex
+	results      map[string]CheckResult
+	shuttingDown atomic.Bool
+}
+
+func NewChecker(timeout time.Duration, ttl time.Duration, checks ...Check) *Checker {
+	return &Checker{
+		checks:  checks,
+		timeout: timeout,
+		ttl:     ttl,
+		results: map[string]CheckResult{},
+	}
+}
+
+// ShutDown marks the service as shutting down, the readiness fails from now on so the
+// orchestrator stops sending traffic while the requests in flight are drained.
+func (c *Checker) ShutDown() {
+	c.shuttingDown.Store(true)
+}
+
+// Check returns the readiness of the service, running the checks whose result is older
+// than ttl. The checks run concurrently, each one with its own timeout.
+func (c *Checker) Check(ctx context.Context) Report {
+	if c.shuttingDown.Load() {
+		return Report{Status: StatusShuttingDown}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var wg sync.WaitGroup
+	var resultsMu sync.Mutex
+	for _, check := range c.checks {
+		if result, ok := c.results[check.Name]; ok && time.Now().Sub(result.CheckedAt) < c.ttl {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := c.run(ctx, check)
+
+			resultsMu.Lock()
+			c.results[check.Name] = result
+			resultsMu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusReady, Checks: make(map[string]CheckResult, len(c.checks))}
+	for _, check := range c.checks {
+		result := c.results[check.Name]
+		if result.Status != StatusOK {
+			report.Status = StatusNotReady
+		}
+		report.Checks[check.Name] = result
+	}
+	return report
+}
+
+func (c *Checker) run(ctx context.Context, check Check) CheckResult {
+	// the result is cached, so a probe that gives up must not fail the check of the next ones
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Run(ctx)
+	result := CheckResult{
+		Status:    StatusOK,
+		Latency:   time.Since(start).String(),
+		CheckedAt: time.Now(),
+	}
+	if err != nil {
+		result.Status = StatusFailing
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,107 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/infraestructure/health"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeSchema struct {
+	version int
+}
+
+func (f fakeSchema) GetSchemaVersion(ctx context.Context) (int, error) {
+	return f.version, nil
+}
+
+func TestChecker(t *testing.T) {
+	hanging := health.Check{Name: "mysql", Run: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}}
+
+	testCases := []struct {
+		testName       string
+		checks         []health.Check
+		expectedStatus string
+		expectedChecks map[string]string
+		expectedError  string
+	}{
+		{
+			testName:       "Success - Every check is ok",
+			checks:         []health.Check{{Name: "mysql", Run: func(ctx context.Context) error { return nil }}, health.MigrationsCheck(fakeSchema{version: 1}, 1)},
+			expectedStatus: health.StatusReady,
+			expectedChecks: map[string]string{"mysql": health.StatusOK, "migrations": health.StatusOK},
+		},
+		{
+			testName:       "Failure - Schema older than the service",
+			checks:         []health.Check{health.MigrationsCheck(fakeSchema{version: 1}, 2)},
+			expectedStatus: health.StatusNotReady,
+			expectedChecks: map[string]string{"migrations": health.StatusFailing},
+			expectedError:  "schema version is 1, 2 is required",
+		},
+		{
+			testName:       "Failure - Check over the timeout",
+			checks:         []health.Check{hanging},
+			expectedStatus: health.StatusNotReady,
+			expectedChecks: map[string]string{"mysql": health.StatusFailing},
+			expectedError:  context.DeadlineExceeded.Error(),
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			checker := health.NewChecker(20*time.Millisecond, time.Minute, tc.checks...)
+
+			// Act
+			report := checker.Check(context.Background())
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, report.Status)
+			for name, status := range tc.expectedChecks {
+				assert.Equal(t, status, report.Checks[name].Status)
+				assert.NotEmpty(t, report.Checks[name].Latency)
+				if tc.expectedError != "" {
+					assert.Equal(t, tc.expectedError, report.Checks[name].Error)
+				}
+			}
+		})
+	}
+}
+
+func TestChecker_Cache(t *testing.T) {
+	var pings atomic.Int32
+	ping := health.Check{Name: "mysql", Run: func(ctx context.Context) error {
+		pings.Add(1)
+		return errors.New("connection refused")
+	}}
+
+	cached := health.NewChecker(time.Second, time.Minute, ping)
+	for range 5 {
+		assert.False(t, cached.Check(context.Background()).Ready())
+	}
+	assert.Equal(t, int32(1), pings.Load())
+
+	uncached := health.NewChecker(time.Second, 0, ping)
+	uncached.Check(context.Background())
+	uncached.Check(context.Background())
+	assert.Equal(t, int32(3), pings.Load())
+}
+
+func TestChecker_ShutDown(t *testing.T) {
+	checker := health.NewChecker(time.Second, time.Minute, health.Check{Name: "mysql", Run: func(ctx context.Context) error { return nil }})
+	assert.True(t, checker.Check(context.Background()).Ready())
+
+	checker.ShutDown()
+
+	report := checker.Check(context.Background())
+	assert.False(t, report.Ready())
+	assert.Equal(t, health.StatusShuttingDown, report.Status)
+}
@@ -0,0 +1,37 @@
+package health
+
+import (
+	"context"
+	"fmt"
+)
+
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+type SchemaVersionReader interface {
+	GetSchemaVersion(ctx context.Context) (int, error)
+}
+
+// DatabaseCheck pings the database through a connection of the pool.
+func DatabaseCheck(name string, db Pinger) Check {
+	return Check{Name: name, Run: db.Ping}
+}
+
+// MigrationsCheck fails until the schema of the database is at least expected, the service
+// must not take traffic on a schema older than the one it was written for.
+func MigrationsCheck(db SchemaVersionReader, expected int) Check {
+	return Check{
+		Name: "migrations",
+		Run: func(ctx context.Context) error {
+			version, err := db.GetSchemaVersion(ctx)
+			if err != nil {
+				return err
+			}
+			if version < expected {
+				return fmt.Errorf("schema version is %d, %d is required", version, expected)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package invoice
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"io/fs"
+	"microservice-products-catalog/internal/domain"
+	"os"
+	"path/filepath"
+	texttemplate "text/template"
+)
+
+const (
+	MediaTypeHTML = "text/html"
+	MediaTypeText = "text/plain"
+
+	htmlTemplate = "invoice.html.tmpl"
+	textTemplate = "invoice.txt.tmpl"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// Renderer writes the invoices with html/template and text/template. The templates of dir
+// replace the embedded ones file by file, dir can be empty to keep the embedded ones. The
+// templates get a domain.Invoice.
+type Renderer struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+func NewRenderer(dir string) (*Renderer, error) {
+	htmlSource, err := readTemplate(dir, htmlTemplate)
+	if err != nil {
+		return nil, err
+	}
+	textSource, err := readTemplate(dir, textTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	html, err := htmltemplate.New(htmlTemplate).Parse(htmlSource)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", htmlTemplate, err)
+	}
+	text, err := texttemplate.New(textTemplate).Parse(textSource)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", textTemplate, err)
+	}
+	return &Renderer{html: html, text: text}, nil
+}
+
+// Render writes the invoice as mediaType, text/html or text/plain.
+func (r *Renderer) Render(w io.Writer, mediaType string, invoice domain.Invoice) error {
+	switch mediaType {
+	case MediaTypeHTML:
+		return r.html.Execute(w, invoice)
+	case MediaTypeText:
+		return r.text.Execute(w, invoice)
+	}
+	return fmt.Errorf("%w: %s", domain.ErrInvoiceFormatNotSupported, mediaType)
+}
+
+// readTemplate reads the template from dir when it has one, from the embedded defaults otherwise.
+func readTemplate(dir string, name string) (string, error) {
+	if dir != "" {
+		source, err := os.ReadFile(filepath.Join(dir, name))
+		if err == nil {
+			return string(source), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("reading invoice template: %w", err)
+		}
+	}
+
+	source, err := defaultTemplates.ReadFile("templates/" + name)
+	if err != nil {
+		return "", err
+	}
+	return string(source), nil
+}
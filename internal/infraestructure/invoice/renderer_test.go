@@ -0,0 +1,81 @@
+package invoice
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/internal/domain"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	sku := "GOPHER-M"
+	code := "SUMMER10"
+	netPaid := domain.NewMoney(1650, domain.BaseCurrency)
+	invoice := domain.Invoice{
+		Number:   "INV-000042",
+		IssuedAt: time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC),
+		Customer: &domain.Customer{Name: "Ada <Lovelace>", Email: "ada@example.com"},
+		Order: domain.Order{
+			ID:          "18eb9153-a00c-466d-8f38-f149806b054e",
+			ProductName: "Gopher plush",
+			VariantSKU:  &sku,
+			Quantity:    2,
+			UnitPrice:   domain.NewMoney(1500, domain.BaseCurrency),
+			Subtotal:    domain.NewMoney(2500, domain.BaseCurrency),
+			Tax:         domain.NewMoney(0, domain.BaseCurrency),
+			Total:       domain.NewMoney(2500, domain.BaseCurrency),
+			Status:      domain.OrderConfirmed,
+			Discounts:   []domain.OrderDiscount{{Name: "Summer coupon", CouponCode: &code, Amount: domain.NewMoney(500, domain.BaseCurrency)}},
+			Refunds: []domain.Refund{
+				{Quantity: 1, Reason: "damaged", Amount: domain.NewMoney(850, domain.BaseCurrency), Status: domain.RefundCompleted, CreatedAt: time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)},
+				{Quantity: 1, Reason: "refused", Amount: domain.NewMoney(850, domain.BaseCurrency), Status: domain.RefundFailed, CreatedAt: time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC)},
+			},
+			NetPaid: &netPaid,
+		},
+	}
+
+	renderer, err := NewRenderer("")
+	require.NoError(t, err)
+
+	var text bytes.Buffer
+	require.NoError(t, renderer.Render(&text, MediaTypeText, invoice))
+	assert.Contains(t, text.String(), "INVOICE INV-000042")
+	assert.Contains(t, text.String(), "2 x Gopher plush (GOPHER-M) at 15.00 USD: 30.00 USD")
+	assert.Contains(t, text.String(), "Summer coupon (SUMMER10): -5.00 USD")
+	assert.Contains(t, text.String(), "Refund of 1 on 2026-10-05 (damaged): -8.50 USD")
+	assert.Contains(t, text.String(), "Net paid: 16.50 USD")
+	assert.NotContains(t, text.String(), "refused")
+
+	var html bytes.Buffer
+	require.NoError(t, renderer.Render(&html, MediaTypeHTML, invoice))
+	assert.Contains(t, html.String(), "<h1>Invoice INV-000042</h1>")
+	assert.Contains(t, html.String(), "Ada &lt;Lovelace&gt;")
+	assert.NotContains(t, html.String(), "refused")
+
+	assert.ErrorIs(t, renderer.Render(&html, "application/pdf", invoice), domain.ErrInvoiceFormatNotSupported)
+}
+
+func TestNewRenderer_Override(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invoice.txt.tmpl"), []byte("Receipt {{.Number}}"), 0o644))
+
+	renderer, err := NewRenderer(dir)
+	require.NoError(t, err)
+
+	var text bytes.Buffer
+	require.NoError(t, renderer.Render(&text, MediaTypeText, domain.Invoice{Number: "INV-000001"}))
+	assert.Equal(t, "Receipt INV-000001", text.String())
+
+	// the html template was not overridden
+	var html bytes.Buffer
+	require.NoError(t, renderer.Render(&html, MediaTypeHTML, domain.Invoice{Number: "INV-000001"}))
+	assert.Contains(t, html.String(), "<h1>Invoice INV-000001</h1>")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invoice.html.tmpl"), []byte("{{.Number"), 0o644))
+	_, err = NewRenderer(dir)
+	assert.Error(t, err)
+}
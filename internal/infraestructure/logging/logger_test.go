@@ -0,0 +1,42 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	var out bytes.Buffer
+	logger, err := New(&out, "json", "warn")
+	require.NoError(t, err)
+
+	logger.Info("not written")
+	logger.Warn("written", "order_id", "18eb9153")
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entry))
+	assert.Equal(t, "written", entry["msg"])
+	assert.Equal(t, "WARN", entry["level"])
+	assert.Equal(t, "18eb9153", entry["order_id"])
+
+	_, err = New(&out, "xml", "info")
+	assert.Error(t, err)
+	_, err = New(&out, "text", "verbose")
+	assert.Error(t, err)
+}
+
+func TestFromContext(t *testing.T) {
+	var out bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&out, nil)).With("request_id", "req-1")
+
+	ctx := WithLogger(context.Background(), logger)
+	FromContext(ctx).Info("order saved")
+
+	assert.Contains(t, out.String(), "request_id=req-1")
+	assert.Equal(t, slog.Default(), FromContext(context.Background()))
+}
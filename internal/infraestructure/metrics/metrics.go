@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"database/sql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Metrics holds the collectors of the service in their own registry, it is exposed in the
+// Prometheus text format by Handler.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests        *prometheus.CounterVec
+	httpRequestDuration *prometheus.HistogramVec
+	orders              *prometheus.CounterVec
+	txDuration          prometheus.Histogram
+	txRollbacks         prometheus.Counter
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "HTTP requests served, by method, route and status.",
+		}, []string{"method", "route", "status"}),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Time to serve the HTTP requests, by method, route and status.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		orders: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "orders_total",
+			Help: "Orders placed, by outcome: created, insufficient_stock, not_found, payment_failed or failed.",
+		}, []string{"outcome"}),
+		txDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "db_transaction_duration_seconds",
+			Help:    "Time spent in the database transactions, commit or rollback included.",
+			Buckets: prometheus.DefBuckets,
+		}),
+		txRollbacks: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "db_transaction_rollbacks_total",
+			Help: "Database transactions rolled back.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpRequestDuration,
+		m.orders,
+		m.txDuration,
+		m.txRollbacks,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// RegisterDB exposes the connection pool stats of db (open, in use and idle connections,
+// waits), read on every scrape.
+func (m *Metrics) RegisterDB(db *sql.DB, name string) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// ObserveRequest records a request served, route is the pattern it matched.
+func (m *Metrics) ObserveRequest(method string, route string, status int, duration time.Duration) {
+	labels := prometheus.Labels{"method": method, "route": route, "status": strconv.Itoa(status)}
+	m.httpRequests.With(labels).Inc()
+	m.httpRequestDuration.With(labels).Observe(duration.Seconds())
+}
+
+// RecordOrders counts count orders with the outcome, see order.InstrumentedService.
+func (m *Metrics) RecordOrders(outcome string, count int) {
+	m.orders.WithLabelValues(outcome).Add(float64(count))
+}
@@ -0,0 +1,59 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/infraestructure/metrics"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakeTxManager struct{}
+
+func (fakeTxManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func scrape(t *testing.T, m *metrics.Metrics) string {
+	recorder := httptest.NewRecorder()
+	m.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	return recorder.Body.String()
+}
+
+func TestMetrics(t *testing.T) {
+	m := metrics.New()
+
+	m.ObserveRequest(http.MethodGet, "/api/products/", http.StatusNotFound, 30*time.Millisecond)
+	m.ObserveRequest(http.MethodGet, "/api/products/", http.StatusNotFound, 70*time.Millisecond)
+	m.RecordOrders("created", 2)
+	m.RecordOrders("insufficient_stock", 1)
+
+	body := scrape(t, m)
+
+	assert.Contains(t, body, `http_requests_total{method="GET",route="/api/products/",status="404"} 2`)
+	assert.Contains(t, body, `http_request_duration_seconds_bucket{method="GET",route="/api/products/",status="404",le="0.05"} 1`)
+	assert.Contains(t, body, `http_request_duration_seconds_count{method="GET",route="/api/products/",status="404"} 2`)
+	assert.Contains(t, body, `orders_total{outcome="created"} 2`)
+	assert.Contains(t, body, `orders_total{outcome="insufficient_stock"} 1`)
+	assert.Contains(t, body, "go_goroutines")
+}
+
+func TestTxManager(t *testing.T) {
+	m := metrics.New()
+	txManager := metrics.NewTxManager(fakeTxManager{}, m)
+	rollbackErr := errors.New("insufficient stock")
+
+	err := txManager.WithTransaction(context.Background(), func(ctx context.Context) error { return nil })
+	assert.NoError(t, err)
+	err = txManager.WithTransaction(context.Background(), func(ctx context.Context) error { return rollbackErr })
+	assert.ErrorIs(t, err, rollbackErr)
+
+	body := scrape(t, m)
+
+	assert.Contains(t, body, "db_transaction_duration_seconds_count 2")
+	assert.Contains(t, body, "db_transaction_rollbacks_total 1")
+}
@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"context"
+	"time"
+)
+
+type TransactionManager interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// TxManager decorates a TransactionManager recording the duration of the transactions and
+// counting the ones rolled back, those whose fn or commit failed.
+type TxManager struct {
+	next    TransactionManager
+	metrics *Metrics
+}
+
+func NewTxManager(next TransactionManager, metrics *Metrics) *TxManager {
+	return &TxManager{next: next, metrics: metrics}
+}
+
+func (m *TxManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	start := time.Now()
+	err := m.next.WithTransaction(ctx, fn)
+
+	m.metrics.txDuration.Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.metrics.txRollbacks.Inc()
+	}
+	return err
+}
@@ -0,0 +1,27 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) DeleteCartItem(ctx context.Context, cartID string, itemID string) error {
+	var db = r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).Where("id = ? AND cart_id = ?", itemID, cartID).Delete(&domain.CartItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrCartItemNotFound
+	}
+
+	logging.FromContext(ctx).Info("cart item deleted", "cart_item_id", itemID)
+	return nil
+}
@@ -0,0 +1,27 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) DeleteCategory(ctx context.Context, id string) error {
+	var db = r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).Where("id = ?", id).Delete(&domain.Category{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrCategoryNotFound
+	}
+
+	logging.FromContext(ctx).Info("category deleted", "category_id", id)
+	return nil
+}
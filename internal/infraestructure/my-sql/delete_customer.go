@@ -0,0 +1,31 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// DeleteCustomer refuses to delete a customer with orders, the orders keep a reference to it.
+func (r *Repository) DeleteCustomer(ctx context.Context, id string) error {
+	var db = r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).Where("id = ?", id).Delete(&domain.Customer{})
+	if isForeignKeyViolation(result.Error) {
+		return domain.ErrCustomerHasOrders
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrCustomerNotFound
+	}
+
+	logging.FromContext(ctx).Info("customer deleted", "customer_id", id)
+	return nil
+}
@@ -0,0 +1,30 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"time"
+)
+
+// DeleteExpiredCarts removes the open carts that expired before the given time, their items
+// are removed by the cascade of the foreign key. The checked out carts are kept.
+func (r *Repository) DeleteExpiredCarts(ctx context.Context, before time.Time) (int, error) {
+	var db = r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Where("status = ? AND expires_at <= ?", domain.CartOpen, before).
+		Delete(&domain.Cart{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		logging.FromContext(ctx).Info("expired carts deleted", "count", result.RowsAffected)
+	}
+	return int(result.RowsAffected), nil
+}
@@ -2,8 +2,8 @@ package my_sql
 
 import (
 	"context"
-	"fmt"
 	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
 )
 
 func (r *Repository) DeleteProduct(ctx context.Context, id string) error {
@@ -22,7 +22,7 @@ func (r *Repository) DeleteProduct(ctx context.Context, id string) error {
 		return domain.ErrProductNotFound
 	}
 
-	fmt.Printf("[LOG] - Product with ID : %s deeted correctly\n", id)
+	logging.FromContext(ctx).Info("product deleted", "product_id", id)
 	return nil
 
 }
@@ -0,0 +1,29 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) RemoveProductFromCategory(ctx context.Context, categoryID string, productID string) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Where("category_id = ? AND product_id = ?", categoryID, productID).
+		Delete(&productCategory{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrProductNotFound
+	}
+
+	logging.FromContext(ctx).Info("product removed from category", "product_id", productID, "category_id", categoryID)
+	return nil
+}
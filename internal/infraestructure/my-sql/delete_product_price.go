@@ -0,0 +1,29 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) DeleteProductPrice(ctx context.Context, productID string, currency string) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Where("product_id = ? AND currency = ?", productID, currency).
+		Delete(&productPrice{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrPriceNotFound
+	}
+
+	logging.FromContext(ctx).Info("product price deleted", "product_id", productID, "currency", currency)
+	return nil
+}
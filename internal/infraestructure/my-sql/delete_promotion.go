@@ -0,0 +1,27 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) DeletePromotion(ctx context.Context, id string) error {
+	var db = r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).Where("id = ?", id).Delete(&promotionRow{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrPromotionNotFound
+	}
+
+	logging.FromContext(ctx).Info("promotion deleted", "promotion_id", id)
+	return nil
+}
@@ -0,0 +1,30 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) DeleteVariant(ctx context.Context, id string) error {
+	var db = r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).Where("id = ?", id).Delete(&domain.Variant{})
+	if isForeignKeyViolation(result.Error) {
+		return domain.ErrVariantHasOrders
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrVariantNotFound
+	}
+
+	logging.FromContext(ctx).Info("variant deleted", "variant_id", id)
+	return nil
+}
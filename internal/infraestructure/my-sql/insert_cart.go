@@ -0,0 +1,22 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) CreateCart(ctx context.Context, cart domain.Cart) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	if err := db.WithContext(ctx).Create(&cart).Error; err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("cart saved", "cart_id", cart.ID)
+	return nil
+}
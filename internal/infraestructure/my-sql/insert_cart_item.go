@@ -0,0 +1,22 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) CreateCartItem(ctx context.Context, item domain.CartItem) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	if err := db.WithContext(ctx).Create(&item).Error; err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("cart item saved", "cart_item_id", item.ID)
+	return nil
+}
@@ -0,0 +1,22 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) CreateCategory(ctx context.Context, category domain.Category) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	if err := db.WithContext(ctx).Create(&category).Error; err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("category saved", "category_id", category.ID)
+	return nil
+}
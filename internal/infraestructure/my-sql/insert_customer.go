@@ -0,0 +1,27 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// CreateCustomer fails with ErrCustomerAlreadyExists when the ID or the email are taken.
+func (r *Repository) CreateCustomer(ctx context.Context, customer domain.Customer) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	err := db.WithContext(ctx).Create(&customer).Error
+	if isDuplicateKey(err) {
+		return domain.ErrCustomerAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("customer saved", "customer_id", customer.ID)
+	return nil
+}
@@ -2,10 +2,26 @@ package my_sql
 
 import (
 	"context"
-	"fmt"
 	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
 )
 
+// orderDiscountRow is a row of order_discounts, the currency of the amount lives in its own column.
+type orderDiscountRow struct {
+	ID          uint `gorm:"primaryKey"`
+	OrderID     string
+	PromotionID *string
+	Name        string
+	CouponCode  *string
+	Amount      domain.Money
+	Currency    string
+}
+
+func (orderDiscountRow) TableName() string {
+	return "order_discounts"
+}
+
+// CreateOrder writes the order and its discounts breakdown.
 func (r *Repository) CreateOrder(ctx context.Context, order domain.Order) error {
 	db := r.db
 
@@ -17,6 +33,23 @@ func (r *Repository) CreateOrder(ctx context.Context, order domain.Order) error
 		return err
 	}
 
-	fmt.Printf("[LOG] - Order with ID : %s saved correctly\n", order.ID)
+	if len(order.Discounts) > 0 {
+		rows := make([]orderDiscountRow, 0, len(order.Discounts))
+		for _, discount := range order.Discounts {
+			rows = append(rows, orderDiscountRow{
+				OrderID:     order.ID,
+				PromotionID: discount.PromotionID,
+				Name:        discount.Name,
+				CouponCode:  discount.CouponCode,
+				Amount:      discount.Amount,
+				Currency:    discount.Amount.Currency,
+			})
+		}
+		if err := db.WithContext(ctx).Create(&rows).Error; err != nil {
+			return err
+		}
+	}
+
+	logging.FromContext(ctx).Info("order saved", "order_id", order.ID)
 	return nil
 }
@@ -0,0 +1,46 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"time"
+)
+
+// priceHistoryRow is a row of price_history, the id only keeps the entries of the same second in order.
+type priceHistoryRow struct {
+	ID               uint `gorm:"primaryKey"`
+	ProductID        string
+	OldPrice         domain.Money
+	NewPrice         domain.Money
+	Source           domain.PriceChangeSource
+	ScheduledPriceID *string
+	ChangedAt        time.Time
+}
+
+func (priceHistoryRow) TableName() string {
+	return "price_history"
+}
+
+func (r *Repository) CreatePriceChange(ctx context.Context, change domain.PriceChange) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	row := priceHistoryRow{
+		ProductID:        change.ProductID,
+		OldPrice:         change.OldPrice,
+		NewPrice:         change.NewPrice,
+		Source:           change.Source,
+		ScheduledPriceID: change.ScheduledPriceID,
+		ChangedAt:        change.ChangedAt,
+	}
+	if err := db.WithContext(ctx).Create(&row).Error; err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("price change saved", "product_id", change.ProductID)
+	return nil
+}
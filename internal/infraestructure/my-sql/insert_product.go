@@ -2,8 +2,8 @@ package my_sql
 
 import (
 	"context"
-	"fmt"
 	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
 )
 
 func (r *Repository) SaveProduct(ctx context.Context, product *domain.Product) error {
@@ -17,6 +17,9 @@ func (r *Repository) SaveProduct(ctx context.Context, product *domain.Product) e
 		WithContext(ctx).
 		Save(product)
 
+	if isDuplicateKey(result.Error) {
+		return domain.ErrProductAlreadyExists
+	}
 	if result.Error != nil {
 		return result.Error
 	}
@@ -25,6 +28,6 @@ func (r *Repository) SaveProduct(ctx context.Context, product *domain.Product) e
 		return domain.ErrProductNotFound
 	}
 
-	fmt.Printf("[LOG] - Product with ID : %s saved correctly\n", product.ID)
+	logging.FromContext(ctx).Info("product saved", "product_id", product.ID)
 	return nil
 }
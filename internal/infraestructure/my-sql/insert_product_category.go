@@ -0,0 +1,33 @@
+package my_sql
+
+import (
+	"context"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+type productCategory struct {
+	ProductID  string `gorm:"primaryKey"`
+	CategoryID string `gorm:"primaryKey"`
+}
+
+func (productCategory) TableName() string {
+	return "product_categories"
+}
+
+// AddProductToCategory links the product to the category, linking it twice is a no-op.
+func (r *Repository) AddProductToCategory(ctx context.Context, categoryID string, productID string) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	link := productCategory{ProductID: productID, CategoryID: categoryID}
+	if err := db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&link).Error; err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("product added to category", "product_id", productID, "category_id", categoryID)
+	return nil
+}
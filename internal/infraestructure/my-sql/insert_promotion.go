@@ -0,0 +1,101 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"time"
+)
+
+// promotionRow is a row of promotions, the currency of the fixed amount lives in its own column.
+type promotionRow struct {
+	ID              string
+	Name            string
+	Type            domain.PromotionType
+	Percentage      int
+	Amount          *domain.Money
+	Currency        *string
+	BuyQuantity     int
+	GetQuantity     int
+	ProductID       *string
+	CouponCode      *string
+	UsageLimit      *int
+	RedemptionCount int
+	StartsAt        *time.Time
+	EndsAt          *time.Time
+	Active          bool
+}
+
+func (promotionRow) TableName() string {
+	return "promotions"
+}
+
+func newPromotionRow(promotion domain.Promotion) promotionRow {
+	row := promotionRow{
+		ID:              promotion.ID,
+		Name:            promotion.Name,
+		Type:            promotion.Type,
+		Percentage:      promotion.Percentage,
+		Amount:          promotion.Amount,
+		BuyQuantity:     promotion.BuyQuantity,
+		GetQuantity:     promotion.GetQuantity,
+		ProductID:       promotion.ProductID,
+		CouponCode:      promotion.CouponCode,
+		UsageLimit:      promotion.UsageLimit,
+		RedemptionCount: promotion.RedemptionCount,
+		StartsAt:        promotion.StartsAt,
+		EndsAt:          promotion.EndsAt,
+		Active:          promotion.Active,
+	}
+	if promotion.Amount != nil {
+		row.Currency = &promotion.Amount.Currency
+	}
+	return row
+}
+
+func (row promotionRow) promotion() domain.Promotion {
+	promotion := domain.Promotion{
+		ID:              row.ID,
+		Name:            row.Name,
+		Type:            row.Type,
+		Percentage:      row.Percentage,
+		BuyQuantity:     row.BuyQuantity,
+		GetQuantity:     row.GetQuantity,
+		ProductID:       row.ProductID,
+		CouponCode:      row.CouponCode,
+		UsageLimit:      row.UsageLimit,
+		RedemptionCount: row.RedemptionCount,
+		StartsAt:        row.StartsAt,
+		EndsAt:          row.EndsAt,
+		Active:          row.Active,
+	}
+	if row.Amount != nil {
+		currency := ""
+		if row.Currency != nil {
+			currency = *row.Currency
+		}
+		amount := domain.NewMoney(row.Amount.Amount, currency)
+		promotion.Amount = &amount
+	}
+	return promotion
+}
+
+func (r *Repository) CreatePromotion(ctx context.Context, promotion domain.Promotion) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	row := newPromotionRow(promotion)
+	err := db.WithContext(ctx).Create(&row).Error
+	if isDuplicateKey(err) {
+		return domain.ErrCouponAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("promotion saved", "promotion_id", promotion.ID)
+	return nil
+}
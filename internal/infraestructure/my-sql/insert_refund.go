@@ -0,0 +1,64 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"time"
+)
+
+// refundRow is a row of order_refunds, the currency of the amount lives in its own column.
+type refundRow struct {
+	ID        string
+	OrderID   string
+	Quantity  int
+	Amount    domain.Money
+	Currency  string
+	Reason    string
+	Restock   bool
+	Status    string
+	CreatedAt time.Time
+}
+
+func (refundRow) TableName() string {
+	return "order_refunds"
+}
+
+func (r *Repository) CreateRefund(ctx context.Context, refund domain.Refund) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	row := refundRow{
+		ID:        refund.ID,
+		OrderID:   refund.OrderID,
+		Quantity:  refund.Quantity,
+		Amount:    refund.Amount,
+		Currency:  refund.Amount.Currency,
+		Reason:    refund.Reason,
+		Restock:   refund.Restock,
+		Status:    string(refund.Status),
+		CreatedAt: refund.CreatedAt,
+	}
+	if err := db.WithContext(ctx).Create(&row).Error; err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("refund saved", "refund_id", refund.ID, "order_id", refund.OrderID, "status", refund.Status)
+	return nil
+}
+
+func (row refundRow) refund() domain.Refund {
+	return domain.Refund{
+		ID:        row.ID,
+		OrderID:   row.OrderID,
+		Quantity:  row.Quantity,
+		Amount:    domain.NewMoney(row.Amount.Amount, row.Currency),
+		Reason:    row.Reason,
+		Restock:   row.Restock,
+		Status:    domain.RefundStatus(row.Status),
+		CreatedAt: row.CreatedAt,
+	}
+}
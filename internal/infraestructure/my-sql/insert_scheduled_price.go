@@ -0,0 +1,22 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) CreateScheduledPrice(ctx context.Context, scheduledPrice domain.ScheduledPrice) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	if err := db.WithContext(ctx).Create(&scheduledPrice).Error; err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("scheduled price saved", "scheduled_price_id", scheduledPrice.ID)
+	return nil
+}
@@ -0,0 +1,22 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) CreateStockAlert(ctx context.Context, alert domain.StockAlert) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	if err := db.WithContext(ctx).Create(&alert).Error; err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("stock alert saved", "stock_alert_id", alert.ID)
+	return nil
+}
@@ -0,0 +1,26 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) CreateVariant(ctx context.Context, variant domain.Variant) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	err := db.WithContext(ctx).Create(&variant).Error
+	if isDuplicateKey(err) {
+		return domain.ErrVariantSKUAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("variant saved", "variant_id", variant.ID)
+	return nil
+}
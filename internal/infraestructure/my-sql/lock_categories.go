@@ -0,0 +1,21 @@
+package my_sql
+
+import (
+	"context"
+)
+
+// LockCategories takes a write lock on the whole category tree so concurrent moves
+// cannot interleave their cycle checks.
+func (r *Repository) LockCategories(ctx context.Context) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var ids []string
+	return db.WithContext(ctx).
+		Raw("SELECT id FROM categories FOR UPDATE").
+		Scan(&ids).
+		Error
+}
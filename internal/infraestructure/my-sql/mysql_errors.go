@@ -0,0 +1,27 @@
+package my_sql
+
+import (
+	"errors"
+	"github.com/go-sql-driver/mysql"
+)
+
+// MySQL error numbers the repository reports as domain errors, the connection does not
+// translate the driver errors so they keep their identity for every other query.
+const (
+	errDuplicateEntry  = 1062
+	errRowIsReferenced = 1451
+	errNoReferencedRow = 1452
+)
+
+// isDuplicateKey reports whether err is a violation of a primary or unique key.
+func isDuplicateKey(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == errDuplicateEntry
+}
+
+// isForeignKeyViolation reports whether err is a violation of a foreign key, deleting a
+// referenced row or referencing a missing one.
+func isForeignKeyViolation(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && (mysqlErr.Number == errRowIsReferenced || mysqlErr.Number == errNoReferencedRow)
+}
@@ -0,0 +1,14 @@
+package my_sql
+
+import (
+	"context"
+)
+
+// Ping checks that a connection of the pool reaches MySQL.
+func (r *Repository) Ping(ctx context.Context) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
@@ -0,0 +1,49 @@
+package my_sql
+
+import (
+	"context"
+	gormlogger "gorm.io/gorm/logger"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"time"
+)
+
+// queryLogger writes the gorm logs with the logger carried by the context, so the slow and
+// failed queries of a request log with its request ID.
+type queryLogger struct {
+	config gormlogger.Config
+}
+
+func newQueryLogger() *queryLogger {
+	return &queryLogger{config: gormlogger.Config{
+		SlowThreshold:             200 * time.Millisecond,
+		LogLevel:                  gormlogger.Warn,
+		IgnoreRecordNotFoundError: true,
+		ParameterizedQueries:      true,
+	}}
+}
+
+func (l *queryLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	config := l.config
+	config.LogLevel = level
+	return &queryLogger{config: config}
+}
+
+func (l *queryLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	l.logger(ctx).Info(ctx, msg, data...)
+}
+
+func (l *queryLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	l.logger(ctx).Warn(ctx, msg, data...)
+}
+
+func (l *queryLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	l.logger(ctx).Error(ctx, msg, data...)
+}
+
+func (l *queryLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	l.logger(ctx).Trace(ctx, begin, fc, err)
+}
+
+func (l *queryLogger) logger(ctx context.Context) gormlogger.Interface {
+	return gormlogger.NewSlogLogger(logging.FromContext(ctx), l.config)
+}
@@ -0,0 +1,107 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/infraestructure/ratelimit"
+	"sync"
+	"time"
+)
+
+// rateLimitPruneInterval is how often a replica deletes the buckets refilled since.
+const rateLimitPruneInterval = time.Minute
+
+// rateLimitBucketRow is a row of rate_limit_buckets, FullAt is when the bucket is refilled
+// and the row can be deleted.
+type rateLimitBucketRow struct {
+	BucketKey string `gorm:"primaryKey"`
+	Tokens    float64
+	UpdatedAt time.Time `gorm:"autoUpdateTime:false"`
+	FullAt    time.Time
+}
+
+func (rateLimitBucketRow) TableName() string {
+	return "rate_limit_buckets"
+}
+
+// RateLimitStore keeps the buckets in MySQL so the replicas share the limits, see
+// ratelimit.Store. The bucket row is locked while a token is taken. Every request takes a
+// token, db is the small pool of Repository.RateLimitDB so the buckets never hold the
+// connections of the services: a request waits for the pool instead.
+type RateLimitStore struct {
+	db *gorm.DB
+
+	mu       sync.Mutex
+	prunedAt time.Time
+}
+
+func NewRateLimitStore(db *gorm.DB) *RateLimitStore {
+	return &RateLimitStore{db: db}
+}
+
+func (s *RateLimitStore) Take(ctx context.Context, key string, limit ratelimit.Limit, now time.Time) (ratelimit.Result, error) {
+	var result ratelimit.Result
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// a missing bucket is full, the row is created first so it can be locked
+		err := tx.
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&rateLimitBucketRow{BucketKey: key, Tokens: limit.Capacity(), UpdatedAt: now, FullAt: now}).
+			Error
+		if err != nil {
+			return err
+		}
+
+		var row rateLimitBucketRow
+		err = tx.
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("bucket_key = ?", key).
+			First(&row).
+			Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("the rate limit bucket is missing, see db/init/init.sql")
+		}
+		if err != nil {
+			return err
+		}
+
+		var bucket ratelimit.Bucket
+		bucket, result = ratelimit.Bucket{Tokens: row.Tokens, UpdatedAt: row.UpdatedAt}.Take(limit, now)
+
+		return tx.
+			Model(&rateLimitBucketRow{}).
+			Where("bucket_key = ?", key).
+			Updates(map[string]any{
+				"tokens":     bucket.Tokens,
+				"updated_at": bucket.UpdatedAt,
+				"full_at":    now.Add(result.ResetAfter),
+			}).
+			Error
+	})
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+
+	s.prune(ctx, now)
+	return result, nil
+}
+
+// prune deletes the full buckets once per rateLimitPruneInterval, a full bucket is the same
+// as a missing one.
+func (s *RateLimitStore) prune(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	if now.Sub(s.prunedAt) < rateLimitPruneInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.prunedAt = now
+	s.mu.Unlock()
+
+	// a failed prune is retried by the next one, it does not fail the request
+	_ = s.db.
+		WithContext(ctx).
+		Where("full_at <= ?", now).
+		Delete(&rateLimitBucketRow{}).
+		Error
+}
@@ -0,0 +1,33 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// ReplaceProduct overwrites every mutable column, unlike UpdateProduct zero values
+// (stock 0, empty reorder point, etc) are written too.
+func (r *Repository) ReplaceProduct(ctx context.Context, product *domain.Product) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&domain.Product{}).
+		Where("id = ?", product.ID).
+		Select("name", "description", "price", "stock", "reorder_point", "reorder_quantity", "external_sku").
+		Updates(product)
+
+	if isDuplicateKey(result.Error) {
+		return domain.ErrProductAlreadyExists
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+
+	logging.FromContext(ctx).Info("product replaced", "product_id", product.ID)
+	return nil
+}
@@ -5,17 +5,42 @@ import (
 	"fmt"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
-	"log"
+	"log/slog"
 	"microservice-products-catalog/cmd/http/config"
 	"time"
 )
 
 type Repository struct {
 	db *gorm.DB
+	// rateLimitDB is the pool of the rate limit buckets, nil unless RATE_LIMIT_STORE is mysql,
+	// see RateLimitStore
+	rateLimitDB *gorm.DB
 }
 
-func NewRepository(cfg config.Config) (*Repository, error) {
+func NewRepository(cfg config.Config, logger *slog.Logger) (*Repository, error) {
+	db, err := open(cfg, cfg.MySQL.MaxOpenConnection, cfg.MySQL.MaxIdleConnection)
+	if err != nil {
+		return nil, err
+	}
 
+	repository := &Repository{db: db}
+	if cfg.RateLimit.Store == "mysql" {
+		// every request takes a token, on the service pool a burst of requests would wait
+		// for the connections of the orders
+		repository.rateLimitDB, err = open(cfg, cfg.RateLimit.MaxConnections, cfg.RateLimit.MaxConnections)
+		if err != nil {
+			repository.Close()
+			return nil, fmt.Errorf("rate limit pool: %w", err)
+		}
+	}
+
+	logger.Info("connected to mysql", "host", cfg.MySQL.Host, "database", cfg.MySQL.DBName)
+
+	return repository, nil
+}
+
+// open opens a pool of at most maxOpen connections to the database of cfg and pings it.
+func open(cfg config.Config, maxOpen int, maxIdle int) (*gorm.DB, error) {
 	dsn := fmt.Sprintf(
 		"%s:%s@tcp(%s:%d)/%s?parseTime=true&loc=Local",
 		cfg.MySQL.User,
@@ -27,6 +52,7 @@ func NewRepository(cfg config.Config) (*Repository, error) {
 
 	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
 		PrepareStmt: true,
+		Logger:      newQueryLogger(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("mysql connection failed: %w", err)
@@ -37,29 +63,38 @@ func NewRepository(cfg config.Config) (*Repository, error) {
 		return nil, err
 	}
 
-	sqlDB.SetMaxOpenConns(cfg.MySQL.MaxOpenConnection)
-	sqlDB.SetMaxIdleConns(cfg.MySQL.MaxIdleConnection)
-	sqlDB.SetConnMaxLifetime(5 * time.Minute)
+	sqlDB.SetMaxOpenConns(maxOpen)
+	sqlDB.SetMaxIdleConns(maxIdle)
+	sqlDB.SetConnMaxLifetime(cfg.MySQL.ConnMaxLifetime)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	if err := sqlDB.PingContext(ctx); err != nil {
+		_ = sqlDB.Close()
 		return nil, err
 	}
 
-	log.Println("Connected to MySQL")
-
-	return &Repository{db: db}, nil
+	return db, nil
 }
 
 func (r *Repository) DB() *gorm.DB {
 	return r.db
 }
 
+// RateLimitDB is the pool of the rate limit buckets, nil unless RATE_LIMIT_STORE is mysql.
+func (r *Repository) RateLimitDB() *gorm.DB {
+	return r.rateLimitDB
+}
+
 func (r *Repository) Close() {
-	sqlDB, err := r.db.DB()
-	if err == nil {
-		_ = sqlDB.Close()
+	for _, db := range []*gorm.DB{r.db, r.rateLimitDB} {
+		if db == nil {
+			continue
+		}
+		sqlDB, err := db.DB()
+		if err == nil {
+			_ = sqlDB.Close()
+		}
 	}
 }
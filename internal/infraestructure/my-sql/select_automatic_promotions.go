@@ -0,0 +1,36 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"time"
+)
+
+// GetAutomaticPromotions returns the active promotions without coupon code that apply to the
+// product at the given time, in a stable order so the discounts stack the same way every time.
+func (r *Repository) GetAutomaticPromotions(ctx context.Context, productID string, at time.Time) ([]domain.Promotion, error) {
+
+	var rows []promotionRow
+
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	err := db.
+		WithContext(ctx).
+		Where("coupon_code IS NULL AND active = ?", true).
+		Where("product_id IS NULL OR product_id = ?", productID).
+		Where("starts_at IS NULL OR starts_at <= ?", at).
+		Where("ends_at IS NULL OR ends_at > ?", at).
+		Order("name").
+		Order("id").
+		Find(&rows).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+	return promotionsFromRows(rows), nil
+}
@@ -0,0 +1,46 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetCartByID loads the cart with its items in the order they were added, inside a
+// transaction the cart row stays locked so its items cannot change until it commits.
+func (r *Repository) GetCartByID(ctx context.Context, id string) (*domain.Cart, error) {
+
+	db := r.db
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var cart domain.Cart
+
+	err := db.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&cart).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrCartNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.
+		WithContext(ctx).
+		Where("cart_id = ?", id).
+		Order("added_at, id").
+		Find(&cart.Items).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return &cart, nil
+}
@@ -0,0 +1,28 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (r *Repository) GetCategories(ctx context.Context) ([]domain.Category, error) {
+
+	var categories []domain.Category
+
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	err := db.
+		WithContext(ctx).
+		Order("name").
+		Find(&categories).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
@@ -0,0 +1,32 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (r *Repository) GetCategoryByID(ctx context.Context, id string) (*domain.Category, error) {
+
+	db := r.db
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var category domain.Category
+
+	err := db.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&category).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrCategoryNotFound
+	}
+
+	return &category, err
+}
@@ -0,0 +1,32 @@
+package my_sql
+
+import (
+	"context"
+)
+
+const categoryDescendantsCTE = `WITH RECURSIVE category_tree (id) AS (
+	SELECT id FROM categories WHERE id = ?
+	UNION ALL
+	SELECT c.id FROM categories c JOIN category_tree t ON c.parent_id = t.id
+)`
+
+// GetCategoryDescendantIDs returns the ID of the category followed by the IDs of all
+// of its descendants.
+func (r *Repository) GetCategoryDescendantIDs(ctx context.Context, id string) ([]string, error) {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var ids []string
+	err := db.WithContext(ctx).
+		Raw(categoryDescendantsCTE+" SELECT id FROM category_tree", id).
+		Scan(&ids).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
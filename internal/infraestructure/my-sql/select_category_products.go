@@ -0,0 +1,40 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (r *Repository) GetCategoryProducts(ctx context.Context, id string, includeDescendants bool, limit int) ([]domain.Product, error) {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	query := `SELECT DISTINCT p.* FROM products p
+	JOIN product_categories pc ON pc.product_id = p.id
+	WHERE pc.category_id = ?
+	ORDER BY p.name LIMIT ?`
+	if includeDescendants {
+		query = categoryDescendantsCTE + ` SELECT DISTINCT p.* FROM products p
+	JOIN product_categories pc ON pc.product_id = p.id
+	JOIN category_tree t ON t.id = pc.category_id
+	ORDER BY p.name LIMIT ?`
+	}
+
+	var products []domain.Product
+	err := db.WithContext(ctx).
+		Raw(query, id, limit).
+		Scan(&products).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := loadProductDetails(ctx, db, products); err != nil {
+		return nil, err
+	}
+	return products, nil
+}
@@ -0,0 +1,34 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (r *Repository) GetCustomerByID(ctx context.Context, id string) (*domain.Customer, error) {
+
+	db := r.db
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var customer domain.Customer
+
+	err := db.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&customer).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrCustomerNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &customer, nil
+}
@@ -0,0 +1,29 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (r *Repository) GetCustomers(ctx context.Context, limit int) ([]domain.Customer, error) {
+
+	var customers []domain.Customer
+
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	err := db.
+		WithContext(ctx).
+		Order("email").
+		Limit(limit).
+		Find(&customers).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+	return customers, nil
+}
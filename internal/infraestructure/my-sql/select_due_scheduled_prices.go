@@ -0,0 +1,36 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"time"
+)
+
+// GetDueScheduledPrices returns up to limit scheduled prices to apply or to end at the given
+// time, the oldest due first. The rows are not locked, every one is locked and checked again
+// when it is processed.
+func (r *Repository) GetDueScheduledPrices(ctx context.Context, at time.Time, limit int) ([]domain.ScheduledPrice, error) {
+
+	var scheduledPrices []domain.ScheduledPrice
+
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	err := db.
+		WithContext(ctx).
+		Where("(status = ? AND effective_at <= ?) OR (status = ? AND ends_at <= ?)",
+			domain.ScheduledPricePending, at, domain.ScheduledPriceActive, at).
+		Order("IF(status = 'active', ends_at, effective_at)").
+		Order("id").
+		Limit(limit).
+		Find(&scheduledPrices).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+	return scheduledPrices, nil
+}
@@ -0,0 +1,30 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (r *Repository) GetOpenStockAlert(ctx context.Context, productID string) (*domain.StockAlert, error) {
+
+	db := r.db
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var alert domain.StockAlert
+
+	err := db.
+		WithContext(ctx).
+		Where("product_id = ? AND resolved_at IS NULL", productID).
+		First(&alert).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrStockAlertNotFound
+	}
+
+	return &alert, err
+}
@@ -0,0 +1,41 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetOrderByID locks the order row, so the refunds of an order are serialized inside the transaction.
+func (r *Repository) GetOrderByID(ctx context.Context, id string) (*domain.Order, error) {
+
+	db := r.db
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var order domain.Order
+
+	err := db.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&order).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	withOrderCurrency(&order)
+	orders := []domain.Order{order}
+	if err := attachOrderDiscounts(ctx, db, orders); err != nil {
+		return nil, err
+	}
+	return &orders[0], nil
+}
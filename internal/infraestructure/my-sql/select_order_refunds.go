@@ -0,0 +1,32 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetOrderRefunds lists the refunds of the order, oldest first.
+func (r *Repository) GetOrderRefunds(ctx context.Context, orderID string) ([]domain.Refund, error) {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var rows []refundRow
+	err := db.
+		WithContext(ctx).
+		Where("order_id = ?", orderID).
+		Order("created_at, id").
+		Find(&rows).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	refunds := make([]domain.Refund, 0, len(rows))
+	for _, row := range rows {
+		refunds = append(refunds, row.refund())
+	}
+	return refunds, nil
+}
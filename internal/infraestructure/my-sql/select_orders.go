@@ -2,10 +2,11 @@ package my_sql
 
 import (
 	"context"
+	"gorm.io/gorm"
 	"microservice-products-catalog/internal/domain"
 )
 
-func (r *Repository) GetOrders(ctx context.Context) ([]domain.Order, error) {
+func (r *Repository) GetOrders(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, error) {
 
 	var orders []domain.Order
 
@@ -15,8 +16,7 @@ func (r *Repository) GetOrders(ctx context.Context) ([]domain.Order, error) {
 		db = tx
 	}
 
-	err := db.
-		WithContext(ctx).
+	err := applyOrderFilter(db.WithContext(ctx).Order("date"), filter).
 		Find(&orders).
 		Error
 
@@ -24,5 +24,83 @@ func (r *Repository) GetOrders(ctx context.Context) ([]domain.Order, error) {
 		return nil, err
 	}
 
+	for i := range orders {
+		withOrderCurrency(&orders[i])
+	}
+	if err := attachOrderDiscounts(ctx, db, orders); err != nil {
+		return nil, err
+	}
 	return orders, nil
 }
+
+// applyOrderFilter adds the conditions of the filter to an orders query.
+func applyOrderFilter(query *gorm.DB, filter domain.OrderFilter) *gorm.DB {
+	if filter.CustomerID != "" {
+		query = query.Where("customer_id = ?", filter.CustomerID)
+	}
+	if filter.ProductID != "" {
+		query = query.Where("product_id = ?", filter.ProductID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.From != nil {
+		query = query.Where("date >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("date < ?", *filter.To)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	return query
+}
+
+// attachOrderDiscounts loads the discounts breakdown of the orders with a single query.
+func attachOrderDiscounts(ctx context.Context, db *gorm.DB, orders []domain.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+	}
+
+	var rows []orderDiscountRow
+	err := db.
+		WithContext(ctx).
+		Where("order_id IN ?", ids).
+		Order("id").
+		Find(&rows).
+		Error
+	if err != nil {
+		return err
+	}
+
+	byOrder := make(map[string][]domain.OrderDiscount, len(orders))
+	for _, row := range rows {
+		byOrder[row.OrderID] = append(byOrder[row.OrderID], domain.OrderDiscount{
+			OrderID:     row.OrderID,
+			PromotionID: row.PromotionID,
+			Name:        row.Name,
+			CouponCode:  row.CouponCode,
+			Amount:      domain.NewMoney(row.Amount.Amount, row.Currency),
+		})
+	}
+	for i := range orders {
+		orders[i].Discounts = byOrder[orders[i].ID]
+		if orders[i].Discounts == nil {
+			orders[i].Discounts = []domain.OrderDiscount{}
+		}
+	}
+	return nil
+}
+
+// withOrderCurrency sets the currency column on the amounts, their columns only hold the amount.
+func withOrderCurrency(order *domain.Order) {
+	order.Subtotal = domain.NewMoney(order.Subtotal.Amount, order.Currency)
+	order.Tax = domain.NewMoney(order.Tax.Amount, order.Currency)
+	order.Total = domain.NewMoney(order.Total.Amount, order.Currency)
+	order.UnitPrice = domain.NewMoney(order.UnitPrice.Amount, order.Currency)
+}
@@ -0,0 +1,35 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"time"
+)
+
+// GetPendingRefunds lists at most limit refunds still pending that were created before
+// createdBefore, oldest first.
+func (r *Repository) GetPendingRefunds(ctx context.Context, createdBefore time.Time, limit int) ([]domain.Refund, error) {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var rows []refundRow
+	err := db.
+		WithContext(ctx).
+		Where("status = ? AND created_at < ?", domain.RefundPending, createdBefore).
+		Order("created_at, id").
+		Limit(limit).
+		Find(&rows).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	refunds := make([]domain.Refund, 0, len(rows))
+	for _, row := range rows {
+		refunds = append(refunds, row.refund())
+	}
+	return refunds, nil
+}
@@ -0,0 +1,43 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetPriceHistory returns the price changes of the product, the latest first.
+func (r *Repository) GetPriceHistory(ctx context.Context, productID string) ([]domain.PriceChange, error) {
+
+	var rows []priceHistoryRow
+
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	err := db.
+		WithContext(ctx).
+		Where("product_id = ?", productID).
+		Order("changed_at DESC").
+		Order("id DESC").
+		Find(&rows).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]domain.PriceChange, 0, len(rows))
+	for _, row := range rows {
+		changes = append(changes, domain.PriceChange{
+			ProductID:        row.ProductID,
+			OldPrice:         row.OldPrice,
+			NewPrice:         row.NewPrice,
+			Source:           row.Source,
+			ScheduledPriceID: row.ScheduledPriceID,
+			ChangedAt:        row.ChangedAt,
+		})
+	}
+	return changes, nil
+}
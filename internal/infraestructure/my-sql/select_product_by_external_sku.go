@@ -0,0 +1,32 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (r *Repository) GetProductByExternalSKU(ctx context.Context, externalSKU string) (*domain.Product, error) {
+
+	db := r.db
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var product domain.Product
+
+	err := db.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("external_sku = ?", externalSKU).
+		First(&product).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrProductNotFound
+	}
+
+	return &product, err
+}
@@ -27,6 +27,13 @@ func (r *Repository) GetProductByID(ctx context.Context, id string) (*domain.Pro
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, domain.ErrProductNotFound
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	return &product, err
+	products := []domain.Product{product}
+	if err := loadProductDetails(ctx, db, products); err != nil {
+		return nil, err
+	}
+	return &products[0], nil
 }
@@ -0,0 +1,114 @@
+package my_sql
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"microservice-products-catalog/internal/domain"
+)
+
+// productPrice is a row of product_prices, a list price of a product in a currency.
+type productPrice struct {
+	ProductID string `gorm:"primaryKey"`
+	Currency  string `gorm:"primaryKey"`
+	Amount    domain.Money
+}
+
+func (productPrice) TableName() string {
+	return "product_prices"
+}
+
+// loadProductDetails loads the list prices, the variants and the category ids of the
+// products, with one query each, products without them get empty lists.
+func loadProductDetails(ctx context.Context, db *gorm.DB, products []domain.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(products))
+	for _, product := range products {
+		ids = append(ids, product.ID)
+	}
+
+	if err := attachPrices(ctx, db, products, ids); err != nil {
+		return err
+	}
+	if err := attachVariants(ctx, db, products, ids); err != nil {
+		return err
+	}
+	return attachCategoryIDs(ctx, db, products, ids)
+}
+
+func attachPrices(ctx context.Context, db *gorm.DB, products []domain.Product, ids []string) error {
+	var prices []productPrice
+	err := db.
+		WithContext(ctx).
+		Where("product_id IN ?", ids).
+		Order("currency").
+		Find(&prices).
+		Error
+	if err != nil {
+		return err
+	}
+
+	byProduct := make(map[string][]domain.Money, len(products))
+	for _, price := range prices {
+		byProduct[price.ProductID] = append(byProduct[price.ProductID], domain.NewMoney(price.Amount.Amount, price.Currency))
+	}
+	for i := range products {
+		products[i].Prices = byProduct[products[i].ID]
+		if products[i].Prices == nil {
+			products[i].Prices = []domain.Money{}
+		}
+	}
+	return nil
+}
+
+func attachVariants(ctx context.Context, db *gorm.DB, products []domain.Product, ids []string) error {
+	var variants []domain.Variant
+	err := db.
+		WithContext(ctx).
+		Where("product_id IN ?", ids).
+		Order("sku").
+		Find(&variants).
+		Error
+	if err != nil {
+		return err
+	}
+
+	byProduct := make(map[string][]domain.Variant, len(products))
+	for _, variant := range variants {
+		byProduct[variant.ProductID] = append(byProduct[variant.ProductID], variant)
+	}
+	for i := range products {
+		products[i].Variants = byProduct[products[i].ID]
+		if products[i].Variants == nil {
+			products[i].Variants = []domain.Variant{}
+		}
+	}
+	return nil
+}
+
+func attachCategoryIDs(ctx context.Context, db *gorm.DB, products []domain.Product, ids []string) error {
+	var links []productCategory
+	err := db.
+		WithContext(ctx).
+		Where("product_id IN ?", ids).
+		Order("category_id").
+		Find(&links).
+		Error
+	if err != nil {
+		return err
+	}
+
+	byProduct := make(map[string][]string, len(products))
+	for _, link := range links {
+		byProduct[link.ProductID] = append(byProduct[link.ProductID], link.CategoryID)
+	}
+	for i := range products {
+		products[i].CategoryIDs = byProduct[products[i].ID]
+		if products[i].CategoryIDs == nil {
+			products[i].CategoryIDs = []string{}
+		}
+	}
+	return nil
+}
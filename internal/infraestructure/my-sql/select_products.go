@@ -24,5 +24,9 @@ func (r *Repository) GetProducts(ctx context.Context, limit int) ([]domain.Produ
 	if err != nil {
 		return nil, err
 	}
+
+	if err := loadProductDetails(ctx, db, products); err != nil {
+		return nil, err
+	}
 	return products, nil
 }
@@ -0,0 +1,38 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetPromotionByCouponCode locks the coupon row, concurrent orders redeeming the same
+// coupon wait for the transaction holding it.
+func (r *Repository) GetPromotionByCouponCode(ctx context.Context, code string) (*domain.Promotion, error) {
+
+	db := r.db
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var row promotionRow
+
+	err := db.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("coupon_code = ?", code).
+		First(&row).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrCouponNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	promotion := row.promotion()
+	return &promotion, nil
+}
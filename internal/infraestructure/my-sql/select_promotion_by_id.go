@@ -0,0 +1,36 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (r *Repository) GetPromotionByID(ctx context.Context, id string) (*domain.Promotion, error) {
+
+	db := r.db
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var row promotionRow
+
+	err := db.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&row).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrPromotionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	promotion := row.promotion()
+	return &promotion, nil
+}
@@ -0,0 +1,36 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (r *Repository) GetPromotions(ctx context.Context) ([]domain.Promotion, error) {
+
+	var rows []promotionRow
+
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	err := db.
+		WithContext(ctx).
+		Order("name").
+		Find(&rows).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+	return promotionsFromRows(rows), nil
+}
+
+func promotionsFromRows(rows []promotionRow) []domain.Promotion {
+	promotions := make([]domain.Promotion, 0, len(rows))
+	for _, row := range rows {
+		promotions = append(promotions, row.promotion())
+	}
+	return promotions
+}
@@ -0,0 +1,36 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetScheduledPriceByID locks the row, the scheduler of another replica waits here and then
+// sees the status already moved forward.
+func (r *Repository) GetScheduledPriceByID(ctx context.Context, id string) (*domain.ScheduledPrice, error) {
+
+	db := r.db
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var scheduledPrice domain.ScheduledPrice
+
+	err := db.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&scheduledPrice).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrScheduledPriceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &scheduledPrice, nil
+}
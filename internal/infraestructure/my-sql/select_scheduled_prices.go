@@ -0,0 +1,31 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetScheduledPrices returns the scheduled prices of the product in the order they take effect.
+func (r *Repository) GetScheduledPrices(ctx context.Context, productID string) ([]domain.ScheduledPrice, error) {
+
+	var scheduledPrices []domain.ScheduledPrice
+
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	err := db.
+		WithContext(ctx).
+		Where("product_id = ?", productID).
+		Order("effective_at").
+		Order("id").
+		Find(&scheduledPrices).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+	return scheduledPrices, nil
+}
@@ -0,0 +1,27 @@
+package my_sql
+
+import (
+	"context"
+)
+
+// SchemaVersion is the version of db/init/init.sql the repository is written for, both are
+// bumped with every schema change.
+const SchemaVersion = 4
+
+// GetSchemaVersion returns the latest version applied to the database, 0 when none was.
+func (r *Repository) GetSchemaVersion(ctx context.Context) (int, error) {
+	var version *int
+	err := r.db.
+		WithContext(ctx).
+		Table("schema_version").
+		Select("MAX(version)").
+		Scan(&version).
+		Error
+	if err != nil {
+		return 0, err
+	}
+	if version == nil {
+		return 0, nil
+	}
+	return *version, nil
+}
@@ -0,0 +1,32 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (r *Repository) GetStockAlerts(ctx context.Context, onlyOpen bool) ([]domain.StockAlert, error) {
+
+	var alerts []domain.StockAlert
+
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	query := db.WithContext(ctx)
+	if onlyOpen {
+		query = query.Where("resolved_at IS NULL")
+	}
+
+	err := query.
+		Order("created_at DESC").
+		Find(&alerts).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
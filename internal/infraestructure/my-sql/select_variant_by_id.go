@@ -0,0 +1,32 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (r *Repository) GetVariantByID(ctx context.Context, id string) (*domain.Variant, error) {
+
+	db := r.db
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	var variant domain.Variant
+
+	err := db.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}). // the stock is decremented by the orders inside the same transaction
+		Where("id = ?", id).
+		First(&variant).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrVariantNotFound
+	}
+
+	return &variant, err
+}
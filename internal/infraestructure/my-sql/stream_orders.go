@@ -0,0 +1,39 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// StreamOrders reads the orders through a cursor, only one row is held at a time.
+func (r *Repository) StreamOrders(ctx context.Context, filter domain.OrderFilter, fn func(order domain.Order) error) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	query := applyOrderFilter(db.
+		WithContext(ctx).
+		Model(&domain.Order{}).
+		Order("date"), filter)
+
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var order domain.Order
+		if err := db.ScanRows(rows, &order); err != nil {
+			return err
+		}
+		withOrderCurrency(&order)
+		if err := fn(order); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,42 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// StreamProducts reads the products through a cursor, only one row is held at a time.
+func (r *Repository) StreamProducts(ctx context.Context, filter domain.ProductFilter, fn func(product domain.Product) error) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	query := db.
+		WithContext(ctx).
+		Model(&domain.Product{}).
+		Order("name")
+
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var product domain.Product
+		if err := db.ScanRows(rows, &product); err != nil {
+			return err
+		}
+		if err := fn(product); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
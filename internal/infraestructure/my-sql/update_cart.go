@@ -0,0 +1,31 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// UpdateCart writes the status and the expiry of the cart, the items have their own methods.
+func (r *Repository) UpdateCart(ctx context.Context, cart *domain.Cart) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&domain.Cart{}).
+		Where("id = ?", cart.ID).
+		Select("status", "expires_at", "updated_at").
+		Updates(cart)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrCartNotFound
+	}
+
+	logging.FromContext(ctx).Info("cart updated", "cart_id", cart.ID)
+	return nil
+}
@@ -0,0 +1,30 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) UpdateCartItem(ctx context.Context, item *domain.CartItem) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&domain.CartItem{}).
+		Where("id = ? AND cart_id = ?", item.ID, item.CartID).
+		Select("quantity").
+		Updates(item)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrCartItemNotFound
+	}
+
+	logging.FromContext(ctx).Info("cart item updated", "cart_item_id", item.ID)
+	return nil
+}
@@ -0,0 +1,28 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// UpdateCategory writes every mutable column, so a nil ParentID moves the category to the root.
+func (r *Repository) UpdateCategory(ctx context.Context, category *domain.Category) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	if err := db.WithContext(ctx).
+		Model(&domain.Category{}).
+		Where("id = ?", category.ID).
+		Select("name", "description", "parent_id").
+		Updates(category).
+		Error; err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("category updated", "category_id", category.ID)
+	return nil
+}
@@ -0,0 +1,31 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+func (r *Repository) UpdateCustomer(ctx context.Context, customer *domain.Customer) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	err := db.WithContext(ctx).
+		Model(&domain.Customer{}).
+		Where("id = ?", customer.ID).
+		Select("email", "name").
+		Updates(customer).
+		Error
+	if isDuplicateKey(err) {
+		return domain.ErrCustomerAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("customer updated", "customer_id", customer.ID)
+	return nil
+}
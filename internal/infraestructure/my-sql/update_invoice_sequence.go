@@ -0,0 +1,55 @@
+package my_sql
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const orderInvoiceSequence = "orders"
+
+// invoiceSequenceRow is a row of invoice_sequences, NextValue is the next number to allocate.
+type invoiceSequenceRow struct {
+	Name      string `gorm:"primaryKey"`
+	NextValue int64
+}
+
+func (invoiceSequenceRow) TableName() string {
+	return "invoice_sequences"
+}
+
+// NextInvoiceNumber allocates the next invoice number, it must run inside the transaction
+// that confirms the order: the sequence row stays locked until it commits and a rollback gives
+// the number back, so the invoices have no gaps.
+func (r *Repository) NextInvoiceNumber(ctx context.Context) (int64, error) {
+	tx, ok := GetTx(ctx)
+	if !ok {
+		return 0, errors.New("invoice numbers are allocated inside a transaction")
+	}
+
+	var row invoiceSequenceRow
+	err := tx.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("name = ?", orderInvoiceSequence).
+		First(&row).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, errors.New("the orders invoice sequence is missing, see db/init/init.sql")
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.
+		WithContext(ctx).
+		Model(&invoiceSequenceRow{}).
+		Where("name = ?", orderInvoiceSequence).
+		UpdateColumn("next_value", row.NextValue+1).
+		Error
+	if err != nil {
+		return 0, err
+	}
+	return row.NextValue, nil
+}
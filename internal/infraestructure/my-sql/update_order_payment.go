@@ -0,0 +1,34 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// UpdateOrderPayment moves a pending order to its status, payment reference and invoice
+// number. The update is
+// conditional so an order already confirmed or compensated is never overwritten, it returns
+// domain.ErrOrderNotPending when the order is no longer pending.
+func (r *Repository) UpdateOrderPayment(ctx context.Context, order domain.Order) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&domain.Order{}).
+		Where("id = ? AND status = ?", order.ID, domain.OrderPending).
+		Select("status", "payment_id", "invoice_number").
+		Updates(&order)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrOrderNotPending
+	}
+
+	logging.FromContext(ctx).Info("order payment updated", "order_id", order.ID, "status", order.Status)
+	return nil
+}
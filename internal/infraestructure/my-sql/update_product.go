@@ -2,8 +2,8 @@ package my_sql
 
 import (
 	"context"
-	"fmt"
 	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
 )
 
 func (r *Repository) UpdateProduct(ctx context.Context, product *domain.Product) error {
@@ -21,6 +21,6 @@ func (r *Repository) UpdateProduct(ctx context.Context, product *domain.Product)
 		return err
 	}
 
-	fmt.Printf("[LOG] - Product with ID : %s updated correctly\n", product.ID)
+	logging.FromContext(ctx).Info("product updated", "product_id", product.ID)
 	return nil
 }
@@ -0,0 +1,29 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// UpdatePromotion writes the mutable columns, the redemption count is only changed by RedeemPromotion.
+func (r *Repository) UpdatePromotion(ctx context.Context, promotion *domain.Promotion) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	row := newPromotionRow(*promotion)
+	if err := db.WithContext(ctx).
+		Model(&promotionRow{}).
+		Where("id = ?", promotion.ID).
+		Select("name", "percentage", "amount", "currency", "buy_quantity", "get_quantity", "usage_limit", "starts_at", "ends_at", "active").
+		Updates(&row).
+		Error; err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("promotion updated", "promotion_id", promotion.ID)
+	return nil
+}
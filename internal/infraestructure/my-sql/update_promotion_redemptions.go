@@ -0,0 +1,45 @@
+package my_sql
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"microservice-products-catalog/internal/domain"
+)
+
+// RedeemPromotion counts a redemption only while the usage limit is not reached, the check and
+// the increment are a single statement so concurrent redemptions cannot exceed the limit.
+func (r *Repository) RedeemPromotion(ctx context.Context, id string) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&promotionRow{}).
+		Where("id = ? AND (usage_limit IS NULL OR redemption_count < usage_limit)", id).
+		UpdateColumn("redemption_count", gorm.Expr("redemption_count + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrCouponUsageLimitReached
+	}
+	return nil
+}
+
+// ReleasePromotion gives back a redemption of an order that was compensated.
+func (r *Repository) ReleasePromotion(ctx context.Context, id string) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	return db.WithContext(ctx).
+		Model(&promotionRow{}).
+		Where("id = ? AND redemption_count > 0", id).
+		UpdateColumn("redemption_count", gorm.Expr("redemption_count - 1")).
+		Error
+}
@@ -0,0 +1,31 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// UpdateRefundStatus moves a pending refund to its status, it returns domain.ErrRefundNotPending
+// when the refund is no longer pending.
+func (r *Repository) UpdateRefundStatus(ctx context.Context, refund domain.Refund) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&refundRow{}).
+		Where("id = ? AND status = ?", refund.ID, domain.RefundPending).
+		Update("status", string(refund.Status))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrRefundNotPending
+	}
+
+	logging.FromContext(ctx).Info("refund status updated", "refund_id", refund.ID, "status", refund.Status)
+	return nil
+}
@@ -0,0 +1,28 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// UpdateScheduledPrice writes the progress of a scheduled price, the terms are never updated.
+func (r *Repository) UpdateScheduledPrice(ctx context.Context, scheduledPrice *domain.ScheduledPrice) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	if err := db.WithContext(ctx).
+		Model(&domain.ScheduledPrice{}).
+		Where("id = ?", scheduledPrice.ID).
+		Select("status", "previous_price", "applied_at", "ended_at").
+		Updates(scheduledPrice).
+		Error; err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("scheduled price updated", "scheduled_price_id", scheduledPrice.ID)
+	return nil
+}
@@ -0,0 +1,30 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"time"
+)
+
+func (r *Repository) ResolveStockAlerts(ctx context.Context, productID string, resolvedAt time.Time) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	result := db.WithContext(ctx).
+		Model(&domain.StockAlert{}).
+		Where("product_id = ? AND resolved_at IS NULL", productID).
+		Update("resolved_at", resolvedAt)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		logging.FromContext(ctx).Info("stock alerts resolved", "product_id", productID)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package my_sql
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// UpdateVariant writes every mutable column, so a nil Price removes the price override.
+func (r *Repository) UpdateVariant(ctx context.Context, variant *domain.Variant) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	err := db.WithContext(ctx).
+		Model(&domain.Variant{}).
+		Where("id = ?", variant.ID).
+		Select("sku", "options", "price", "stock").
+		Updates(variant).
+		Error
+	if isDuplicateKey(err) {
+		return domain.ErrVariantSKUAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("variant updated", "variant_id", variant.ID)
+	return nil
+}
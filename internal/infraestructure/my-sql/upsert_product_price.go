@@ -0,0 +1,29 @@
+package my_sql
+
+import (
+	"context"
+	"gorm.io/gorm/clause"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// SetProductPrice creates or replaces the list price of the product in the currency of price.
+func (r *Repository) SetProductPrice(ctx context.Context, productID string, price domain.Money) error {
+	db := r.db
+
+	if tx, ok := GetTx(ctx); ok {
+		db = tx
+	}
+
+	row := productPrice{ProductID: productID, Currency: price.Currency, Amount: price}
+	err := db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns([]string{"amount"})}).
+		Create(&row).
+		Error
+	if err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("product price saved", "product_id", productID, "currency", price.Currency)
+	return nil
+}
@@ -0,0 +1,26 @@
+package notifier
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// LogNotifier writes the alerts to the service output, it is the default when no
+// webhook is configured.
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, alert domain.StockAlert) error {
+	logging.FromContext(ctx).Warn(
+		"product reached its reorder point",
+		"product_id", alert.ProductID,
+		"stock", alert.Stock,
+		"reorder_point", alert.ReorderPoint,
+		"reorder_quantity", alert.ReorderQuantity,
+	)
+	return nil
+}
@@ -0,0 +1,164 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+	"sync"
+)
+
+// FakeMode is the outcome of every authorisation of a FakeGateway.
+type FakeMode string
+
+const (
+	FakeSucceed FakeMode = "succeed"
+	FakeDecline FakeMode = "decline"
+	FakeTimeout FakeMode = "timeout"
+)
+
+type fakeStatus string
+
+const (
+	fakeAuthorized fakeStatus = "authorized"
+	fakeCaptured   fakeStatus = "captured"
+	fakeVoided     fakeStatus = "voided"
+)
+
+// FakeGateway is an in-memory gateway for local runs and tests. It is deterministic: the
+// authorisations are numbered in order and their outcome only depends on the mode, a
+// timeout is reported at once instead of waiting. It keeps the state of every payment so
+// the invalid transitions fail as they would on a real gateway.
+type FakeGateway struct {
+	mode FakeMode
+
+	mu       sync.Mutex
+	sequence int
+	payments map[string]*fakePayment
+	byOrder  map[string]string
+	refunds  map[string]domain.Money
+}
+
+type fakePayment struct {
+	authorized domain.Money
+	captured   domain.Money
+	refunded   domain.Money
+	status     fakeStatus
+}
+
+func NewFakeGateway(mode FakeMode) (*FakeGateway, error) {
+	switch mode {
+	case FakeSucceed, FakeDecline, FakeTimeout:
+	default:
+		return nil, fmt.Errorf("unknown fake payment mode %q", mode)
+	}
+	return &FakeGateway{
+		mode:     mode,
+		payments: map[string]*fakePayment{},
+		byOrder:  map[string]string{},
+		refunds:  map[string]domain.Money{},
+	}, nil
+}
+
+// Authorize returns the authorisation already given to the order when it is retried.
+func (g *FakeGateway) Authorize(ctx context.Context, request domain.PaymentRequest) (domain.PaymentAuthorization, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	switch g.mode {
+	case FakeDecline:
+		return domain.PaymentAuthorization{}, domain.ErrPaymentDeclined
+	case FakeTimeout:
+		return domain.PaymentAuthorization{}, domain.ErrPaymentTimeout
+	}
+
+	if id, ok := g.byOrder[request.OrderID]; ok {
+		return domain.PaymentAuthorization{ID: id, Amount: g.payments[id].authorized}, nil
+	}
+
+	g.sequence++
+	id := fmt.Sprintf("fake_auth_%06d", g.sequence)
+	g.payments[id] = &fakePayment{
+		authorized: request.Amount,
+		captured:   domain.NewMoney(0, request.Amount.Currency),
+		refunded:   domain.NewMoney(0, request.Amount.Currency),
+		status:     fakeAuthorized,
+	}
+	g.byOrder[request.OrderID] = id
+	return domain.PaymentAuthorization{ID: id, Amount: request.Amount}, nil
+}
+
+// Capture takes up to the authorised amount, an authorisation is captured once.
+func (g *FakeGateway) Capture(ctx context.Context, authorizationID string, amount domain.Money) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	payment, ok := g.payments[authorizationID]
+	if !ok {
+		return domain.ErrPaymentNotFound
+	}
+	if payment.status != fakeAuthorized || !fits(amount, payment.authorized) {
+		return domain.ErrInvalidPaymentState
+	}
+	payment.captured = amount
+	payment.status = fakeCaptured
+	return nil
+}
+
+func (g *FakeGateway) Void(ctx context.Context, authorizationID string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	payment, ok := g.payments[authorizationID]
+	if !ok {
+		return domain.ErrPaymentNotFound
+	}
+	if payment.status != fakeAuthorized {
+		return domain.ErrInvalidPaymentState
+	}
+	payment.status = fakeVoided
+	return nil
+}
+
+// Refund gives back part of the captured amount, the refunds never exceed it. A refund retried
+// with the same idempotency key is not given back twice, with another amount it fails.
+func (g *FakeGateway) Refund(ctx context.Context, authorizationID string, amount domain.Money, idempotencyKey string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if refunded, ok := g.refunds[idempotencyKey]; ok {
+		if refunded != amount {
+			return domain.ErrInvalidPaymentState
+		}
+		return nil
+	}
+
+	payment, ok := g.payments[authorizationID]
+	if !ok {
+		return domain.ErrPaymentNotFound
+	}
+	if payment.status != fakeCaptured || amount.Amount <= 0 || amount.Currency != payment.captured.Currency ||
+		!fits(payment.refunded.Add(amount), payment.captured) {
+		return domain.ErrInvalidPaymentState
+	}
+	payment.refunded = payment.refunded.Add(amount)
+	g.refunds[idempotencyKey] = amount
+	return nil
+}
+
+// Refunded returns the amount refunded of a captured payment.
+func (g *FakeGateway) Refunded(authorizationID string) (domain.Money, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	payment, ok := g.payments[authorizationID]
+	if !ok {
+		return domain.Money{}, false
+	}
+	return payment.refunded, true
+}
+
+// fits reports whether amount is at most limit, in the same currency. An order fully paid by
+// its discounts captures a zero amount.
+func fits(amount domain.Money, limit domain.Money) bool {
+	return amount.Currency == limit.Currency && !amount.IsNegative() && amount.Amount <= limit.Amount
+}
@@ -0,0 +1,72 @@
+package payment
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/internal/domain"
+	"testing"
+)
+
+func TestFakeGateway_Succeed(t *testing.T) {
+	ctx := context.Background()
+	gateway, err := NewFakeGateway(FakeSucceed)
+	require.NoError(t, err)
+
+	amount := domain.NewMoney(2500, domain.BaseCurrency)
+	first, err := gateway.Authorize(ctx, domain.PaymentRequest{OrderID: "order-1", Amount: amount})
+	require.NoError(t, err)
+	second, err := gateway.Authorize(ctx, domain.PaymentRequest{OrderID: "order-2", Amount: amount})
+	require.NoError(t, err)
+	retried, err := gateway.Authorize(ctx, domain.PaymentRequest{OrderID: "order-1", Amount: amount})
+	require.NoError(t, err)
+
+	assert.Equal(t, "fake_auth_000001", first.ID)
+	assert.Equal(t, "fake_auth_000002", second.ID)
+	assert.Equal(t, first, retried)
+
+	// captured payments can be refunded up to the captured amount, voided ones cannot be captured
+	assert.ErrorIs(t, gateway.Capture(ctx, first.ID, domain.NewMoney(3000, domain.BaseCurrency)), domain.ErrInvalidPaymentState)
+	assert.NoError(t, gateway.Capture(ctx, first.ID, amount))
+	assert.ErrorIs(t, gateway.Void(ctx, first.ID), domain.ErrInvalidPaymentState)
+	assert.NoError(t, gateway.Refund(ctx, first.ID, domain.NewMoney(1000, domain.BaseCurrency), "refund-1"))
+	assert.ErrorIs(t, gateway.Refund(ctx, first.ID, domain.NewMoney(1501, domain.BaseCurrency), "refund-2"), domain.ErrInvalidPaymentState)
+	assert.NoError(t, gateway.Refund(ctx, first.ID, domain.NewMoney(1500, domain.BaseCurrency), "refund-3"))
+
+	// a retried refund is not given back twice
+	assert.NoError(t, gateway.Refund(ctx, first.ID, domain.NewMoney(1000, domain.BaseCurrency), "refund-1"))
+	assert.ErrorIs(t, gateway.Refund(ctx, first.ID, domain.NewMoney(500, domain.BaseCurrency), "refund-1"), domain.ErrInvalidPaymentState)
+
+	refunded, ok := gateway.Refunded(first.ID)
+	assert.True(t, ok)
+	assert.Equal(t, amount, refunded)
+
+	assert.NoError(t, gateway.Void(ctx, second.ID))
+	assert.ErrorIs(t, gateway.Capture(ctx, second.ID, amount), domain.ErrInvalidPaymentState)
+	assert.ErrorIs(t, gateway.Refund(ctx, second.ID, amount, "refund-4"), domain.ErrInvalidPaymentState)
+	assert.ErrorIs(t, gateway.Void(ctx, "fake_auth_999999"), domain.ErrPaymentNotFound)
+}
+
+func TestFakeGateway_Failures(t *testing.T) {
+	testCases := []struct {
+		mode          FakeMode
+		expectedError error
+	}{
+		{mode: FakeDecline, expectedError: domain.ErrPaymentDeclined},
+		{mode: FakeTimeout, expectedError: domain.ErrPaymentTimeout},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.mode), func(t *testing.T) {
+			gateway, err := NewFakeGateway(tc.mode)
+			require.NoError(t, err)
+
+			_, err = gateway.Authorize(context.Background(), domain.PaymentRequest{OrderID: "order-1", Amount: domain.NewMoney(100, domain.BaseCurrency)})
+
+			assert.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+
+	_, err := NewFakeGateway("flaky")
+	assert.Error(t, err)
+}
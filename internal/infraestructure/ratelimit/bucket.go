@@ -0,0 +1,85 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+// Limit is a token bucket: it holds up to Burst tokens and refills Requests tokens every
+// Period, each request takes one. A zero Requests is no limit.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+// Unlimited reports if the limit lets every request through.
+func (l Limit) Unlimited() bool {
+	return l.Requests <= 0 || l.Period <= 0
+}
+
+// Capacity is the number of tokens of a full bucket, Burst or Requests when it is not set.
+func (l Limit) Capacity() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return float64(l.Requests)
+}
+
+// interval is the time one token takes to refill.
+func (l Limit) interval() time.Duration {
+	return l.Period / time.Duration(l.Requests)
+}
+
+// Result is the state of a bucket after a request took, or failed to take, a token.
+// ResetAfter is the time until the bucket is full again and RetryAfter, when the request
+// is not allowed, the time until a token is available.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration
+	RetryAfter time.Duration
+}
+
+// Bucket is the state a Store keeps per key. A zero Bucket is full.
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+// Take refills the bucket up to now and takes a token when there is one, it returns the
+// bucket to store.
+func (b Bucket) Take(limit Limit, now time.Time) (Bucket, Result) {
+	capacity := limit.Capacity()
+	interval := limit.interval()
+
+	tokens := capacity
+	updatedAt := now
+	if !b.UpdatedAt.IsZero() {
+		elapsed := now.Sub(b.UpdatedAt)
+		if elapsed < 0 {
+			// the clocks of the replicas drift, a bucket is never refilled twice for the same time
+			elapsed = 0
+			updatedAt = b.UpdatedAt
+		}
+		tokens = math.Min(capacity, b.Tokens+float64(elapsed)/float64(interval))
+	}
+
+	result := Result{Limit: int(capacity)}
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - tokens) * float64(interval))
+	}
+	result.Remaining = int(math.Floor(tokens))
+	result.ResetAfter = time.Duration((capacity - tokens) * float64(interval))
+
+	return Bucket{Tokens: tokens, UpdatedAt: updatedAt}, result
+}
+
+// Full reports if the bucket is refilled by now, a store may forget it.
+func (b Bucket) Full(limit Limit, now time.Time) bool {
+	return b.UpdatedAt.IsZero() || now.Sub(b.UpdatedAt) >= time.Duration((limit.Capacity()-b.Tokens)*float64(limit.interval()))
+}
@@ -0,0 +1,151 @@
+package ratelimit_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/internal/infraestructure/ratelimit"
+	"testing"
+	"time"
+)
+
+// fakeClock is moved by the tests, the buckets are refilled by its time only.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)}
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	// 2 requests per second, up to 4 at once
+	limit := ratelimit.Limit{Requests: 2, Period: time.Second, Burst: 4}
+
+	testCases := []struct {
+		name     string
+		requests []time.Duration
+		expected ratelimit.Result
+	}{
+		{
+			name:     "Success - full bucket",
+			requests: []time.Duration{0},
+			expected: ratelimit.Result{Allowed: true, Limit: 4, Remaining: 3, ResetAfter: 500 * time.Millisecond},
+		},
+		{
+			name:     "Success - burst taken",
+			requests: []time.Duration{0, 0, 0, 0},
+			expected: ratelimit.Result{Allowed: true, Limit: 4, Remaining: 0, ResetAfter: 2 * time.Second},
+		},
+		{
+			name:     "Failure - past the burst",
+			requests: []time.Duration{0, 0, 0, 0, 0},
+			expected: ratelimit.Result{Allowed: false, Limit: 4, Remaining: 0, ResetAfter: 2 * time.Second, RetryAfter: 500 * time.Millisecond},
+		},
+		{
+			name:     "Failure - partially refilled",
+			requests: []time.Duration{0, 0, 0, 0, 250 * time.Millisecond},
+			expected: ratelimit.Result{Allowed: false, Limit: 4, Remaining: 0, ResetAfter: 1750 * time.Millisecond, RetryAfter: 250 * time.Millisecond},
+		},
+		{
+			name:     "Success - a token refilled",
+			requests: []time.Duration{0, 0, 0, 0, 500 * time.Millisecond},
+			expected: ratelimit.Result{Allowed: true, Limit: 4, Remaining: 0, ResetAfter: 2 * time.Second},
+		},
+		{
+			name:     "Success - refilled up to the burst only",
+			requests: []time.Duration{0, 0, 0, 0, time.Hour},
+			expected: ratelimit.Result{Allowed: true, Limit: 4, Remaining: 3, ResetAfter: 500 * time.Millisecond},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			clock := newFakeClock()
+			limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), clock.Now)
+
+			// Act
+			var result ratelimit.Result
+			for _, wait := range tc.requests {
+				clock.Advance(wait)
+				var err error
+				result, err = limiter.Allow(context.Background(), "ip:10.0.0.1 /api/orders", limit)
+				require.NoError(t, err)
+			}
+
+			// Assert
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestLimiter_AllowKeepsTheKeysApart(t *testing.T) {
+	// Arrange
+	clock := newFakeClock()
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), clock.Now)
+	limit := ratelimit.Limit{Requests: 1, Period: time.Minute}
+
+	// Act
+	first, err := limiter.Allow(context.Background(), "subject:alice POST /api/orders", limit)
+	require.NoError(t, err)
+	second, err := limiter.Allow(context.Background(), "subject:alice POST /api/orders", limit)
+	require.NoError(t, err)
+	other, err := limiter.Allow(context.Background(), "subject:bob POST /api/orders", limit)
+	require.NoError(t, err)
+	unlimited, err := limiter.Allow(context.Background(), "subject:alice POST /api/orders", ratelimit.Limit{})
+	require.NoError(t, err)
+
+	// Assert
+	assert.True(t, first.Allowed)
+	assert.False(t, second.Allowed)
+	assert.Equal(t, time.Minute, second.RetryAfter)
+	assert.True(t, other.Allowed)
+	assert.True(t, unlimited.Allowed)
+}
+
+func TestBucket_TakeWithClockDrift(t *testing.T) {
+	// Arrange
+	limit := ratelimit.Limit{Requests: 1, Period: time.Second}
+	now := newFakeClock().Now()
+	bucket, _ := ratelimit.Bucket{}.Take(limit, now)
+
+	// Act
+	// a replica behind by a second takes the bucket, then the first one again
+	behind, result := bucket.Take(limit, now.Add(-time.Second))
+	_, again := behind.Take(limit, now)
+
+	// Assert
+	assert.False(t, result.Allowed)
+	assert.Equal(t, now, behind.UpdatedAt)
+	assert.False(t, again.Allowed)
+}
+
+func TestMemoryStore_PrunesFullBuckets(t *testing.T) {
+	// Arrange
+	clock := newFakeClock()
+	store := ratelimit.NewMemoryStore()
+	limit := ratelimit.Limit{Requests: 10, Period: time.Minute}
+	for _, key := range []string{"ip:10.0.0.1", "ip:10.0.0.2"} {
+		_, err := store.Take(context.Background(), key, limit, clock.Now())
+		require.NoError(t, err)
+	}
+
+	// Act
+	clock.Advance(2 * time.Minute)
+	_, err := store.Take(context.Background(), "ip:10.0.0.3", limit, clock.Now())
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, 1, store.Len())
+}
@@ -0,0 +1,34 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Store keeps the buckets. Take must be atomic per key: the replicas sharing a store share
+// the limits.
+type Store interface {
+	// Take takes a token from the bucket of key at now, see Bucket.Take.
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (Result, error)
+}
+
+// Limiter takes the tokens from its store at the time of its clock.
+type Limiter struct {
+	Store Store
+	Now   func() time.Time
+}
+
+func NewLimiter(store Store, now func() time.Time) *Limiter {
+	return &Limiter{
+		Store: store,
+		Now:   now,
+	}
+}
+
+// Allow takes a token of key, an unlimited limit allows the request without a bucket.
+func (l *Limiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	if limit.Unlimited() {
+		return Result{Allowed: true}, nil
+	}
+	return l.Store.Take(ctx, key, limit, l.Now())
+}
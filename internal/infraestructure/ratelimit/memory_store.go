@@ -0,0 +1,56 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryPruneInterval is how often the memory store forgets the buckets refilled since.
+const memoryPruneInterval = time.Minute
+
+// MemoryStore keeps the buckets of a single replica, each replica enforces the whole limit.
+type MemoryStore struct {
+	mu       sync.Mutex
+	buckets  map[string]memoryBucket
+	prunedAt time.Time
+}
+
+type memoryBucket struct {
+	bucket Bucket
+	limit  Limit
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: map[string]memoryBucket{}}
+}
+
+func (s *MemoryStore) Take(ctx context.Context, key string, limit Limit, now time.Time) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.prunedAt) >= memoryPruneInterval {
+		s.prune(now)
+	}
+
+	bucket, result := s.buckets[key].bucket.Take(limit, now)
+	s.buckets[key] = memoryBucket{bucket: bucket, limit: limit}
+	return result, nil
+}
+
+// prune drops the full buckets, a full bucket is the same as a missing one.
+func (s *MemoryStore) prune(now time.Time) {
+	for key, entry := range s.buckets {
+		if entry.bucket.Full(entry.limit, now) {
+			delete(s.buckets, key)
+		}
+	}
+	s.prunedAt = now
+}
+
+// Len is the number of buckets kept.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.buckets)
+}
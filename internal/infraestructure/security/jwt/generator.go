@@ -25,6 +25,9 @@ func (g *JWTGenerator) Generate(
 		"iat":        now.Unix(),
 		"exp":        now.Add(g.ttl).Unix(),
 	}
+	if input.Subject != "" {
+		claims["sub"] = input.Subject
+	}
 
 	token := jwtlib.NewWithClaims(jwtlib.SigningMethodHS256, claims)
 	return token.SignedString(g.secret)
@@ -3,11 +3,11 @@ package jwt
 import (
 	"fmt"
 	jwtlib "github.com/golang-jwt/jwt/v5"
-	"time"
+	"microservice-products-catalog/cmd/http/auth"
 )
 
 type Verifier interface {
-	Verify(token string) (Claims, error)
+	Verify(token string) (auth.TokenClaims, error)
 }
 
 type JWTVerifier struct {
@@ -20,27 +20,31 @@ func NewVerifier(secret string) *JWTVerifier {
 	}
 }
 
-func (v *JWTVerifier) Verify(tokenString string) (Claims, error) {
+// Verify checks the signature and the expiration of the token, missing claims are read as empty.
+func (v *JWTVerifier) Verify(tokenString string) (auth.TokenClaims, error) {
 	token, err := jwtlib.Parse(tokenString, func(token *jwtlib.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwtlib.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method")
 		}
 		return v.secret, nil
-	})
+	}, jwtlib.WithExpirationRequired())
 
 	if err != nil || !token.Valid {
-		return Claims{}, fmt.Errorf("invalid token")
+		return auth.TokenClaims{}, fmt.Errorf("invalid token")
 	}
 
 	mapClaims, ok := token.Claims.(jwtlib.MapClaims)
 	if !ok {
-		return Claims{}, fmt.Errorf("invalid claims")
+		return auth.TokenClaims{}, fmt.Errorf("invalid claims")
 	}
 
-	return Claims{
-		Scope:     mapClaims["scope"].(string),
-		RequestID: mapClaims["request_id"].(string),
-		IssuedAt:  time.Unix(int64(mapClaims["iat"].(float64)), 0),
-		ExpiresAt: time.Unix(int64(mapClaims["exp"].(float64)), 0),
+	subject, _ := mapClaims["sub"].(string)
+	scope, _ := mapClaims["scope"].(string)
+	requestID, _ := mapClaims["request_id"].(string)
+
+	return auth.TokenClaims{
+		Subject:   subject,
+		Scope:     scope,
+		RequestID: requestID,
 	}, nil
 }
@@ -0,0 +1,37 @@
+package tax
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+	"os"
+)
+
+// FileCalculator applies the Rules of a JSON file read once at startup.
+type FileCalculator struct {
+	rules Rules
+}
+
+func NewFileCalculator(path string) (*FileCalculator, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading tax rules file: %w", err)
+	}
+
+	var rules Rules
+	if err := json.Unmarshal(content, &rules); err != nil {
+		return nil, fmt.Errorf("error parsing tax rules file: %w", err)
+	}
+	if rules.Rounding == "" {
+		rules.Rounding = RoundPerLine
+	}
+	if err := rules.validate(); err != nil {
+		return nil, err
+	}
+	return &FileCalculator{rules: rules}, nil
+}
+
+func (c *FileCalculator) Calculate(_ context.Context, request domain.TaxRequest) (domain.TaxBreakdown, error) {
+	return c.rules.Calculate(request)
+}
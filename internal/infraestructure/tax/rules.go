@@ -0,0 +1,161 @@
+package tax
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"microservice-products-catalog/internal/domain"
+	"strings"
+)
+
+const (
+	// RoundPerLine rounds the tax of every line, the order tax is their sum.
+	RoundPerLine = "line"
+	// RoundPerOrder adds the unrounded line taxes and rounds the order tax once.
+	RoundPerOrder = "order"
+)
+
+// Rules is the tax configuration document, the rates are fractions (0.21 is 21%):
+//
+//	{
+//	  "default_region": "AR",
+//	  "rounding": "line",
+//	  "regions": {
+//	    "AR": {"rate": 0.21, "inclusive": true, "categories": {"<category id>": 0.105}},
+//	    "US-NY": {"rate": 0.08875}
+//	  }
+//	}
+type Rules struct {
+	DefaultRegion string            `json:"default_region"`
+	Rounding      string            `json:"rounding"`
+	Regions       map[string]Region `json:"regions"`
+}
+
+// Region is the rate of a destination. Inclusive regions have the tax already included in
+// the prices, Categories override the rate for the products of a category.
+type Region struct {
+	Rate       float64            `json:"rate"`
+	Inclusive  bool               `json:"inclusive"`
+	Categories map[string]float64 `json:"categories"`
+}
+
+// Calculate applies the rules of the region to the lines.
+func (r Rules) Calculate(request domain.TaxRequest) (domain.TaxBreakdown, error) {
+	code := strings.ToUpper(request.Region)
+	if code == "" {
+		code = strings.ToUpper(r.DefaultRegion)
+	}
+	region, ok := r.region(code)
+	if !ok {
+		return domain.TaxBreakdown{}, fmt.Errorf("%w: %q", domain.ErrTaxRegionNotFound, code)
+	}
+
+	breakdown := domain.TaxBreakdown{Region: code, Inclusive: region.Inclusive, Lines: make([]domain.LineTax, 0, len(request.Lines))}
+	if len(request.Lines) == 0 {
+		return breakdown, nil
+	}
+
+	currency := request.Lines[0].Amount.Currency
+	amount := domain.NewMoney(0, currency)
+	tax := domain.NewMoney(0, currency)
+	unroundedTax := new(big.Rat)
+
+	for _, line := range request.Lines {
+		rate := region.rateFor(line.CategoryIDs)
+		lineTax := region.tax(line.Amount, rate)
+		unroundedTax.Add(unroundedTax, lineTax)
+
+		rounded := domain.NewMoney(roundHalfAwayFromZero(lineTax), currency)
+		breakdown.Lines = append(breakdown.Lines, region.split(line.Amount, rounded, rate))
+
+		amount = amount.Add(line.Amount)
+		tax = tax.Add(rounded)
+	}
+
+	if r.Rounding == RoundPerOrder {
+		tax = domain.NewMoney(roundHalfAwayFromZero(unroundedTax), currency)
+	}
+
+	order := region.split(amount, tax, 0)
+	breakdown.Subtotal, breakdown.Tax, breakdown.Total = order.Subtotal, order.Tax, order.Total
+	return breakdown, nil
+}
+
+func (r Rules) region(code string) (Region, bool) {
+	for name, region := range r.Regions {
+		if strings.EqualFold(name, code) {
+			return region, true
+		}
+	}
+	return Region{}, false
+}
+
+// rateFor uses the lowest category override of the product, or the region rate when none of
+// its categories has one.
+func (r Region) rateFor(categoryIDs []string) float64 {
+	rate, found := r.Rate, false
+	for _, id := range categoryIDs {
+		override, ok := r.Categories[id]
+		if ok && (!found || override < rate) {
+			rate, found = override, true
+		}
+	}
+	return rate
+}
+
+// tax is the exact tax, in minor units, of an amount. The rate is read with six decimals and
+// the computation is done with rationals, so a tax of exactly half a cent is never rounded
+// the wrong way because of a binary float.
+func (r Region) tax(amount domain.Money, rate float64) *big.Rat {
+	ratio := big.NewRat(int64(math.Round(rate*ratePrecision)), ratePrecision)
+	if r.Inclusive {
+		// the tax included in a gross amount is amount * rate / (1 + rate)
+		ratio.Quo(ratio, new(big.Rat).Add(big.NewRat(1, 1), ratio))
+	}
+	return ratio.Mul(ratio, big.NewRat(amount.Amount, 1))
+}
+
+const ratePrecision = 1_000_000
+
+// roundHalfAwayFromZero rounds to the minor unit with the same rule as domain.ParseMoney.
+func roundHalfAwayFromZero(value *big.Rat) int64 {
+	numerator := new(big.Int).Abs(value.Num())
+	denominator := value.Denom()
+
+	// (2 * |n| + d) / (2 * d) is |n| / d rounded half up
+	twice := new(big.Int).Mul(numerator, big.NewInt(2))
+	twice.Add(twice, denominator)
+	rounded := twice.Quo(twice, new(big.Int).Mul(denominator, big.NewInt(2))).Int64()
+	if value.Sign() < 0 {
+		return -rounded
+	}
+	return rounded
+}
+
+// split builds the subtotal and total of an amount as charged in the region.
+func (r Region) split(amount domain.Money, tax domain.Money, rate float64) domain.LineTax {
+	if r.Inclusive {
+		return domain.LineTax{Rate: rate, Subtotal: amount.Sub(tax), Tax: tax, Total: amount}
+	}
+	return domain.LineTax{Rate: rate, Subtotal: amount, Tax: tax, Total: amount.Add(tax)}
+}
+
+func (r Rules) validate() error {
+	if r.Rounding != RoundPerLine && r.Rounding != RoundPerOrder {
+		return fmt.Errorf("tax rounding must be %q or %q", RoundPerLine, RoundPerOrder)
+	}
+	if _, ok := r.region(r.DefaultRegion); !ok {
+		return fmt.Errorf("tax default region %q has no rules", r.DefaultRegion)
+	}
+	for name, region := range r.Regions {
+		if region.Rate < 0 {
+			return fmt.Errorf("tax rate of %s must not be negative", name)
+		}
+		for category, rate := range region.Categories {
+			if rate < 0 {
+				return fmt.Errorf("tax rate of %s for category %s must not be negative", name, category)
+			}
+		}
+	}
+	return nil
+}
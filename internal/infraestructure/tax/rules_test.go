@@ -0,0 +1,122 @@
+package tax
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/internal/domain"
+	"testing"
+)
+
+func TestRules_Calculate(t *testing.T) {
+	const booksID = "5b1c9f0e-2a3d-4e6f-8a7b-9c0d1e2f3a4b"
+
+	usd := func(cents int64) domain.Money { return domain.NewMoney(cents, domain.BaseCurrency) }
+	ars := func(cents int64) domain.Money { return domain.NewMoney(cents, "ARS") }
+
+	regions := map[string]Region{
+		"US-NY": {Rate: 0.10},
+		"US-TX": {Rate: 0.09},
+		"AR":    {Rate: 0.21, Inclusive: true, Categories: map[string]float64{booksID: 0.105}},
+	}
+	perLine := Rules{DefaultRegion: "US-NY", Rounding: RoundPerLine, Regions: regions}
+	perOrder := Rules{DefaultRegion: "US-NY", Rounding: RoundPerOrder, Regions: regions}
+
+	// three lines of 0.05 at 10% have a tax of half a cent each
+	halfCentLines := []domain.TaxLine{{Quantity: 1, Amount: usd(5)}, {Quantity: 1, Amount: usd(5)}, {Quantity: 1, Amount: usd(5)}}
+
+	type testCase struct {
+		testName      string
+		rules         Rules
+		request       domain.TaxRequest
+		expectedLines []domain.Money
+		expectedOrder domain.LineTax
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName:      "Success - Exclusive rate in the default region",
+			rules:         perLine,
+			request:       domain.TaxRequest{Lines: []domain.TaxLine{{Quantity: 3, Amount: usd(2997)}}},
+			expectedLines: []domain.Money{usd(300)},
+			expectedOrder: domain.LineTax{Subtotal: usd(2997), Tax: usd(300), Total: usd(3297)},
+		},
+		{
+			testName:      "Success - Half cent rounds away from zero without float drift",
+			rules:         perLine,
+			request:       domain.TaxRequest{Region: "us-tx", Lines: []domain.TaxLine{{Quantity: 1, Amount: usd(50)}}},
+			expectedLines: []domain.Money{usd(5)},
+			expectedOrder: domain.LineTax{Subtotal: usd(50), Tax: usd(5), Total: usd(55)},
+		},
+		{
+			testName:      "Success - Line level rounding sums the rounded line taxes",
+			rules:         perLine,
+			request:       domain.TaxRequest{Lines: halfCentLines},
+			expectedLines: []domain.Money{usd(1), usd(1), usd(1)},
+			expectedOrder: domain.LineTax{Subtotal: usd(15), Tax: usd(3), Total: usd(18)},
+		},
+		{
+			testName:      "Success - Order level rounding rounds the sum once",
+			rules:         perOrder,
+			request:       domain.TaxRequest{Lines: halfCentLines},
+			expectedLines: []domain.Money{usd(1), usd(1), usd(1)},
+			expectedOrder: domain.LineTax{Subtotal: usd(15), Tax: usd(2), Total: usd(17)},
+		},
+		{
+			testName:      "Success - Inclusive prices extract the tax",
+			rules:         perLine,
+			request:       domain.TaxRequest{Region: "AR", Lines: []domain.TaxLine{{Quantity: 1, Amount: ars(1210)}, {Quantity: 1, Amount: ars(999)}}},
+			expectedLines: []domain.Money{ars(210), ars(173)},
+			expectedOrder: domain.LineTax{Subtotal: ars(1826), Tax: ars(383), Total: ars(2209)},
+		},
+		{
+			testName: "Success - Category rate overrides the region rate",
+			rules:    perLine,
+			request: domain.TaxRequest{Region: "AR", Lines: []domain.TaxLine{
+				{Quantity: 1, CategoryIDs: []string{"0f0e0d0c-0000-4000-8000-000000000000", booksID}, Amount: ars(1105)},
+			}},
+			expectedLines: []domain.Money{ars(105)},
+			expectedOrder: domain.LineTax{Subtotal: ars(1000), Tax: ars(105), Total: ars(1105)},
+		},
+		{
+			testName:      "Failure - Unknown region",
+			rules:         perLine,
+			request:       domain.TaxRequest{Region: "BR", Lines: []domain.TaxLine{{Quantity: 1, Amount: usd(100)}}},
+			expectedError: domain.ErrTaxRegionNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			breakdown, err := tc.rules.Calculate(tc.request)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			lineTaxes := make([]domain.Money, 0, len(breakdown.Lines))
+			for _, line := range breakdown.Lines {
+				lineTaxes = append(lineTaxes, line.Tax)
+				assert.Equal(t, line.Total, line.Subtotal.Add(line.Tax))
+			}
+			assert.Equal(t, tc.expectedLines, lineTaxes)
+			assert.Equal(t, tc.expectedOrder.Subtotal, breakdown.Subtotal)
+			assert.Equal(t, tc.expectedOrder.Tax, breakdown.Tax)
+			assert.Equal(t, tc.expectedOrder.Total, breakdown.Total)
+		})
+	}
+}
+
+func TestRules_Validate(t *testing.T) {
+	assert.Error(t, Rules{DefaultRegion: "AR", Rounding: "cent", Regions: map[string]Region{"AR": {Rate: 0.21}}}.validate())
+	assert.Error(t, Rules{DefaultRegion: "BR", Rounding: RoundPerLine, Regions: map[string]Region{"AR": {Rate: 0.21}}}.validate())
+	assert.Error(t, Rules{DefaultRegion: "AR", Rounding: RoundPerLine, Regions: map[string]Region{"AR": {Rate: -0.1}}}.validate())
+	assert.NoError(t, Rules{DefaultRegion: "ar", Rounding: RoundPerOrder, Regions: map[string]Region{"AR": {Rate: 0.21}}}.validate())
+}
@@ -0,0 +1,57 @@
+package tracing_test
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"microservice-products-catalog/internal/infraestructure/tracing"
+	"testing"
+)
+
+type product struct {
+	ID    string
+	Stock int
+}
+
+func TestGormPlugin(t *testing.T) {
+	// Arrange
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	// the statements are built but not sent, no database is needed
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:password@tcp(localhost:3306)/test", SkipInitializeWithVersion: true}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(tracing.NewGormPlugin(tracer)))
+
+	ctx, parent := tracer.Start(context.Background(), "WithTransaction")
+
+	// Act
+	var found product
+	db.WithContext(ctx).Where("id = ?", "076e76d6").First(&found)
+	db.WithContext(ctx).Model(&product{}).Where("id = ?", "076e76d6").Update("stock", 3)
+	parent.End()
+
+	// Assert
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	query, update := spans[0], spans[1]
+	assert.Equal(t, "gorm.Query", query.Name())
+	assert.Equal(t, "gorm.Update", update.Name())
+	for _, span := range []sdktrace.ReadOnlySpan{query, update} {
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+		assert.Contains(t, span.Attributes(), attribute.String("db.system", "mysql"))
+		assert.Contains(t, span.Attributes(), attribute.String("db.sql.table", "products"))
+	}
+	assert.Contains(t, query.Attributes(), attribute.String("db.statement", "SELECT * FROM `products` WHERE id = ? ORDER BY `products`.`id` LIMIT ?"))
+	assert.Contains(t, update.Attributes(), attribute.String("db.statement", "UPDATE `products` SET `stock`=? WHERE id = ?"))
+}
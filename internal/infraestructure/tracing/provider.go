@@ -0,0 +1,69 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"strings"
+)
+
+// Exporters of the spans, see NewProvider.
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterNone   = "none"
+)
+
+// NewProvider builds the tracer provider of the service. otlp sends the spans over OTLP/HTTP to
+// endpoint (the OTEL_EXPORTER_OTLP_* variables apply when it is empty), stdout writes them to
+// the output and none samples nothing. The provider must be shut down to flush the spans.
+func NewProvider(ctx context.Context, exporter string, endpoint string, serviceName string) (*sdktrace.TracerProvider, error) {
+	serviceResource := resource.NewSchemaless(attribute.String("service.name", serviceName))
+
+	var spanExporter sdktrace.SpanExporter
+	switch strings.ToLower(exporter) {
+	case ExporterNone:
+		return sdktrace.NewTracerProvider(sdktrace.WithSampler(sdktrace.NeverSample()), sdktrace.WithResource(serviceResource)), nil
+
+	case ExporterStdout:
+		stdoutExporter, err := stdouttrace.New()
+		if err != nil {
+			return nil, fmt.Errorf("stdout exporter: %w", err)
+		}
+		spanExporter = stdoutExporter
+
+	case ExporterOTLP:
+		var options []otlptracehttp.Option
+		if endpoint != "" {
+			options = append(options, otlptracehttp.WithEndpointURL(endpoint))
+		}
+		otlpExporter, err := otlptracehttp.New(ctx, options...)
+		if err != nil {
+			return nil, fmt.Errorf("otlp exporter: %w", err)
+		}
+		spanExporter = otlpExporter
+
+	default:
+		return nil, fmt.Errorf("invalid trace exporter %q, must be otlp, stdout or none", exporter)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(spanExporter),
+		sdktrace.WithResource(serviceResource),
+	), nil
+}
+
+// End records err on the span, when there is one, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
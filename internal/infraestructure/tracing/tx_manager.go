@@ -0,0 +1,28 @@
+package tracing
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type TransactionManager interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// TxManager decorates a TransactionManager with a span per transaction, the queries of fn are
+// its children so the time waiting on locks shows under it.
+type TxManager struct {
+	next   TransactionManager
+	tracer trace.Tracer
+}
+
+func NewTxManager(next TransactionManager, tracer trace.Tracer) *TxManager {
+	return &TxManager{next: next, tracer: tracer}
+}
+
+func (m *TxManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, span := m.tracer.Start(ctx, "WithTransaction")
+	err := m.next.WithTransaction(ctx, fn)
+	End(span, err)
+	return err
+}
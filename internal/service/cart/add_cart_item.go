@@ -0,0 +1,69 @@
+package cart
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"microservice-products-catalog/internal/domain"
+)
+
+// AddCartItem adds quantity units of the product (of the variant when variantID is set), an
+// item already in the cart gets the quantity added. The product is read before the cart is
+// locked, the carts never wait for the product locks of the checkouts.
+func (s *Service) AddCartItem(ctx context.Context, cartID string, customerID string, productID string, variantID string, quantity int) (*domain.CartView, error) {
+	product, err := s.ProductService.GetProductByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	variant, reason := findVariant(product, variantID)
+	switch reason {
+	case domain.LineVariantRequired:
+		return nil, domain.ErrVariantRequired
+	case domain.LineVariantNotFound:
+		return nil, domain.ErrVariantNotFound
+	}
+	stock := product.Stock
+	if variant != nil {
+		stock = variant.Stock
+	}
+
+	err = s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		cart, err := s.lockOpenCart(txCtx, cartID, customerID)
+		if err != nil {
+			return err
+		}
+
+		item := cart.Item(productID, variantID)
+		if item == nil {
+			item = &domain.CartItem{
+				ID:        uuid.New().String(),
+				CartID:    cart.ID,
+				ProductID: productID,
+				AddedAt:   s.Now(),
+			}
+			if variantID != "" {
+				item.VariantID = &variantID
+			}
+			if quantity > stock {
+				return domain.ErrInsufficientStock
+			}
+			item.Quantity = quantity
+			if err := s.Storage.CreateCartItem(txCtx, *item); err != nil {
+				return err
+			}
+		} else {
+			if item.Quantity+quantity > stock {
+				return domain.ErrInsufficientStock
+			}
+			item.Quantity += quantity
+			if err := s.Storage.UpdateCartItem(txCtx, item); err != nil {
+				return err
+			}
+		}
+
+		return s.touch(txCtx, cart)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetCart(ctx, cartID, customerID)
+}
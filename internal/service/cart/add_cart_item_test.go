@@ -0,0 +1,193 @@
+package cart_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/cart"
+	"microservice-products-catalog/internal/service/cart/mocks"
+	"testing"
+	"time"
+)
+
+const (
+	cartID     = "3c9a1f2e-7b4d-4e6a-9c8b-1d2e3f4a5b6c"
+	customerID = "5f3c2b1a-0d9e-4c8b-a7f6-e5d4c3b2a190"
+	productID  = "076e76d6-fc3e-4f95-a024-1b4984e76060"
+)
+
+var now = time.Date(2026, time.October, 19, 12, 0, 0, 0, time.UTC)
+
+func TestAddCartItem(t *testing.T) {
+	type testCase struct {
+		testName      string
+		customerID    string
+		items         []domain.CartItem
+		status        domain.CartStatus
+		expiresAt     time.Time
+		quantity      int
+		setupMock     func(mockStorage *mocks.MockStorageRepository)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName:   "Success - new item and the expiry moves forward",
+			customerID: customerID,
+			quantity:   2,
+			setupMock: func(mockStorage *mocks.MockStorageRepository) {
+				mockStorage.EXPECT().
+					CreateCartItem(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, item domain.CartItem) error {
+						assert.Equal(t, productID, item.ProductID)
+						assert.Equal(t, 2, item.Quantity)
+						return nil
+					}).Times(1)
+				mockStorage.EXPECT().
+					UpdateCart(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *domain.Cart) error {
+						assert.Equal(t, now.Add(time.Hour), c.ExpiresAt)
+						return nil
+					}).Times(1)
+			},
+		},
+		{
+			testName:   "Success - the quantity is added to the item of the product",
+			customerID: customerID,
+			items:      []domain.CartItem{{ID: "item-1", CartID: cartID, ProductID: productID, Quantity: 3}},
+			quantity:   2,
+			setupMock: func(mockStorage *mocks.MockStorageRepository) {
+				mockStorage.EXPECT().
+					UpdateCartItem(gomock.Any(), &domain.CartItem{ID: "item-1", CartID: cartID, ProductID: productID, Quantity: 5}).
+					Return(nil).Times(1)
+				mockStorage.EXPECT().UpdateCart(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			testName:      "Failure - more than the stock",
+			customerID:    customerID,
+			items:         []domain.CartItem{{ID: "item-1", CartID: cartID, ProductID: productID, Quantity: 4}},
+			quantity:      2,
+			setupMock:     func(mockStorage *mocks.MockStorageRepository) {},
+			expectedError: domain.ErrInsufficientStock,
+		},
+		{
+			testName:      "Failure - cart of another customer",
+			customerID:    "0b7d7c8e-3f4a-4b8e-9d61-2a5f1e7c9b30",
+			quantity:      1,
+			setupMock:     func(mockStorage *mocks.MockStorageRepository) {},
+			expectedError: domain.ErrCartNotFound,
+		},
+		{
+			testName:      "Failure - expired cart",
+			customerID:    customerID,
+			expiresAt:     now.Add(-time.Minute),
+			quantity:      1,
+			setupMock:     func(mockStorage *mocks.MockStorageRepository) {},
+			expectedError: domain.ErrCartExpired,
+		},
+		{
+			testName:      "Failure - checked out cart",
+			customerID:    customerID,
+			status:        domain.CartCheckedOut,
+			quantity:      1,
+			setupMock:     func(mockStorage *mocks.MockStorageRepository) {},
+			expectedError: domain.ErrCartCheckedOut,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			product := &domain.Product{ID: productID, Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 5}
+			stored := func() *domain.Cart {
+				c := &domain.Cart{ID: cartID, CustomerID: customerID, Status: domain.CartOpen, ExpiresAt: now.Add(time.Minute), Items: append([]domain.CartItem{}, tc.items...)}
+				if tc.status != "" {
+					c.Status = tc.status
+				}
+				if !tc.expiresAt.IsZero() {
+					c.ExpiresAt = tc.expiresAt
+				}
+				return c
+			}
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockStorage.EXPECT().GetCartByID(gomock.Any(), cartID).DoAndReturn(func(context.Context, string) (*domain.Cart, error) {
+				return stored(), nil
+			}).AnyTimes()
+			mockTxManager := mocks.NewMockTransactionManager(ctrl)
+			mockTxManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockProductService.EXPECT().GetProductByID(gomock.Any(), productID).Return(product, nil).AnyTimes()
+			tc.setupMock(mockStorage)
+
+			service := cart.NewService(mockStorage, mockTxManager, mockProductService, mocks.NewMockOrderService(ctrl), time.Hour)
+			service.Now = func() time.Time { return now }
+
+			// Act
+			view, err := service.AddCartItem(context.Background(), cartID, tc.customerID, productID, "", tc.quantity)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, view)
+			} else {
+				require.NoError(t, err)
+				assert.NotNil(t, view)
+			}
+		})
+	}
+}
+
+func TestGetCart(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	variantID := "5e4d3c2b-1a09-4f8e-9d7c-6b5a4f3e2d1c"
+	variantPrice := domain.NewMoney(1500, domain.BaseCurrency)
+	gopher := &domain.Product{ID: productID, Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 1}
+	shirt := &domain.Product{ID: "9b1f3c2e-6a4d-4f8b-8e7a-5d2c1b0a9f86", Price: domain.NewMoney(2500, domain.BaseCurrency),
+		Variants: []domain.Variant{{ID: variantID, Price: &variantPrice, Stock: 10}}}
+	missingID := "2a3b4c5d-6e7f-4a8b-9c0d-1e2f3a4b5c6d"
+
+	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockStorage.EXPECT().GetCartByID(gomock.Any(), cartID).Return(&domain.Cart{
+		ID: cartID, CustomerID: customerID, Status: domain.CartOpen, ExpiresAt: now.Add(time.Hour),
+		Items: []domain.CartItem{
+			{ID: "item-1", ProductID: gopher.ID, Quantity: 2},
+			{ID: "item-2", ProductID: shirt.ID, VariantID: &variantID, Quantity: 2},
+			{ID: "item-3", ProductID: missingID, Quantity: 1},
+		},
+	}, nil).Times(1)
+	mockProductService := mocks.NewMockProductService(ctrl)
+	mockProductService.EXPECT().GetProductByID(gomock.Any(), gopher.ID).Return(gopher, nil).Times(1)
+	mockProductService.EXPECT().GetProductByID(gomock.Any(), shirt.ID).Return(shirt, nil).Times(1)
+	mockProductService.EXPECT().GetProductByID(gomock.Any(), missingID).Return(nil, domain.ErrProductNotFound).Times(1)
+
+	service := cart.NewService(mockStorage, mocks.NewMockTransactionManager(ctrl), mockProductService, mocks.NewMockOrderService(ctrl), time.Hour)
+	service.Now = func() time.Time { return now }
+
+	// Act
+	view, err := service.GetCart(context.Background(), cartID, customerID)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, view.Lines, 3)
+	assert.Equal(t, &domain.LineConflict{ProductID: gopher.ID, Requested: 2, Available: 1, Reason: domain.LineInsufficientStock}, view.Lines[0].Conflict)
+	assert.Equal(t, domain.NewMoney(3000, domain.BaseCurrency), view.Lines[1].Total, "the variant price overrides the product price")
+	assert.Nil(t, view.Lines[1].Conflict)
+	assert.Equal(t, domain.LineProductNotFound, view.Lines[2].Conflict.Reason)
+	assert.Equal(t, domain.NewMoney(5000, domain.BaseCurrency), view.Subtotal)
+}
@@ -0,0 +1,76 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// Checkout places one order per item through order.Service.CreateOrders, so the stock is
+// decremented in the same transaction as every other order. When the stock of some items
+// changed since they were added, nothing is ordered and the error is a
+// *domain.CheckoutConflictError with a line per item that cannot be ordered. The cart is
+// marked as checked out in the order transaction, a cart is never ordered twice. When the
+// payment fails the orders are compensated and the cart is open again, so it can be retried.
+func (s *Service) Checkout(ctx context.Context, cartID string, customerID string, options domain.CheckoutOptions) ([]domain.Order, error) {
+	cart, err := s.Storage.GetCartByID(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkOpen(cart, customerID); err != nil {
+		return nil, err
+	}
+	if len(cart.Items) == 0 {
+		return nil, domain.ErrCartEmpty
+	}
+
+	requests := make([]domain.OrderRequest, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		request := domain.OrderRequest{
+			CustomerID: cart.CustomerID,
+			ProductID:  item.ProductID,
+			Quantity:   item.Quantity,
+			Currency:   options.Currency,
+			Region:     options.Region,
+		}
+		if item.VariantID != nil {
+			request.VariantID = *item.VariantID
+		}
+		requests = append(requests, request)
+	}
+
+	orders, err := s.OrderService.CreateOrders(ctx, requests, func(txCtx context.Context, _ []domain.Order) error {
+		locked, err := s.Storage.GetCartByID(txCtx, cartID)
+		if err != nil {
+			return err
+		}
+		if locked.Status == domain.CartCheckedOut {
+			return domain.ErrCartCheckedOut
+		}
+		// the items were read before the lock, they must be the ones that were ordered
+		if !locked.UpdatedAt.Equal(cart.UpdatedAt) {
+			return domain.ErrCartChanged
+		}
+
+		locked.Status = domain.CartCheckedOut
+		locked.UpdatedAt = s.Now()
+		return s.Storage.UpdateCart(txCtx, locked)
+	})
+	if errors.Is(err, domain.ErrPaymentDeclined) || errors.Is(err, domain.ErrPaymentTimeout) || errors.Is(err, domain.ErrPaymentFailed) {
+		if reopenErr := s.reopen(ctx, cartID); reopenErr != nil {
+			logging.FromContext(ctx).Error("error reopening cart after a payment failure", "cart_id", cartID, "error", reopenErr)
+		}
+	}
+	return orders, err
+}
+
+// reopen puts back in the open status a cart whose checkout was compensated.
+func (s *Service) reopen(ctx context.Context, cartID string) error {
+	cart, err := s.Storage.GetCartByID(ctx, cartID)
+	if err != nil {
+		return err
+	}
+	cart.Status = domain.CartOpen
+	return s.touch(ctx, cart)
+}
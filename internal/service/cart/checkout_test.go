@@ -0,0 +1,131 @@
+package cart_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/cart"
+	"microservice-products-catalog/internal/service/cart/mocks"
+	"testing"
+	"time"
+)
+
+func TestCheckout(t *testing.T) {
+	updatedAt := now.Add(-time.Minute)
+	conflict := &domain.CheckoutConflictError{Lines: []domain.LineConflict{{ProductID: productID, Requested: 2, Available: 1, Reason: domain.LineInsufficientStock}}}
+
+	type testCase struct {
+		testName      string
+		items         []domain.CartItem
+		locked        domain.Cart
+		ordersErr     error
+		reopened      bool
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - the cart is checked out with its orders",
+			items:    []domain.CartItem{{ID: "item-1", ProductID: productID, Quantity: 2}},
+			locked:   domain.Cart{ID: cartID, Status: domain.CartOpen, UpdatedAt: updatedAt},
+		},
+		{
+			testName:      "Failure - stock conflict is reported as is",
+			items:         []domain.CartItem{{ID: "item-1", ProductID: productID, Quantity: 2}},
+			ordersErr:     conflict,
+			expectedError: domain.ErrCheckoutConflict,
+		},
+		{
+			testName:      "Failure - declined payment opens the cart again",
+			items:         []domain.CartItem{{ID: "item-1", ProductID: productID, Quantity: 2}},
+			ordersErr:     domain.ErrPaymentDeclined,
+			reopened:      true,
+			expectedError: domain.ErrPaymentDeclined,
+		},
+		{
+			testName:      "Failure - a concurrent checkout won",
+			items:         []domain.CartItem{{ID: "item-1", ProductID: productID, Quantity: 2}},
+			locked:        domain.Cart{ID: cartID, Status: domain.CartCheckedOut, UpdatedAt: now},
+			expectedError: domain.ErrCartCheckedOut,
+		},
+		{
+			testName:      "Failure - the items changed during the checkout",
+			items:         []domain.CartItem{{ID: "item-1", ProductID: productID, Quantity: 2}},
+			locked:        domain.Cart{ID: cartID, Status: domain.CartOpen, UpdatedAt: now},
+			expectedError: domain.ErrCartChanged,
+		},
+		{
+			testName:      "Failure - empty cart",
+			expectedError: domain.ErrCartEmpty,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockOrderService := mocks.NewMockOrderService(ctrl)
+
+			snapshot := &domain.Cart{ID: cartID, CustomerID: customerID, Status: domain.CartOpen, ExpiresAt: now.Add(time.Hour), UpdatedAt: updatedAt, Items: tc.items}
+			mockStorage.EXPECT().GetCartByID(gomock.Any(), cartID).Return(snapshot, nil).Times(1)
+
+			if len(tc.items) > 0 {
+				mockOrderService.EXPECT().
+					CreateOrders(gomock.Any(), []domain.OrderRequest{{CustomerID: customerID, ProductID: productID, Quantity: 2, Currency: "EUR", Region: "US-NY"}}, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, requests []domain.OrderRequest, afterPlaced func(context.Context, []domain.Order) error) ([]domain.Order, error) {
+						if tc.ordersErr != nil {
+							return nil, tc.ordersErr
+						}
+						orders := []domain.Order{{ID: "order-1", ProductID: productID, Quantity: 2}}
+						if err := afterPlaced(ctx, orders); err != nil {
+							return nil, err
+						}
+						return orders, nil
+					}).Times(1)
+			}
+			if tc.ordersErr == nil && len(tc.items) > 0 {
+				locked := tc.locked
+				mockStorage.EXPECT().GetCartByID(gomock.Any(), cartID).Return(&locked, nil).Times(1)
+			}
+			if tc.reopened {
+				mockStorage.EXPECT().GetCartByID(gomock.Any(), cartID).Return(&domain.Cart{ID: cartID, Status: domain.CartCheckedOut}, nil).Times(1)
+				mockStorage.EXPECT().
+					UpdateCart(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *domain.Cart) error {
+						assert.Equal(t, domain.CartOpen, c.Status)
+						assert.Equal(t, now.Add(time.Hour), c.ExpiresAt)
+						return nil
+					}).Times(1)
+			}
+			if tc.expectedError == nil {
+				mockStorage.EXPECT().
+					UpdateCart(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *domain.Cart) error {
+						assert.Equal(t, domain.CartCheckedOut, c.Status)
+						return nil
+					}).Times(1)
+			}
+
+			service := cart.NewService(mockStorage, mocks.NewMockTransactionManager(ctrl), mocks.NewMockProductService(ctrl), mockOrderService, time.Hour)
+			service.Now = func() time.Time { return now }
+
+			// Act
+			orders, err := service.Checkout(context.Background(), cartID, customerID, domain.CheckoutOptions{Currency: "EUR", Region: "US-NY"})
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, orders)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, orders, 1)
+			}
+		})
+	}
+}
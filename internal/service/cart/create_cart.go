@@ -0,0 +1,30 @@
+package cart
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"microservice-products-catalog/internal/domain"
+)
+
+// CreateCart opens an empty cart for the customer.
+func (s *Service) CreateCart(ctx context.Context, customerID string) (domain.Cart, error) {
+	if customerID == "" {
+		return domain.Cart{}, domain.ErrCustomerRequired
+	}
+
+	now := s.Now()
+	cart := domain.Cart{
+		ID:         uuid.New().String(),
+		CustomerID: customerID,
+		Status:     domain.CartOpen,
+		ExpiresAt:  now.Add(s.TTL),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Items:      []domain.CartItem{},
+	}
+
+	if err := s.Storage.CreateCart(ctx, cart); err != nil {
+		return domain.Cart{}, err
+	}
+	return cart, nil
+}
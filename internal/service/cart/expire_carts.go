@@ -0,0 +1,32 @@
+package cart
+
+import (
+	"context"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"time"
+)
+
+// RunCartExpiry deletes the expired carts every interval until the context is cancelled. The
+// expired carts are already unusable, this only keeps the table small, so every replica can
+// run it.
+func (s *Service) RunCartExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.DeleteExpiredCarts(ctx); err != nil {
+			logging.FromContext(ctx).Error("error deleting expired carts", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeleteExpiredCarts deletes the open carts expired by now and returns how many were deleted.
+func (s *Service) DeleteExpiredCarts(ctx context.Context) (int, error) {
+	return s.Storage.DeleteExpiredCarts(ctx, s.Now())
+}
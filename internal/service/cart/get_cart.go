@@ -0,0 +1,100 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetCart returns the cart with the current price and stock of its items. Reading the cart does
+// not push its expiry forward, an expired cart is reported as such until it is deleted.
+func (s *Service) GetCart(ctx context.Context, id string, customerID string) (*domain.CartView, error) {
+	cart, err := s.Storage.GetCartByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOwner(cart, customerID); err != nil {
+		return nil, err
+	}
+	if cart.Status == domain.CartOpen && cart.ExpiredAt(s.Now()) {
+		return nil, domain.ErrCartExpired
+	}
+
+	view := &domain.CartView{
+		Cart:     *cart,
+		Lines:    make([]domain.CartLine, 0, len(cart.Items)),
+		Subtotal: domain.NewMoney(0, domain.BaseCurrency),
+	}
+	for _, item := range cart.Items {
+		line, err := s.cartLine(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		view.Lines = append(view.Lines, line)
+		view.Subtotal = view.Subtotal.Add(line.Total)
+	}
+	view.Items = nil
+	return view, nil
+}
+
+// cartLine prices the item at the current price of the product, in the base currency.
+func (s *Service) cartLine(ctx context.Context, item domain.CartItem) (domain.CartLine, error) {
+	line := domain.CartLine{
+		CartItem:  item,
+		UnitPrice: domain.NewMoney(0, domain.BaseCurrency),
+		Total:     domain.NewMoney(0, domain.BaseCurrency),
+	}
+	conflict := &domain.LineConflict{ProductID: item.ProductID, Requested: item.Quantity}
+	if item.VariantID != nil {
+		conflict.VariantID = *item.VariantID
+	}
+
+	product, err := s.ProductService.GetProductByID(ctx, item.ProductID)
+	if errors.Is(err, domain.ErrProductNotFound) {
+		conflict.Reason = domain.LineProductNotFound
+		line.Conflict = conflict
+		return line, nil
+	}
+	if err != nil {
+		return domain.CartLine{}, err
+	}
+
+	variant, reason := findVariant(product, conflict.VariantID)
+	if reason != "" {
+		conflict.Reason = reason
+		line.Conflict = conflict
+		return line, nil
+	}
+
+	line.UnitPrice = product.Price
+	line.Stock = product.Stock
+	if variant != nil {
+		line.UnitPrice = variant.EffectivePrice(product.Price)
+		line.Stock = variant.Stock
+	}
+	line.Total = line.UnitPrice.Mul(item.Quantity)
+
+	if line.Stock < item.Quantity {
+		conflict.Available = line.Stock
+		conflict.Reason = domain.LineInsufficientStock
+		line.Conflict = conflict
+	}
+	return line, nil
+}
+
+// findVariant returns the variant of the product, nil for a product without variants, and the
+// conflict reason when the variant cannot be bought.
+func findVariant(product *domain.Product, variantID string) (*domain.Variant, string) {
+	if variantID == "" {
+		if len(product.Variants) > 0 {
+			return nil, domain.LineVariantRequired
+		}
+		return nil, ""
+	}
+	for i := range product.Variants {
+		if product.Variants[i].ID == variantID {
+			return &product.Variants[i], ""
+		}
+	}
+	return nil, domain.LineVariantNotFound
+}
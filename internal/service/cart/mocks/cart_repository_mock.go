@@ -0,0 +1,250 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "microservice-products-catalog/internal/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStorageRepository is a mock of StorageRepository interface.
+type MockStorageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageRepositoryMockRecorder
+}
+
+// MockStorageRepositoryMockRecorder is the mock recorder for MockStorageRepository.
+type MockStorageRepositoryMockRecorder struct {
+	mock *MockStorageRepository
+}
+
+// NewMockStorageRepository creates a new mock instance.
+func NewMockStorageRepository(ctrl *gomock.Controller) *MockStorageRepository {
+	mock := &MockStorageRepository{ctrl: ctrl}
+	mock.recorder = &MockStorageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageRepository) EXPECT() *MockStorageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCart mocks base method.
+func (m *MockStorageRepository) CreateCart(ctx context.Context, cart domain.Cart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCart", ctx, cart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCart indicates an expected call of CreateCart.
+func (mr *MockStorageRepositoryMockRecorder) CreateCart(ctx, cart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCart", reflect.TypeOf((*MockStorageRepository)(nil).CreateCart), ctx, cart)
+}
+
+// CreateCartItem mocks base method.
+func (m *MockStorageRepository) CreateCartItem(ctx context.Context, item domain.CartItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCartItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCartItem indicates an expected call of CreateCartItem.
+func (mr *MockStorageRepositoryMockRecorder) CreateCartItem(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCartItem", reflect.TypeOf((*MockStorageRepository)(nil).CreateCartItem), ctx, item)
+}
+
+// DeleteCartItem mocks base method.
+func (m *MockStorageRepository) DeleteCartItem(ctx context.Context, cartID, itemID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCartItem", ctx, cartID, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCartItem indicates an expected call of DeleteCartItem.
+func (mr *MockStorageRepositoryMockRecorder) DeleteCartItem(ctx, cartID, itemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCartItem", reflect.TypeOf((*MockStorageRepository)(nil).DeleteCartItem), ctx, cartID, itemID)
+}
+
+// DeleteExpiredCarts mocks base method.
+func (m *MockStorageRepository) DeleteExpiredCarts(ctx context.Context, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredCarts", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredCarts indicates an expected call of DeleteExpiredCarts.
+func (mr *MockStorageRepositoryMockRecorder) DeleteExpiredCarts(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredCarts", reflect.TypeOf((*MockStorageRepository)(nil).DeleteExpiredCarts), ctx, before)
+}
+
+// GetCartByID mocks base method.
+func (m *MockStorageRepository) GetCartByID(ctx context.Context, id string) (*domain.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCartByID indicates an expected call of GetCartByID.
+func (mr *MockStorageRepositoryMockRecorder) GetCartByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartByID", reflect.TypeOf((*MockStorageRepository)(nil).GetCartByID), ctx, id)
+}
+
+// UpdateCart mocks base method.
+func (m *MockStorageRepository) UpdateCart(ctx context.Context, cart *domain.Cart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCart", ctx, cart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCart indicates an expected call of UpdateCart.
+func (mr *MockStorageRepositoryMockRecorder) UpdateCart(ctx, cart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCart", reflect.TypeOf((*MockStorageRepository)(nil).UpdateCart), ctx, cart)
+}
+
+// UpdateCartItem mocks base method.
+func (m *MockStorageRepository) UpdateCartItem(ctx context.Context, item *domain.CartItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCartItem", ctx, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCartItem indicates an expected call of UpdateCartItem.
+func (mr *MockStorageRepositoryMockRecorder) UpdateCartItem(ctx, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCartItem", reflect.TypeOf((*MockStorageRepository)(nil).UpdateCartItem), ctx, item)
+}
+
+// MockProductService is a mock of ProductService interface.
+type MockProductService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductServiceMockRecorder
+}
+
+// MockProductServiceMockRecorder is the mock recorder for MockProductService.
+type MockProductServiceMockRecorder struct {
+	mock *MockProductService
+}
+
+// NewMockProductService creates a new mock instance.
+func NewMockProductService(ctrl *gomock.Controller) *MockProductService {
+	mock := &MockProductService{ctrl: ctrl}
+	mock.recorder = &MockProductServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductService) EXPECT() *MockProductServiceMockRecorder {
+	return m.recorder
+}
+
+// GetProductByID mocks base method.
+func (m *MockProductService) GetProductByID(ctx context.Context, id string) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductByID indicates an expected call of GetProductByID.
+func (mr *MockProductServiceMockRecorder) GetProductByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByID", reflect.TypeOf((*MockProductService)(nil).GetProductByID), ctx, id)
+}
+
+// MockOrderService is a mock of OrderService interface.
+type MockOrderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderServiceMockRecorder
+}
+
+// MockOrderServiceMockRecorder is the mock recorder for MockOrderService.
+type MockOrderServiceMockRecorder struct {
+	mock *MockOrderService
+}
+
+// NewMockOrderService creates a new mock instance.
+func NewMockOrderService(ctrl *gomock.Controller) *MockOrderService {
+	mock := &MockOrderService{ctrl: ctrl}
+	mock.recorder = &MockOrderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderService) EXPECT() *MockOrderServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateOrders mocks base method.
+func (m *MockOrderService) CreateOrders(ctx context.Context, requests []domain.OrderRequest, afterPlaced func(context.Context, []domain.Order) error) ([]domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrders", ctx, requests, afterPlaced)
+	ret0, _ := ret[0].([]domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrders indicates an expected call of CreateOrders.
+func (mr *MockOrderServiceMockRecorder) CreateOrders(ctx, requests, afterPlaced interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrders", reflect.TypeOf((*MockOrderService)(nil).CreateOrders), ctx, requests, afterPlaced)
+}
+
+// MockTransactionManager is a mock of TransactionManager interface.
+type MockTransactionManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionManagerMockRecorder
+}
+
+// MockTransactionManagerMockRecorder is the mock recorder for MockTransactionManager.
+type MockTransactionManagerMockRecorder struct {
+	mock *MockTransactionManager
+}
+
+// NewMockTransactionManager creates a new mock instance.
+func NewMockTransactionManager(ctrl *gomock.Controller) *MockTransactionManager {
+	mock := &MockTransactionManager{ctrl: ctrl}
+	mock.recorder = &MockTransactionManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionManager) EXPECT() *MockTransactionManagerMockRecorder {
+	return m.recorder
+}
+
+// WithTransaction mocks base method.
+func (m *MockTransactionManager) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTransaction indicates an expected call of WithTransaction.
+func (mr *MockTransactionManagerMockRecorder) WithTransaction(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockTransactionManager)(nil).WithTransaction), ctx, fn)
+}
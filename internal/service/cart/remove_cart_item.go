@@ -0,0 +1,24 @@
+package cart
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) RemoveCartItem(ctx context.Context, cartID string, customerID string, itemID string) (*domain.CartView, error) {
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		cart, err := s.lockOpenCart(txCtx, cartID, customerID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.Storage.DeleteCartItem(txCtx, cart.ID, itemID); err != nil {
+			return err
+		}
+		return s.touch(txCtx, cart)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetCart(ctx, cartID, customerID)
+}
@@ -0,0 +1,94 @@
+package cart
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"time"
+)
+
+//go:generate mockgen -source=service.go -destination=././mocks/cart_repository_mock.go -package=mocks
+
+type StorageRepository interface {
+	CreateCart(ctx context.Context, cart domain.Cart) error
+	GetCartByID(ctx context.Context, id string) (*domain.Cart, error)
+	UpdateCart(ctx context.Context, cart *domain.Cart) error
+	CreateCartItem(ctx context.Context, item domain.CartItem) error
+	UpdateCartItem(ctx context.Context, item *domain.CartItem) error
+	DeleteCartItem(ctx context.Context, cartID string, itemID string) error
+	DeleteExpiredCarts(ctx context.Context, before time.Time) (int, error)
+}
+
+type ProductService interface {
+	GetProductByID(ctx context.Context, id string) (*domain.Product, error)
+}
+
+// OrderService places the orders of the checkout, see order.Service.CreateOrders.
+type OrderService interface {
+	CreateOrders(ctx context.Context, requests []domain.OrderRequest, afterPlaced func(ctx context.Context, orders []domain.Order) error) ([]domain.Order, error)
+}
+
+type TransactionManager interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Service keeps the carts of the customers. The methods take the customerID of the token, a
+// cart of another customer is reported as not found; an empty customerID (admin) reaches
+// every cart. TTL is the inactivity after which a cart expires.
+type Service struct {
+	Storage            StorageRepository
+	TransactionManager TransactionManager
+	ProductService     ProductService
+	OrderService       OrderService
+	TTL                time.Duration
+	Now                func() time.Time
+}
+
+func NewService(storage StorageRepository, transactionManager TransactionManager, productService ProductService, orderService OrderService, ttl time.Duration) *Service {
+	return &Service{
+		Storage:            storage,
+		TransactionManager: transactionManager,
+		ProductService:     productService,
+		OrderService:       orderService,
+		TTL:                ttl,
+		Now:                time.Now,
+	}
+}
+
+// lockOpenCart locks the cart of the customer inside the transaction, it must still be open.
+func (s *Service) lockOpenCart(ctx context.Context, id string, customerID string) (*domain.Cart, error) {
+	cart, err := s.Storage.GetCartByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkOpen(cart, customerID); err != nil {
+		return nil, err
+	}
+	return cart, nil
+}
+
+func (s *Service) checkOpen(cart *domain.Cart, customerID string) error {
+	if err := checkOwner(cart, customerID); err != nil {
+		return err
+	}
+	if cart.Status == domain.CartCheckedOut {
+		return domain.ErrCartCheckedOut
+	}
+	if cart.ExpiredAt(s.Now()) {
+		return domain.ErrCartExpired
+	}
+	return nil
+}
+
+func checkOwner(cart *domain.Cart, customerID string) error {
+	if customerID != "" && cart.CustomerID != customerID {
+		return domain.ErrCartNotFound
+	}
+	return nil
+}
+
+// touch records the activity on the cart and pushes its expiry forward.
+func (s *Service) touch(ctx context.Context, cart *domain.Cart) error {
+	cart.UpdatedAt = s.Now()
+	cart.ExpiresAt = cart.UpdatedAt.Add(s.TTL)
+	return s.Storage.UpdateCart(ctx, cart)
+}
@@ -0,0 +1,29 @@
+package cart_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/service/cart"
+	"microservice-products-catalog/internal/service/cart/mocks"
+	"testing"
+	"time"
+)
+
+// TestNewService verifies that the service constructor correctly initializes
+// the service with its dependencies.
+func TestNewService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockTransaction := mocks.NewMockTransactionManager(ctrl)
+	mockProductService := mocks.NewMockProductService(ctrl)
+	mockOrderService := mocks.NewMockOrderService(ctrl)
+
+	service := cart.NewService(mockStorage, mockTransaction, mockProductService, mockOrderService, 72*time.Hour)
+
+	assert.NotNil(t, service)
+	assert.Equal(t, mockStorage, service.Storage, "Storage should be the provided mock instance")
+	assert.Equal(t, mockOrderService, service.OrderService, "OrderService should be the provided mock instance")
+	assert.Equal(t, 72*time.Hour, service.TTL)
+	assert.NotNil(t, service.Now, "Now should default to the wall clock")
+}
@@ -0,0 +1,59 @@
+package cart
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// UpdateCartItem sets the quantity of an item, the stock is read with ctx and not with the
+// transaction so the product is not locked, see AddCartItem.
+func (s *Service) UpdateCartItem(ctx context.Context, cartID string, customerID string, itemID string, quantity int) (*domain.CartView, error) {
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		cart, err := s.lockOpenCart(txCtx, cartID, customerID)
+		if err != nil {
+			return err
+		}
+
+		item := findItem(cart, itemID)
+		if item == nil {
+			return domain.ErrCartItemNotFound
+		}
+
+		if quantity > item.Quantity {
+			product, err := s.ProductService.GetProductByID(ctx, item.ProductID)
+			if err != nil {
+				return err
+			}
+			var variantID string
+			if item.VariantID != nil {
+				variantID = *item.VariantID
+			}
+			stock := product.Stock
+			if variant, _ := findVariant(product, variantID); variant != nil {
+				stock = variant.Stock
+			}
+			if quantity > stock {
+				return domain.ErrInsufficientStock
+			}
+		}
+
+		item.Quantity = quantity
+		if err := s.Storage.UpdateCartItem(txCtx, item); err != nil {
+			return err
+		}
+		return s.touch(txCtx, cart)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetCart(ctx, cartID, customerID)
+}
+
+func findItem(cart *domain.Cart, itemID string) *domain.CartItem {
+	for i := range cart.Items {
+		if cart.Items[i].ID == itemID {
+			return &cart.Items[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package category
+
+import (
+	"context"
+)
+
+func (s *Service) AddProductToCategory(ctx context.Context, categoryID string, productID string) error {
+	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := s.Storage.GetCategoryByID(txCtx, categoryID); err != nil {
+			return err
+		}
+		if _, err := s.ProductService.GetProductByID(txCtx, productID); err != nil {
+			return err
+		}
+		return s.Storage.AddProductToCategory(txCtx, categoryID, productID)
+	})
+}
@@ -0,0 +1,82 @@
+package category_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/category"
+	"microservice-products-catalog/internal/service/category/mocks"
+	"testing"
+)
+
+func TestAddProductToCategory(t *testing.T) {
+	categoryID := uuid.New().String()
+	productID := uuid.New().String()
+
+	type testCase struct {
+		testName      string
+		setupMock     func(storage *mocks.MockStorageRepository, productService *mocks.MockProductService)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Link product",
+			setupMock: func(storage *mocks.MockStorageRepository, productService *mocks.MockProductService) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(&domain.Category{ID: categoryID}, nil).Times(1)
+				productService.EXPECT().GetProductByID(gomock.Any(), productID).Return(&domain.Product{ID: productID}, nil).Times(1)
+				storage.EXPECT().AddProductToCategory(gomock.Any(), categoryID, productID).Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Failure - Product not found",
+			setupMock: func(storage *mocks.MockStorageRepository, productService *mocks.MockProductService) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(&domain.Category{ID: categoryID}, nil).Times(1)
+				productService.EXPECT().GetProductByID(gomock.Any(), productID).Return(nil, domain.ErrProductNotFound).Times(1)
+			},
+			expectedError: domain.ErrProductNotFound,
+		},
+		{
+			testName: "Failure - Category not found",
+			setupMock: func(storage *mocks.MockStorageRepository, productService *mocks.MockProductService) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(nil, domain.ErrCategoryNotFound).Times(1)
+			},
+			expectedError: domain.ErrCategoryNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTransaction.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			tc.setupMock(mockStorage, mockProductService)
+
+			service := category.NewService(mockStorage, mockTransaction, mockProductService)
+
+			// Act
+			err := service.AddProductToCategory(context.Background(), categoryID, productID)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
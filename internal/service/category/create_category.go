@@ -0,0 +1,22 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) CreateCategory(ctx context.Context, category domain.Category) error {
+	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if category.ParentID != nil {
+			_, err := s.Storage.GetCategoryByID(txCtx, *category.ParentID)
+			if errors.Is(err, domain.ErrCategoryNotFound) {
+				return domain.ErrCategoryParentNotFound
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return s.Storage.CreateCategory(txCtx, category)
+	})
+}
@@ -0,0 +1,84 @@
+package category_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/category"
+	"microservice-products-catalog/internal/service/category/mocks"
+	"testing"
+)
+
+func TestCreateCategory(t *testing.T) {
+	parentID := uuid.New().String()
+	root := domain.Category{ID: uuid.New().String(), Name: "Clothing"}
+	child := domain.Category{ID: uuid.New().String(), Name: "Shirts", ParentID: &parentID}
+
+	type testCase struct {
+		testName      string
+		input         domain.Category
+		setupMock     func(storage *mocks.MockStorageRepository)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Create root category",
+			input:    root,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().CreateCategory(gomock.Any(), root).Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Success - Create subcategory",
+			input:    child,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), parentID).Return(&domain.Category{ID: parentID}, nil).Times(1)
+				storage.EXPECT().CreateCategory(gomock.Any(), child).Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Failure - Parent category not found",
+			input:    child,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), parentID).Return(nil, domain.ErrCategoryNotFound).Times(1)
+			},
+			expectedError: domain.ErrCategoryParentNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTransaction.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			tc.setupMock(mockStorage)
+
+			service := category.NewService(mockStorage, mockTransaction, mockProductService)
+
+			// Act
+			err := service.CreateCategory(context.Background(), tc.input)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
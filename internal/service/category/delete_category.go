@@ -0,0 +1,26 @@
+package category
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// DeleteCategory removes a leaf category and its product links, a category with
+// subcategories must be emptied (or its children moved) first.
+func (s *Service) DeleteCategory(ctx context.Context, id string) error {
+	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := s.Storage.GetCategoryByID(txCtx, id); err != nil {
+			return err
+		}
+
+		descendants, err := s.Storage.GetCategoryDescendantIDs(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if len(descendants) > 1 {
+			return domain.ErrCategoryHasChildren
+		}
+
+		return s.Storage.DeleteCategory(txCtx, id)
+	})
+}
@@ -0,0 +1,81 @@
+package category_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/category"
+	"microservice-products-catalog/internal/service/category/mocks"
+	"testing"
+)
+
+func TestDeleteCategory(t *testing.T) {
+	categoryID := uuid.New().String()
+
+	type testCase struct {
+		testName      string
+		setupMock     func(storage *mocks.MockStorageRepository)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Delete leaf category",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(&domain.Category{ID: categoryID}, nil).Times(1)
+				storage.EXPECT().GetCategoryDescendantIDs(gomock.Any(), categoryID).Return([]string{categoryID}, nil).Times(1)
+				storage.EXPECT().DeleteCategory(gomock.Any(), categoryID).Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Failure - Category has children",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(&domain.Category{ID: categoryID}, nil).Times(1)
+				storage.EXPECT().GetCategoryDescendantIDs(gomock.Any(), categoryID).Return([]string{categoryID, uuid.New().String()}, nil).Times(1)
+			},
+			expectedError: domain.ErrCategoryHasChildren,
+		},
+		{
+			testName: "Failure - Category not found",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(nil, domain.ErrCategoryNotFound).Times(1)
+			},
+			expectedError: domain.ErrCategoryNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTransaction.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			tc.setupMock(mockStorage)
+
+			service := category.NewService(mockStorage, mockTransaction, mockProductService)
+
+			// Act
+			err := service.DeleteCategory(context.Background(), categoryID)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
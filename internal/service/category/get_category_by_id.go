@@ -0,0 +1,10 @@
+package category
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) GetCategoryByID(ctx context.Context, id string) (*domain.Category, error) {
+	return s.Storage.GetCategoryByID(ctx, id)
+}
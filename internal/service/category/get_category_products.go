@@ -0,0 +1,21 @@
+package category
+
+import (
+	"context"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetCategoryProducts lists the products linked to the category, and to all of its
+// subcategories when includeDescendants is set.
+func (s *Service) GetCategoryProducts(ctx context.Context, id string, includeDescendants bool, limit int) ([]domain.Product, error) {
+	if _, err := s.Storage.GetCategoryByID(ctx, id); err != nil {
+		return []domain.Product{}, err
+	}
+
+	products, err := s.Storage.GetCategoryProducts(ctx, id, includeDescendants, limit)
+	if err != nil {
+		return []domain.Product{}, fmt.Errorf("error fetching category products: %w", err)
+	}
+	return products, nil
+}
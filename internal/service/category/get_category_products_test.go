@@ -0,0 +1,76 @@
+package category_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/category"
+	"microservice-products-catalog/internal/service/category/mocks"
+	"testing"
+)
+
+func TestGetCategoryProducts(t *testing.T) {
+	categoryID := uuid.New().String()
+	mockProducts := []domain.Product{
+		{ID: uuid.New().String(), Name: "Gopher", Description: "Realistic replic for the Gopher animal", Price: domain.NewMoney(3223, domain.BaseCurrency), Stock: 50},
+	}
+
+	type testCase struct {
+		testName           string
+		includeDescendants bool
+		setupMock          func(storage *mocks.MockStorageRepository)
+		expectedProducts   []domain.Product
+		expectedError      error
+	}
+
+	testCases := []testCase{
+		{
+			testName:           "Success - Products of the category and its descendants",
+			includeDescendants: true,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(&domain.Category{ID: categoryID}, nil).Times(1)
+				storage.EXPECT().GetCategoryProducts(gomock.Any(), categoryID, true, 10).Return(mockProducts, nil).Times(1)
+			},
+			expectedProducts: mockProducts,
+		},
+		{
+			testName: "Failure - Category not found",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(nil, domain.ErrCategoryNotFound).Times(1)
+			},
+			expectedProducts: []domain.Product{},
+			expectedError:    domain.ErrCategoryNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			tc.setupMock(mockStorage)
+
+			service := category.NewService(mockStorage, mockTransaction, mockProductService)
+
+			// Act
+			products, err := service.GetCategoryProducts(context.Background(), categoryID, tc.includeDescendants, 10)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedProducts, products)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package category
+
+import (
+	"context"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetCategoryTree returns the root categories with their subcategories nested.
+func (s *Service) GetCategoryTree(ctx context.Context) ([]domain.CategoryNode, error) {
+	categories, err := s.Storage.GetCategories(ctx)
+	if err != nil {
+		return []domain.CategoryNode{}, fmt.Errorf("error fetching categories: %w", err)
+	}
+
+	ids := make(map[string]bool, len(categories))
+	children := make(map[string][]domain.Category, len(categories))
+	for _, category := range categories {
+		ids[category.ID] = true
+	}
+
+	var roots []domain.Category
+	for _, category := range categories {
+		if category.ParentID == nil || !ids[*category.ParentID] {
+			roots = append(roots, category)
+			continue
+		}
+		children[*category.ParentID] = append(children[*category.ParentID], category)
+	}
+
+	return buildCategoryNodes(roots, children), nil
+}
+
+func buildCategoryNodes(categories []domain.Category, children map[string][]domain.Category) []domain.CategoryNode {
+	nodes := make([]domain.CategoryNode, 0, len(categories))
+	for _, category := range categories {
+		nodes = append(nodes, domain.CategoryNode{
+			Category: category,
+			Children: buildCategoryNodes(children[category.ID], children),
+		})
+	}
+	return nodes
+}
@@ -0,0 +1,90 @@
+package category_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/category"
+	"microservice-products-catalog/internal/service/category/mocks"
+	"testing"
+)
+
+func TestGetCategoryTree(t *testing.T) {
+	clothingID, shirtsID, toysID := "clothing", "shirts", "toys"
+	clothing := domain.Category{ID: clothingID, Name: "Clothing"}
+	shirts := domain.Category{ID: shirtsID, Name: "Shirts", ParentID: &clothingID}
+	polos := domain.Category{ID: "polos", Name: "Polos", ParentID: &shirtsID}
+	toys := domain.Category{ID: toysID, Name: "Toys"}
+
+	dbError := errors.New("my sql connection failed")
+
+	type testCase struct {
+		testName      string
+		setupMock     func(storage *mocks.MockStorageRepository)
+		expectedTree  []domain.CategoryNode
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Nested tree",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategories(gomock.Any()).Return([]domain.Category{clothing, shirts, polos, toys}, nil).Times(1)
+			},
+			expectedTree: []domain.CategoryNode{
+				{Category: clothing, Children: []domain.CategoryNode{
+					{Category: shirts, Children: []domain.CategoryNode{
+						{Category: polos, Children: []domain.CategoryNode{}},
+					}},
+				}},
+				{Category: toys, Children: []domain.CategoryNode{}},
+			},
+		},
+		{
+			testName: "Success - No categories",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategories(gomock.Any()).Return([]domain.Category{}, nil).Times(1)
+			},
+			expectedTree: []domain.CategoryNode{},
+		},
+		{
+			testName: "Failure - Database fails when call to GetCategories()",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategories(gomock.Any()).Return(nil, dbError).Times(1)
+			},
+			expectedTree:  []domain.CategoryNode{},
+			expectedError: dbError,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			tc.setupMock(mockStorage)
+
+			service := category.NewService(mockStorage, mockTransaction, mockProductService)
+
+			// Act
+			tree, err := service.GetCategoryTree(context.Background())
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedTree, tree)
+		})
+	}
+}
@@ -0,0 +1,255 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "microservice-products-catalog/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStorageRepository is a mock of StorageRepository interface.
+type MockStorageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageRepositoryMockRecorder
+}
+
+// MockStorageRepositoryMockRecorder is the mock recorder for MockStorageRepository.
+type MockStorageRepositoryMockRecorder struct {
+	mock *MockStorageRepository
+}
+
+// NewMockStorageRepository creates a new mock instance.
+func NewMockStorageRepository(ctrl *gomock.Controller) *MockStorageRepository {
+	mock := &MockStorageRepository{ctrl: ctrl}
+	mock.recorder = &MockStorageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageRepository) EXPECT() *MockStorageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddProductToCategory mocks base method.
+func (m *MockStorageRepository) AddProductToCategory(ctx context.Context, categoryID, productID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProductToCategory", ctx, categoryID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddProductToCategory indicates an expected call of AddProductToCategory.
+func (mr *MockStorageRepositoryMockRecorder) AddProductToCategory(ctx, categoryID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductToCategory", reflect.TypeOf((*MockStorageRepository)(nil).AddProductToCategory), ctx, categoryID, productID)
+}
+
+// CreateCategory mocks base method.
+func (m *MockStorageRepository) CreateCategory(ctx context.Context, category domain.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockStorageRepositoryMockRecorder) CreateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockStorageRepository)(nil).CreateCategory), ctx, category)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockStorageRepository) DeleteCategory(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockStorageRepositoryMockRecorder) DeleteCategory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockStorageRepository)(nil).DeleteCategory), ctx, id)
+}
+
+// GetCategories mocks base method.
+func (m *MockStorageRepository) GetCategories(ctx context.Context) ([]domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategories", ctx)
+	ret0, _ := ret[0].([]domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategories indicates an expected call of GetCategories.
+func (mr *MockStorageRepositoryMockRecorder) GetCategories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockStorageRepository)(nil).GetCategories), ctx)
+}
+
+// GetCategoryByID mocks base method.
+func (m *MockStorageRepository) GetCategoryByID(ctx context.Context, id string) (*domain.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryByID indicates an expected call of GetCategoryByID.
+func (mr *MockStorageRepositoryMockRecorder) GetCategoryByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryByID", reflect.TypeOf((*MockStorageRepository)(nil).GetCategoryByID), ctx, id)
+}
+
+// GetCategoryDescendantIDs mocks base method.
+func (m *MockStorageRepository) GetCategoryDescendantIDs(ctx context.Context, id string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryDescendantIDs", ctx, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryDescendantIDs indicates an expected call of GetCategoryDescendantIDs.
+func (mr *MockStorageRepositoryMockRecorder) GetCategoryDescendantIDs(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryDescendantIDs", reflect.TypeOf((*MockStorageRepository)(nil).GetCategoryDescendantIDs), ctx, id)
+}
+
+// GetCategoryProducts mocks base method.
+func (m *MockStorageRepository) GetCategoryProducts(ctx context.Context, id string, includeDescendants bool, limit int) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryProducts", ctx, id, includeDescendants, limit)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryProducts indicates an expected call of GetCategoryProducts.
+func (mr *MockStorageRepositoryMockRecorder) GetCategoryProducts(ctx, id, includeDescendants, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryProducts", reflect.TypeOf((*MockStorageRepository)(nil).GetCategoryProducts), ctx, id, includeDescendants, limit)
+}
+
+// LockCategories mocks base method.
+func (m *MockStorageRepository) LockCategories(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCategories", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockCategories indicates an expected call of LockCategories.
+func (mr *MockStorageRepositoryMockRecorder) LockCategories(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCategories", reflect.TypeOf((*MockStorageRepository)(nil).LockCategories), ctx)
+}
+
+// RemoveProductFromCategory mocks base method.
+func (m *MockStorageRepository) RemoveProductFromCategory(ctx context.Context, categoryID, productID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveProductFromCategory", ctx, categoryID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveProductFromCategory indicates an expected call of RemoveProductFromCategory.
+func (mr *MockStorageRepositoryMockRecorder) RemoveProductFromCategory(ctx, categoryID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveProductFromCategory", reflect.TypeOf((*MockStorageRepository)(nil).RemoveProductFromCategory), ctx, categoryID, productID)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockStorageRepository) UpdateCategory(ctx context.Context, category *domain.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockStorageRepositoryMockRecorder) UpdateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockStorageRepository)(nil).UpdateCategory), ctx, category)
+}
+
+// MockProductService is a mock of ProductService interface.
+type MockProductService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductServiceMockRecorder
+}
+
+// MockProductServiceMockRecorder is the mock recorder for MockProductService.
+type MockProductServiceMockRecorder struct {
+	mock *MockProductService
+}
+
+// NewMockProductService creates a new mock instance.
+func NewMockProductService(ctrl *gomock.Controller) *MockProductService {
+	mock := &MockProductService{ctrl: ctrl}
+	mock.recorder = &MockProductServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductService) EXPECT() *MockProductServiceMockRecorder {
+	return m.recorder
+}
+
+// GetProductByID mocks base method.
+func (m *MockProductService) GetProductByID(ctx context.Context, id string) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductByID indicates an expected call of GetProductByID.
+func (mr *MockProductServiceMockRecorder) GetProductByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByID", reflect.TypeOf((*MockProductService)(nil).GetProductByID), ctx, id)
+}
+
+// MockTransactionManager is a mock of TransactionManager interface.
+type MockTransactionManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionManagerMockRecorder
+}
+
+// MockTransactionManagerMockRecorder is the mock recorder for MockTransactionManager.
+type MockTransactionManagerMockRecorder struct {
+	mock *MockTransactionManager
+}
+
+// NewMockTransactionManager creates a new mock instance.
+func NewMockTransactionManager(ctrl *gomock.Controller) *MockTransactionManager {
+	mock := &MockTransactionManager{ctrl: ctrl}
+	mock.recorder = &MockTransactionManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionManager) EXPECT() *MockTransactionManagerMockRecorder {
+	return m.recorder
+}
+
+// WithTransaction mocks base method.
+func (m *MockTransactionManager) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTransaction indicates an expected call of WithTransaction.
+func (mr *MockTransactionManagerMockRecorder) WithTransaction(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockTransactionManager)(nil).WithTransaction), ctx, fn)
+}
@@ -0,0 +1,14 @@
+package category
+
+import (
+	"context"
+)
+
+func (s *Service) RemoveProductFromCategory(ctx context.Context, categoryID string, productID string) error {
+	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := s.Storage.GetCategoryByID(txCtx, categoryID); err != nil {
+			return err
+		}
+		return s.Storage.RemoveProductFromCategory(txCtx, categoryID, productID)
+	})
+}
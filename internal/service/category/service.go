@@ -0,0 +1,43 @@
+package category
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+//go:generate mockgen -source=service.go -destination=././mocks/category_repository_mock.go -package=mocks
+
+type StorageRepository interface {
+	CreateCategory(ctx context.Context, category domain.Category) error
+	GetCategoryByID(ctx context.Context, id string) (*domain.Category, error)
+	GetCategories(ctx context.Context) ([]domain.Category, error)
+	UpdateCategory(ctx context.Context, category *domain.Category) error
+	DeleteCategory(ctx context.Context, id string) error
+	LockCategories(ctx context.Context) error
+	GetCategoryDescendantIDs(ctx context.Context, id string) ([]string, error)
+	GetCategoryProducts(ctx context.Context, id string, includeDescendants bool, limit int) ([]domain.Product, error)
+	AddProductToCategory(ctx context.Context, categoryID string, productID string) error
+	RemoveProductFromCategory(ctx context.Context, categoryID string, productID string) error
+}
+
+type ProductService interface {
+	GetProductByID(ctx context.Context, id string) (*domain.Product, error)
+}
+
+type TransactionManager interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Service struct {
+	Storage            StorageRepository
+	TransactionManager TransactionManager
+	ProductService     ProductService
+}
+
+func NewService(storage StorageRepository, transactionManager TransactionManager, productService ProductService) *Service {
+	return &Service{
+		Storage:            storage,
+		TransactionManager: transactionManager,
+		ProductService:     productService,
+	}
+}
@@ -0,0 +1,24 @@
+package category_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/service/category"
+	"microservice-products-catalog/internal/service/category/mocks"
+	"testing"
+)
+
+// TestNewService verifies that the service constructor correctly initializes
+// the service with its dependencies.
+func TestNewService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockTransaction := mocks.NewMockTransactionManager(ctrl)
+	mockProductService := mocks.NewMockProductService(ctrl)
+
+	service := category.NewService(mockStorage, mockTransaction, mockProductService)
+
+	assert.NotNil(t, service)
+	assert.Equal(t, mockStorage, service.Storage, "Storage should be the provided mock instance")
+}
@@ -0,0 +1,63 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"microservice-products-catalog/internal/domain"
+	"slices"
+)
+
+func (s *Service) UpdateCategory(ctx context.Context, id string, update domain.CategoryUpdate) error {
+	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		moving := update.ParentID != nil && *update.ParentID != ""
+		if moving {
+			// two concurrent moves can close a cycle without touching the same rows,
+			// so the moves are serialized over the whole tree
+			if err := s.Storage.LockCategories(txCtx); err != nil {
+				return err
+			}
+		}
+
+		category, err := s.Storage.GetCategoryByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		if update.Name != nil {
+			category.Name = *update.Name
+		}
+		if update.Description != nil {
+			category.Description = *update.Description
+		}
+
+		if update.ParentID != nil && !moving {
+			category.ParentID = nil
+		}
+
+		if moving {
+			parentID := *update.ParentID
+			if parentID == id {
+				return domain.ErrCategoryCycle
+			}
+
+			_, err := s.Storage.GetCategoryByID(txCtx, parentID)
+			if errors.Is(err, domain.ErrCategoryNotFound) {
+				return domain.ErrCategoryParentNotFound
+			}
+			if err != nil {
+				return err
+			}
+
+			descendants, err := s.Storage.GetCategoryDescendantIDs(txCtx, id)
+			if err != nil {
+				return err
+			}
+			if slices.Contains(descendants, parentID) {
+				return domain.ErrCategoryCycle
+			}
+			category.ParentID = &parentID
+		}
+
+		return s.Storage.UpdateCategory(txCtx, category)
+	})
+}
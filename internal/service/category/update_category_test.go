@@ -0,0 +1,130 @@
+package category_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/category"
+	"microservice-products-catalog/internal/service/category/mocks"
+	"testing"
+)
+
+func TestUpdateCategory(t *testing.T) {
+	categoryID := uuid.New().String()
+	parentID := uuid.New().String()
+	descendantID := uuid.New().String()
+	newName := "T-Shirts"
+	root := ""
+
+	type testCase struct {
+		testName      string
+		update        domain.CategoryUpdate
+		setupMock     func(storage *mocks.MockStorageRepository)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Rename category",
+			update:   domain.CategoryUpdate{Name: &newName},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(&domain.Category{ID: categoryID, Name: "Shirts", ParentID: &parentID}, nil).Times(1)
+				storage.EXPECT().
+					UpdateCategory(gomock.Any(), &domain.Category{ID: categoryID, Name: newName, ParentID: &parentID}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Success - Move category to another parent",
+			update:   domain.CategoryUpdate{ParentID: &parentID},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().LockCategories(gomock.Any()).Return(nil).Times(1)
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(&domain.Category{ID: categoryID}, nil).Times(1)
+				storage.EXPECT().GetCategoryByID(gomock.Any(), parentID).Return(&domain.Category{ID: parentID}, nil).Times(1)
+				storage.EXPECT().GetCategoryDescendantIDs(gomock.Any(), categoryID).Return([]string{categoryID, descendantID}, nil).Times(1)
+				storage.EXPECT().UpdateCategory(gomock.Any(), &domain.Category{ID: categoryID, ParentID: &parentID}).Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Success - Move category to the root",
+			update:   domain.CategoryUpdate{ParentID: &root},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(&domain.Category{ID: categoryID, ParentID: &parentID}, nil).Times(1)
+				storage.EXPECT().UpdateCategory(gomock.Any(), &domain.Category{ID: categoryID}).Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Failure - Category under itself",
+			update:   domain.CategoryUpdate{ParentID: &categoryID},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().LockCategories(gomock.Any()).Return(nil).Times(1)
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(&domain.Category{ID: categoryID}, nil).Times(1)
+			},
+			expectedError: domain.ErrCategoryCycle,
+		},
+		{
+			testName: "Failure - Category under one of its descendants",
+			update:   domain.CategoryUpdate{ParentID: &descendantID},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().LockCategories(gomock.Any()).Return(nil).Times(1)
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(&domain.Category{ID: categoryID}, nil).Times(1)
+				storage.EXPECT().GetCategoryByID(gomock.Any(), descendantID).Return(&domain.Category{ID: descendantID, ParentID: &categoryID}, nil).Times(1)
+				storage.EXPECT().GetCategoryDescendantIDs(gomock.Any(), categoryID).Return([]string{categoryID, descendantID}, nil).Times(1)
+			},
+			expectedError: domain.ErrCategoryCycle,
+		},
+		{
+			testName: "Failure - Parent category not found",
+			update:   domain.CategoryUpdate{ParentID: &parentID},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().LockCategories(gomock.Any()).Return(nil).Times(1)
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(&domain.Category{ID: categoryID}, nil).Times(1)
+				storage.EXPECT().GetCategoryByID(gomock.Any(), parentID).Return(nil, domain.ErrCategoryNotFound).Times(1)
+			},
+			expectedError: domain.ErrCategoryParentNotFound,
+		},
+		{
+			testName: "Failure - Category not found",
+			update:   domain.CategoryUpdate{Name: &newName},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCategoryByID(gomock.Any(), categoryID).Return(nil, domain.ErrCategoryNotFound).Times(1)
+			},
+			expectedError: domain.ErrCategoryNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTransaction.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			tc.setupMock(mockStorage)
+
+			service := category.NewService(mockStorage, mockTransaction, mockProductService)
+
+			// Act
+			err := service.UpdateCategory(context.Background(), categoryID, tc.update)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
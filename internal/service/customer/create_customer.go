@@ -0,0 +1,20 @@
+package customer
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"strings"
+)
+
+// CreateCustomer stores the email in lower case, two customers cannot share an email.
+func (s *Service) CreateCustomer(ctx context.Context, customer domain.Customer) (domain.Customer, error) {
+	now := s.Now()
+	customer.Email = strings.ToLower(strings.TrimSpace(customer.Email))
+	customer.CreatedAt = now
+	customer.UpdatedAt = now
+
+	if err := s.Storage.CreateCustomer(ctx, customer); err != nil {
+		return domain.Customer{}, err
+	}
+	return customer, nil
+}
@@ -0,0 +1,84 @@
+package customer_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/customer"
+	"microservice-products-catalog/internal/service/customer/mocks"
+	"testing"
+	"time"
+)
+
+func TestCreateCustomer(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	id := uuid.New().String()
+	dbError := errors.New("my sql connection failed")
+
+	type testCase struct {
+		testName      string
+		input         domain.Customer
+		setupMock     func(storage *mocks.MockStorageRepository)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Email is normalised and timestamps set",
+			input:    domain.Customer{ID: id, Email: "  Gopher@Example.com ", Name: "Gopher"},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().
+					CreateCustomer(gomock.Any(), domain.Customer{ID: id, Email: "gopher@example.com", Name: "Gopher", CreatedAt: now, UpdatedAt: now}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Failure - Email already taken",
+			input:    domain.Customer{ID: id, Email: "gopher@example.com", Name: "Gopher"},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().CreateCustomer(gomock.Any(), gomock.Any()).Return(domain.ErrCustomerAlreadyExists).Times(1)
+			},
+			expectedError: domain.ErrCustomerAlreadyExists,
+		},
+		{
+			testName: "Failure - Database error",
+			input:    domain.Customer{ID: id, Email: "gopher@example.com", Name: "Gopher"},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().CreateCustomer(gomock.Any(), gomock.Any()).Return(dbError).Times(1)
+			},
+			expectedError: dbError,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			tc.setupMock(mockStorage)
+
+			service := customer.NewService(mockStorage, mocks.NewMockTransactionManager(ctrl))
+			service.Now = func() time.Time { return now }
+
+			// Act
+			created, err := service.CreateCustomer(context.Background(), tc.input)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "gopher@example.com", created.Email)
+			assert.Equal(t, now, created.CreatedAt)
+		})
+	}
+}
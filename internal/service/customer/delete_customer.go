@@ -0,0 +1,10 @@
+package customer
+
+import (
+	"context"
+)
+
+// DeleteCustomer only deletes customers without orders, see domain.ErrCustomerHasOrders.
+func (s *Service) DeleteCustomer(ctx context.Context, id string) error {
+	return s.Storage.DeleteCustomer(ctx, id)
+}
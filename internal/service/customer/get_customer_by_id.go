@@ -0,0 +1,10 @@
+package customer
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) GetCustomerByID(ctx context.Context, id string) (*domain.Customer, error) {
+	return s.Storage.GetCustomerByID(ctx, id)
+}
@@ -0,0 +1,15 @@
+package customer
+
+import (
+	"context"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) GetCustomers(ctx context.Context, limit int) ([]domain.Customer, error) {
+	customers, err := s.Storage.GetCustomers(ctx, limit)
+	if err != nil {
+		return []domain.Customer{}, fmt.Errorf("get customers error: %w", err)
+	}
+	return customers, nil
+}
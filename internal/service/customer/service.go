@@ -0,0 +1,37 @@
+package customer
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"time"
+)
+
+//go:generate mockgen -source=service.go -destination=././mocks/customer_repository_mock.go -package=mocks
+
+type StorageRepository interface {
+	CreateCustomer(ctx context.Context, customer domain.Customer) error
+	GetCustomerByID(ctx context.Context, id string) (*domain.Customer, error)
+	GetCustomers(ctx context.Context, limit int) ([]domain.Customer, error)
+	UpdateCustomer(ctx context.Context, customer *domain.Customer) error
+	DeleteCustomer(ctx context.Context, id string) error
+}
+
+type TransactionManager interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Service owns the customers CRUD, the access rules (a customer only sees itself) are
+// enforced by the handlers from the token claims.
+type Service struct {
+	Storage            StorageRepository
+	TransactionManager TransactionManager
+	Now                func() time.Time
+}
+
+func NewService(storage StorageRepository, transactionManager TransactionManager) *Service {
+	return &Service{
+		Storage:            storage,
+		TransactionManager: transactionManager,
+		Now:                time.Now,
+	}
+}
@@ -0,0 +1,24 @@
+package customer_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/service/customer"
+	"microservice-products-catalog/internal/service/customer/mocks"
+	"testing"
+)
+
+// TestNewService verifies that the service constructor correctly initializes
+// the service with its dependencies.
+func TestNewService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockTransaction := mocks.NewMockTransactionManager(ctrl)
+
+	service := customer.NewService(mockStorage, mockTransaction)
+
+	assert.NotNil(t, service)
+	assert.Equal(t, mockStorage, service.Storage, "Storage should be the provided mock instance")
+	assert.NotNil(t, service.Now, "Now should default to the wall clock")
+}
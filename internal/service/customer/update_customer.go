@@ -0,0 +1,32 @@
+package customer
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"strings"
+)
+
+func (s *Service) UpdateCustomer(ctx context.Context, id string, update domain.CustomerUpdate) (*domain.Customer, error) {
+	var customer *domain.Customer
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		customer, err = s.Storage.GetCustomerByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		if update.Email != nil {
+			customer.Email = strings.ToLower(strings.TrimSpace(*update.Email))
+		}
+		if update.Name != nil {
+			customer.Name = *update.Name
+		}
+		customer.UpdatedAt = s.Now()
+
+		return s.Storage.UpdateCustomer(txCtx, customer)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return customer, nil
+}
@@ -0,0 +1,93 @@
+package customer_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/customer"
+	"microservice-products-catalog/internal/service/customer/mocks"
+	"testing"
+	"time"
+)
+
+func TestUpdateCustomer(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	id := uuid.New().String()
+	newEmail := "New.Gopher@Example.com"
+
+	existing := func() *domain.Customer {
+		return &domain.Customer{ID: id, Email: "gopher@example.com", Name: "Gopher"}
+	}
+
+	type testCase struct {
+		testName      string
+		update        domain.CustomerUpdate
+		setupMock     func(storage *mocks.MockStorageRepository)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Only the sent fields change",
+			update:   domain.CustomerUpdate{Email: &newEmail},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCustomerByID(gomock.Any(), id).Return(existing(), nil).Times(1)
+				storage.EXPECT().
+					UpdateCustomer(gomock.Any(), &domain.Customer{ID: id, Email: "new.gopher@example.com", Name: "Gopher", UpdatedAt: now}).
+					Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Failure - Email taken by another customer",
+			update:   domain.CustomerUpdate{Email: &newEmail},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCustomerByID(gomock.Any(), id).Return(existing(), nil).Times(1)
+				storage.EXPECT().UpdateCustomer(gomock.Any(), gomock.Any()).Return(domain.ErrCustomerAlreadyExists).Times(1)
+			},
+			expectedError: domain.ErrCustomerAlreadyExists,
+		},
+		{
+			testName: "Failure - Customer not found",
+			update:   domain.CustomerUpdate{Email: &newEmail},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetCustomerByID(gomock.Any(), id).Return(nil, domain.ErrCustomerNotFound).Times(1)
+			},
+			expectedError: domain.ErrCustomerNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockTransaction.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			tc.setupMock(mockStorage)
+
+			service := customer.NewService(mockStorage, mockTransaction)
+			service.Now = func() time.Time { return now }
+
+			// Act
+			_, err := service.UpdateCustomer(context.Background(), id, tc.update)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
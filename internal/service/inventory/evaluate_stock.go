@@ -0,0 +1,42 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"microservice-products-catalog/internal/domain"
+	"time"
+)
+
+// EvaluateStock raises a new alert when the product is below its reorder point and
+// there is no open alert for it yet, or resolves the open alerts once the stock was
+// replenished. It must run inside the transaction that changed the stock, the
+// returned alert (if any) is the one that has to be notified after the commit.
+func (s *Service) EvaluateStock(ctx context.Context, product *domain.Product) (*domain.StockAlert, error) {
+	if !product.BelowReorderPoint() {
+		return nil, s.Storage.ResolveStockAlerts(ctx, product.ID, time.Now())
+	}
+
+	_, err := s.Storage.GetOpenStockAlert(ctx, product.ID)
+	if err == nil {
+		// already alerted, wait until the stock is replenished
+		return nil, nil
+	}
+	if !errors.Is(err, domain.ErrStockAlertNotFound) {
+		return nil, err
+	}
+
+	alert := domain.StockAlert{
+		ID:              uuid.New().String(),
+		ProductID:       product.ID,
+		Stock:           product.AvailableStock(),
+		ReorderPoint:    product.ReorderPoint,
+		ReorderQuantity: product.ReorderQuantity,
+		CreatedAt:       time.Now(),
+	}
+
+	if err := s.Storage.CreateStockAlert(ctx, alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
@@ -0,0 +1,108 @@
+package inventory_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/inventory"
+	"microservice-products-catalog/internal/service/inventory/mocks"
+	"testing"
+)
+
+func TestEvaluateStock(t *testing.T) {
+	productID := uuid.New().String()
+	dbError := errors.New("my sql connection failed")
+
+	type testCase struct {
+		testName      string
+		input         *domain.Product
+		setupMock     func(storage *mocks.MockStorageRepository)
+		expectAlert   bool
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - raise alert when stock reaches the reorder point",
+			input:    &domain.Product{ID: productID, Stock: 5, ReorderPoint: 5, ReorderQuantity: 20},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetOpenStockAlert(gomock.Any(), productID).Return(nil, domain.ErrStockAlertNotFound).Times(1)
+				storage.EXPECT().
+					CreateStockAlert(gomock.Any(), gomock.AssignableToTypeOf(domain.StockAlert{})).
+					DoAndReturn(func(ctx context.Context, alert domain.StockAlert) error {
+						assert.Equal(t, productID, alert.ProductID)
+						assert.Equal(t, 5, alert.Stock)
+						assert.Equal(t, 20, alert.ReorderQuantity)
+						assert.Nil(t, alert.ResolvedAt)
+						return nil
+					}).Times(1)
+			},
+			expectAlert: true,
+		},
+		{
+			testName: "Success - alert is not duplicated while it is open",
+			input:    &domain.Product{ID: productID, Stock: 2, ReorderPoint: 5},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetOpenStockAlert(gomock.Any(), productID).Return(&domain.StockAlert{ID: uuid.New().String()}, nil).Times(1)
+			},
+			expectAlert: false,
+		},
+		{
+			testName: "Success - replenished stock resolves the open alerts",
+			input:    &domain.Product{ID: productID, Stock: 30, ReorderPoint: 5},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().ResolveStockAlerts(gomock.Any(), productID, gomock.Any()).Return(nil).Times(1)
+			},
+			expectAlert: false,
+		},
+		{
+			testName: "Success - zero reorder point disables the alerts",
+			input:    &domain.Product{ID: productID, Stock: 0, ReorderPoint: 0},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().ResolveStockAlerts(gomock.Any(), productID, gomock.Any()).Return(nil).Times(1)
+			},
+			expectAlert: false,
+		},
+		{
+			testName: "Failure - Database fails when looking for the open alert",
+			input:    &domain.Product{ID: productID, Stock: 1, ReorderPoint: 5},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetOpenStockAlert(gomock.Any(), productID).Return(nil, dbError).Times(1)
+			},
+			expectedError: dbError,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockNotifier := mocks.NewMockNotifier(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(mockStorage)
+			}
+
+			service := inventory.NewService(mockStorage, mockNotifier)
+
+			// Act
+			alert, err := service.EvaluateStock(context.Background(), tc.input)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectAlert, alert != nil)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) GetStockAlerts(ctx context.Context, onlyOpen bool) ([]domain.StockAlert, error) {
+	alerts, err := s.Storage.GetStockAlerts(ctx, onlyOpen)
+	if err != nil {
+		return []domain.StockAlert{}, fmt.Errorf("error fetching stock alerts: %w", err)
+	}
+	return alerts, nil
+}
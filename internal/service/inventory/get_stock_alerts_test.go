@@ -0,0 +1,79 @@
+package inventory_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/inventory"
+	"microservice-products-catalog/internal/service/inventory/mocks"
+	"testing"
+	"time"
+)
+
+func TestGetStockAlerts(t *testing.T) {
+	mockAlerts := []domain.StockAlert{
+		{ID: uuid.New().String(), ProductID: uuid.New().String(), Stock: 3, ReorderPoint: 5, ReorderQuantity: 20, CreatedAt: time.Now()},
+	}
+	dbError := errors.New("my sql connection failed")
+
+	type testCase struct {
+		testName       string
+		onlyOpen       bool
+		setupMock      func(storage *mocks.MockStorageRepository)
+		expectedAlerts []domain.StockAlert
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - fetch open alerts",
+			onlyOpen: true,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetStockAlerts(gomock.Any(), true).Return(mockAlerts, nil).Times(1)
+			},
+			expectedAlerts: mockAlerts,
+		},
+		{
+			testName: "Failure - Database fails when call to GetStockAlerts()",
+			onlyOpen: false,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetStockAlerts(gomock.Any(), false).Return(nil, dbError).Times(1)
+			},
+			expectedAlerts: []domain.StockAlert{},
+			expectedError:  dbError,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockNotifier := mocks.NewMockNotifier(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(mockStorage)
+			}
+
+			service := inventory.NewService(mockStorage, mockNotifier)
+
+			// Act
+			alerts, err := service.GetStockAlerts(context.Background(), tc.onlyOpen)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedAlerts, alerts)
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "microservice-products-catalog/internal/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStorageRepository is a mock of StorageRepository interface.
+type MockStorageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageRepositoryMockRecorder
+}
+
+// MockStorageRepositoryMockRecorder is the mock recorder for MockStorageRepository.
+type MockStorageRepositoryMockRecorder struct {
+	mock *MockStorageRepository
+}
+
+// NewMockStorageRepository creates a new mock instance.
+func NewMockStorageRepository(ctrl *gomock.Controller) *MockStorageRepository {
+	mock := &MockStorageRepository{ctrl: ctrl}
+	mock.recorder = &MockStorageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageRepository) EXPECT() *MockStorageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateStockAlert mocks base method.
+func (m *MockStorageRepository) CreateStockAlert(ctx context.Context, alert domain.StockAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStockAlert", ctx, alert)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStockAlert indicates an expected call of CreateStockAlert.
+func (mr *MockStorageRepositoryMockRecorder) CreateStockAlert(ctx, alert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStockAlert", reflect.TypeOf((*MockStorageRepository)(nil).CreateStockAlert), ctx, alert)
+}
+
+// GetOpenStockAlert mocks base method.
+func (m *MockStorageRepository) GetOpenStockAlert(ctx context.Context, productID string) (*domain.StockAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenStockAlert", ctx, productID)
+	ret0, _ := ret[0].(*domain.StockAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenStockAlert indicates an expected call of GetOpenStockAlert.
+func (mr *MockStorageRepositoryMockRecorder) GetOpenStockAlert(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenStockAlert", reflect.TypeOf((*MockStorageRepository)(nil).GetOpenStockAlert), ctx, productID)
+}
+
+// GetStockAlerts mocks base method.
+func (m *MockStorageRepository) GetStockAlerts(ctx context.Context, onlyOpen bool) ([]domain.StockAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStockAlerts", ctx, onlyOpen)
+	ret0, _ := ret[0].([]domain.StockAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStockAlerts indicates an expected call of GetStockAlerts.
+func (mr *MockStorageRepositoryMockRecorder) GetStockAlerts(ctx, onlyOpen interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStockAlerts", reflect.TypeOf((*MockStorageRepository)(nil).GetStockAlerts), ctx, onlyOpen)
+}
+
+// ResolveStockAlerts mocks base method.
+func (m *MockStorageRepository) ResolveStockAlerts(ctx context.Context, productID string, resolvedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveStockAlerts", ctx, productID, resolvedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveStockAlerts indicates an expected call of ResolveStockAlerts.
+func (mr *MockStorageRepositoryMockRecorder) ResolveStockAlerts(ctx, productID, resolvedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveStockAlerts", reflect.TypeOf((*MockStorageRepository)(nil).ResolveStockAlerts), ctx, productID, resolvedAt)
+}
+
+// MockNotifier is a mock of Notifier interface.
+type MockNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifierMockRecorder
+}
+
+// MockNotifierMockRecorder is the mock recorder for MockNotifier.
+type MockNotifierMockRecorder struct {
+	mock *MockNotifier
+}
+
+// NewMockNotifier creates a new mock instance.
+func NewMockNotifier(ctrl *gomock.Controller) *MockNotifier {
+	mock := &MockNotifier{ctrl: ctrl}
+	mock.recorder = &MockNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifier) EXPECT() *MockNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotifier) Notify(ctx context.Context, alert domain.StockAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, alert)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotifierMockRecorder) Notify(ctx, alert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotifier)(nil).Notify), ctx, alert)
+}
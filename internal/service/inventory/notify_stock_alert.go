@@ -0,0 +1,14 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) NotifyStockAlert(ctx context.Context, alert domain.StockAlert) error {
+	if err := s.Notifier.Notify(ctx, alert); err != nil {
+		return fmt.Errorf("error notifying stock alert %s: %w", alert.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package inventory
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"time"
+)
+
+//go:generate mockgen -source=service.go -destination=././mocks/inventory_repository_mock.go -package=mocks
+
+type StorageRepository interface {
+	GetOpenStockAlert(ctx context.Context, productID string) (*domain.StockAlert, error)
+	GetStockAlerts(ctx context.Context, onlyOpen bool) ([]domain.StockAlert, error)
+	CreateStockAlert(ctx context.Context, alert domain.StockAlert) error
+	ResolveStockAlerts(ctx context.Context, productID string, resolvedAt time.Time) error
+}
+
+// Notifier delivers the raised alerts to the people in charge of the replenishment.
+type Notifier interface {
+	Notify(ctx context.Context, alert domain.StockAlert) error
+}
+
+type Service struct {
+	Storage  StorageRepository
+	Notifier Notifier
+}
+
+func NewService(storage StorageRepository, notifier Notifier) *Service {
+	return &Service{
+		Storage:  storage,
+		Notifier: notifier,
+	}
+}
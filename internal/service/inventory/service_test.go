@@ -0,0 +1,24 @@
+package inventory_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/service/inventory"
+	"microservice-products-catalog/internal/service/inventory/mocks"
+	"testing"
+)
+
+// TestNewService verifies that the service constructor correctly initializes
+// the service with its dependencies.
+func TestNewService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockNotifier := mocks.NewMockNotifier(ctrl)
+
+	service := inventory.NewService(mockStorage, mockNotifier)
+
+	assert.NotNil(t, service)
+	assert.Equal(t, mockStorage, service.Storage, "Storage should be the provided mock instance")
+	assert.Equal(t, mockNotifier, service.Notifier, "Notifier should be the provided mock instance")
+}
@@ -4,39 +4,206 @@ import (
 	"context"
 	"github.com/google/uuid"
 	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
 	"time"
 )
 
-func (s *Service) CreateOrder(
-	ctx context.Context,
-	productID string,
-	quantity int,
-) error {
+// CreateOrder buys request.Quantity units of the variant. An empty VariantID buys the product
+// itself, which is only allowed while the product has no explicit variants. The total is
+// charged in request.Currency, the order keeps the exchange rate used for it, and is net of
+// the promotions and the coupon of the request. The taxes are those of request.Region, the
+// order keeps its subtotal, tax and grand total. The order belongs to request.CustomerID.
+//
+// The order is placed pending, which holds the stock, then paid and confirmed. When the
+// payment fails the order is compensated and the payment error is returned.
+func (s *Service) CreateOrder(ctx context.Context, request domain.OrderRequest) error {
+	if request.CustomerID == "" {
+		return domain.ErrCustomerRequired
+	}
 
-	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+	var order domain.Order
+	var alert *domain.StockAlert
 
-		product, err := s.ProductService.GetProductByID(txCtx, productID)
-		if err != nil {
+	quoter := s.PricingService.Quoter(ctx, request.Currency)
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+
+		// the customer row is locked so it cannot be deleted while the order is written
+		if _, err := s.Storage.GetCustomerByID(txCtx, request.CustomerID); err != nil {
 			return err
 		}
 
-		if product.Stock < quantity {
-			return domain.ErrInsufficientStock
-		}
+		var err error
+		order, alert, err = s.placeOrder(txCtx, request, quoter)
+		return err
+	})
+	if err != nil {
+		return err
+	}
 
-		product.Stock -= quantity
+	// the gateway is called outside the transaction, the pending order holds the stock meanwhile
+	if err := s.settleOrders(ctx, []domain.Order{order}); err != nil {
+		return err
+	}
 
-		if err := s.ProductService.SaveProduct(txCtx, product); err != nil {
-			return err
+	// the notification is sent once the stock decrement is committed, a failure here must not fail the order
+	if alert != nil {
+		if err := s.InventoryService.NotifyStockAlert(ctx, *alert); err != nil {
+			logging.FromContext(ctx).Error("error notifying stock alert", "error", err)
 		}
-		order := &domain.Order{
-			ID:        uuid.New().String(),
-			ProductID: productID,
-			Quantity:  quantity,
-			Total:     product.Price * float64(quantity),
-			Date:      time.Now(),
+	}
+	return nil
+}
+
+// placeOrder locks the product, decrements the stock and records the order, it must run
+// inside the order transaction. The order is priced by quoter, in request.Currency. The stock
+// alert is returned when the order crossed the reorder point, to be notified once the
+// transaction commits.
+func (s *Service) placeOrder(ctx context.Context, request domain.OrderRequest, quoter domain.Quoter) (domain.Order, *domain.StockAlert, error) {
+	quantity := request.Quantity
+	product, err := s.ProductService.GetProductByID(ctx, request.ProductID)
+	if err != nil {
+		return domain.Order{}, nil, err
+	}
+
+	if request.VariantID != "" {
+		return s.createVariantOrder(ctx, product, request, quoter)
+	}
+	if len(product.Variants) > 0 {
+		return domain.Order{}, nil, domain.ErrVariantRequired
+	}
+
+	if product.Stock < quantity {
+		return domain.Order{}, nil, domain.ErrInsufficientStock
+	}
+
+	quote, err := quoter.Quote(*product, nil)
+	if err != nil {
+		return domain.Order{}, nil, err
+	}
+	order, err := s.priceOrder(ctx, *product, request, quote)
+	if err != nil {
+		return domain.Order{}, nil, err
+	}
+
+	crossedReorderPoint := !product.BelowReorderPoint()
+	product.Stock -= quantity
+	crossedReorderPoint = crossedReorderPoint && product.BelowReorderPoint()
+
+	if err := s.ProductService.SaveProduct(ctx, product); err != nil {
+		return domain.Order{}, nil, err
+	}
+	if err := s.Storage.CreateOrder(ctx, order); err != nil {
+		return domain.Order{}, nil, err
+	}
+
+	if crossedReorderPoint {
+		alert, err := s.InventoryService.EvaluateStock(ctx, product)
+		return order, alert, err
+	}
+	return order, nil, nil
+}
+
+// createVariantOrder locks the variant row, decrements its stock and records the order
+// at the variant price. The reorder point of the product applies to the total stock of its
+// variants, the alert is returned as in placeOrder.
+func (s *Service) createVariantOrder(ctx context.Context, product *domain.Product, request domain.OrderRequest, quoter domain.Quoter) (domain.Order, *domain.StockAlert, error) {
+	quantity := request.Quantity
+	variant, err := s.ProductService.GetVariantByID(ctx, request.VariantID)
+	if err != nil {
+		return domain.Order{}, nil, err
+	}
+	if variant.ProductID != product.ID {
+		return domain.Order{}, nil, domain.ErrVariantNotFound
+	}
+
+	if variant.Stock < quantity {
+		return domain.Order{}, nil, domain.ErrInsufficientStock
+	}
+
+	quote, err := quoter.Quote(*product, variant)
+	if err != nil {
+		return domain.Order{}, nil, err
+	}
+	order, err := s.priceOrder(ctx, *product, request, quote)
+	if err != nil {
+		return domain.Order{}, nil, err
+	}
+	order.VariantID = &variant.ID
+	order.VariantSKU = &variant.SKU
+
+	crossedReorderPoint := !product.BelowReorderPoint()
+	variant.Stock -= quantity
+	for i := range product.Variants {
+		if product.Variants[i].ID == variant.ID {
+			product.Variants[i].Stock = variant.Stock
 		}
+	}
+	crossedReorderPoint = crossedReorderPoint && product.BelowReorderPoint()
+
+	if err := s.ProductService.SaveVariant(ctx, variant); err != nil {
+		return domain.Order{}, nil, err
+	}
+
+	if err := s.Storage.CreateOrder(ctx, order); err != nil {
+		return domain.Order{}, nil, err
+	}
 
-		return s.Storage.CreateOrder(txCtx, *order)
+	if crossedReorderPoint {
+		alert, err := s.InventoryService.EvaluateStock(ctx, product)
+		return order, alert, err
+	}
+	return order, nil, nil
+}
+
+// priceOrder builds the order at the quoted unit price, net of the discounts of the
+// promotions that apply, and adds the taxes of the destination region.
+func (s *Service) priceOrder(ctx context.Context, product domain.Product, request domain.OrderRequest, quote domain.PriceQuote) (domain.Order, error) {
+	discounts, err := s.PromotionService.ApplyPromotions(ctx, domain.OrderLine{
+		ProductID:  request.ProductID,
+		VariantID:  request.VariantID,
+		Quantity:   request.Quantity,
+		UnitPrice:  quote.Price,
+		CouponCode: request.CouponCode,
+	})
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	order := domain.Order{
+		ID:           uuid.New().String(),
+		ProductID:    request.ProductID,
+		ProductName:  product.Name,
+		CustomerID:   &request.CustomerID,
+		Quantity:     request.Quantity,
+		UnitPrice:    quote.Price,
+		Currency:     quote.Price.Currency,
+		ExchangeRate: quote.ExchangeRate,
+		Status:       domain.OrderPending,
+		Date:         time.Now(),
+		Discounts:    discounts,
+	}
+	charged := quote.Price.Mul(request.Quantity)
+	for i := range order.Discounts {
+		order.Discounts[i].OrderID = order.ID
+		charged = charged.Sub(order.Discounts[i].Amount)
+	}
+
+	breakdown, err := s.TaxCalculator.Calculate(ctx, domain.TaxRequest{
+		Region: request.Region,
+		Lines: []domain.TaxLine{{
+			ProductID:   product.ID,
+			CategoryIDs: product.CategoryIDs,
+			Quantity:    request.Quantity,
+			Amount:      charged,
+		}},
 	})
+	if err != nil {
+		return domain.Order{}, err
+	}
+
+	order.Subtotal = breakdown.Subtotal
+	order.Tax = breakdown.Tax
+	order.Total = breakdown.Total
+	order.TaxRegion = breakdown.Region
+	return order, nil
 }
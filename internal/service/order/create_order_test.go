@@ -18,15 +18,16 @@ func TestCreateOrder(t *testing.T) {
 		ID:          "076e76d6-fc3e-4f95-a024-1b4984e76060",
 		Name:        "Gopher",
 		Description: "Realistic replic for the Gopher animal",
-		Price:       65.42,
+		Price:       domain.NewMoney(6542, domain.BaseCurrency),
 		Stock:       50,
 	}
 
 	type testCase struct {
 		testName      string
 		productID     string
+		variantID     string
 		quantity      int
-		setupMock     func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager)
+		setupMock     func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager, mockInventory *mocks.MockInventoryService)
 		expectedError error
 	}
 
@@ -35,7 +36,7 @@ func TestCreateOrder(t *testing.T) {
 			testName:  "Success - create order",
 			productID: product.ID,
 			quantity:  5,
-			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager) {
+			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager, mockInventory *mocks.MockInventoryService) {
 				mockTxManager.EXPECT().
 					WithTransaction(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
@@ -60,7 +61,7 @@ func TestCreateOrder(t *testing.T) {
 			testName:  "Failure - Product not Found",
 			productID: "non-existent",
 			quantity:  5,
-			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager) {
+			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager, mockInventory *mocks.MockInventoryService) {
 				mockTxManager.EXPECT().
 					WithTransaction(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
@@ -77,7 +78,7 @@ func TestCreateOrder(t *testing.T) {
 			testName:  "Failure - Insufficient Stock",
 			productID: product.ID,
 			quantity:  100,
-			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager) {
+			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager, mockInventory *mocks.MockInventoryService) {
 				mockTxManager.EXPECT().
 					WithTransaction(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
@@ -90,6 +91,183 @@ func TestCreateOrder(t *testing.T) {
 			},
 			expectedError: domain.ErrInsufficientStock,
 		},
+		{
+			testName:  "Success - create order crossing the reorder point raises an alert",
+			productID: "a6f1a0e4-5b7e-4a35-9d0b-3f3f6a5f7c10",
+			quantity:  5,
+			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager, mockInventory *mocks.MockInventoryService) {
+				lowStockProduct := &domain.Product{ID: "a6f1a0e4-5b7e-4a35-9d0b-3f3f6a5f7c10", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 12, ReorderPoint: 10, ReorderQuantity: 40}
+				alert := &domain.StockAlert{ID: "alert-1", ProductID: lowStockProduct.ID, Stock: 7, ReorderPoint: 10}
+
+				mockTxManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+
+				mockProductService.EXPECT().
+					GetProductByID(gomock.Any(), lowStockProduct.ID).
+					Return(lowStockProduct, nil).Times(1)
+
+				mockProductService.EXPECT().
+					SaveProduct(gomock.Any(), lowStockProduct).
+					Return(nil).Times(1)
+
+				mockStorage.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					Return(nil).Times(1)
+
+				mockInventory.EXPECT().
+					EvaluateStock(gomock.Any(), lowStockProduct).
+					Return(alert, nil).Times(1)
+
+				mockInventory.EXPECT().
+					NotifyStockAlert(gomock.Any(), *alert).
+					Return(nil).Times(1)
+			},
+			expectedError: nil,
+		},
+		{
+			testName:  "Success - notification failure does not fail the order",
+			productID: "c1d1e3a2-7e0f-4a4b-8f59-0b6a3c2e9d21",
+			quantity:  1,
+			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager, mockInventory *mocks.MockInventoryService) {
+				lowStockProduct := &domain.Product{ID: "c1d1e3a2-7e0f-4a4b-8f59-0b6a3c2e9d21", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 3, ReorderPoint: 2}
+				alert := &domain.StockAlert{ID: "alert-2", ProductID: lowStockProduct.ID, Stock: 2, ReorderPoint: 2}
+
+				mockTxManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+				mockProductService.EXPECT().GetProductByID(gomock.Any(), lowStockProduct.ID).Return(lowStockProduct, nil).Times(1)
+				mockProductService.EXPECT().SaveProduct(gomock.Any(), lowStockProduct).Return(nil).Times(1)
+				mockStorage.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockInventory.EXPECT().EvaluateStock(gomock.Any(), lowStockProduct).Return(alert, nil).Times(1)
+				mockInventory.EXPECT().NotifyStockAlert(gomock.Any(), *alert).Return(errors.New("webhook is down")).Times(1)
+			},
+			expectedError: nil,
+		},
+		{
+			testName:  "Success - create order of a variant decrements the variant stock",
+			productID: "5f8c3e1a-2b4d-4c6e-8a0f-1d3b5e7f9a21",
+			variantID: "variant-m-blue",
+			quantity:  2,
+			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager, mockInventory *mocks.MockInventoryService) {
+				variantPrice := domain.NewMoney(2500, domain.BaseCurrency)
+				shirt := &domain.Product{ID: "5f8c3e1a-2b4d-4c6e-8a0f-1d3b5e7f9a21", Price: domain.NewMoney(2000, domain.BaseCurrency), Stock: 0}
+				variant := &domain.Variant{ID: "variant-m-blue", ProductID: shirt.ID, SKU: "SHIRT-M-BLUE", Price: &variantPrice, Stock: 5}
+				shirt.Variants = []domain.Variant{*variant}
+
+				mockTxManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+				mockProductService.EXPECT().GetProductByID(gomock.Any(), shirt.ID).Return(shirt, nil).Times(1)
+				mockProductService.EXPECT().GetVariantByID(gomock.Any(), variant.ID).Return(variant, nil).Times(1)
+				mockProductService.EXPECT().
+					SaveVariant(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, v *domain.Variant) error {
+						assert.Equal(t, 3, v.Stock)
+						return nil
+					}).Times(1)
+				mockStorage.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, o domain.Order) error {
+						assert.Equal(t, variant.ID, *o.VariantID)
+						assert.Equal(t, domain.NewMoney(5000, domain.BaseCurrency), o.Total)
+						return nil
+					}).Times(1)
+			},
+			expectedError: nil,
+		},
+		{
+			testName:  "Success - variant order alerts on the total stock of the variants",
+			productID: "5f8c3e1a-2b4d-4c6e-8a0f-1d3b5e7f9a21",
+			variantID: "variant-m-blue",
+			quantity:  3,
+			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager, mockInventory *mocks.MockInventoryService) {
+				shirt := &domain.Product{ID: "5f8c3e1a-2b4d-4c6e-8a0f-1d3b5e7f9a21", Price: domain.NewMoney(2000, domain.BaseCurrency), ReorderPoint: 4}
+				variant := &domain.Variant{ID: "variant-m-blue", ProductID: shirt.ID, Stock: 5}
+				shirt.Variants = []domain.Variant{*variant, {ID: "variant-l-blue", ProductID: shirt.ID, Stock: 1}}
+				alert := &domain.StockAlert{ID: "alert-3", ProductID: shirt.ID, Stock: 3, ReorderPoint: 4}
+
+				mockTxManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+				mockProductService.EXPECT().GetProductByID(gomock.Any(), shirt.ID).Return(shirt, nil).Times(1)
+				mockProductService.EXPECT().GetVariantByID(gomock.Any(), variant.ID).Return(variant, nil).Times(1)
+				mockProductService.EXPECT().SaveVariant(gomock.Any(), variant).Return(nil).Times(1)
+				mockStorage.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				mockInventory.EXPECT().
+					EvaluateStock(gomock.Any(), shirt).
+					DoAndReturn(func(ctx context.Context, product *domain.Product) (*domain.StockAlert, error) {
+						assert.Equal(t, 3, product.AvailableStock())
+						return alert, nil
+					}).Times(1)
+				mockInventory.EXPECT().NotifyStockAlert(gomock.Any(), *alert).Return(nil).Times(1)
+			},
+			expectedError: nil,
+		},
+		{
+			testName:  "Failure - Product with variants requires a variant",
+			productID: "5f8c3e1a-2b4d-4c6e-8a0f-1d3b5e7f9a21",
+			quantity:  1,
+			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager, mockInventory *mocks.MockInventoryService) {
+				shirt := &domain.Product{ID: "5f8c3e1a-2b4d-4c6e-8a0f-1d3b5e7f9a21", Price: domain.NewMoney(2000, domain.BaseCurrency), Stock: 10, Variants: []domain.Variant{{ID: "variant-m-blue"}}}
+
+				mockTxManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+				mockProductService.EXPECT().GetProductByID(gomock.Any(), shirt.ID).Return(shirt, nil).Times(1)
+			},
+			expectedError: domain.ErrVariantRequired,
+		},
+		{
+			testName:  "Failure - Variant of another product",
+			productID: "5f8c3e1a-2b4d-4c6e-8a0f-1d3b5e7f9a21",
+			variantID: "variant-of-mug",
+			quantity:  1,
+			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager, mockInventory *mocks.MockInventoryService) {
+				shirt := &domain.Product{ID: "5f8c3e1a-2b4d-4c6e-8a0f-1d3b5e7f9a21", Price: domain.NewMoney(2000, domain.BaseCurrency)}
+
+				mockTxManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+				mockProductService.EXPECT().GetProductByID(gomock.Any(), shirt.ID).Return(shirt, nil).Times(1)
+				mockProductService.EXPECT().
+					GetVariantByID(gomock.Any(), "variant-of-mug").
+					Return(&domain.Variant{ID: "variant-of-mug", ProductID: "mug", Stock: 10}, nil).Times(1)
+			},
+			expectedError: domain.ErrVariantNotFound,
+		},
+		{
+			testName:  "Failure - Insufficient variant stock",
+			productID: "5f8c3e1a-2b4d-4c6e-8a0f-1d3b5e7f9a21",
+			variantID: "variant-m-blue",
+			quantity:  6,
+			setupMock: func(mockStorage *mocks.MockStorageRepository, mockProductService *mocks.MockProductService, mockTxManager *mocks.MockTransactionManager, mockInventory *mocks.MockInventoryService) {
+				shirt := &domain.Product{ID: "5f8c3e1a-2b4d-4c6e-8a0f-1d3b5e7f9a21", Price: domain.NewMoney(2000, domain.BaseCurrency), Stock: 100}
+
+				mockTxManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+				mockProductService.EXPECT().GetProductByID(gomock.Any(), shirt.ID).Return(shirt, nil).Times(1)
+				mockProductService.EXPECT().
+					GetVariantByID(gomock.Any(), "variant-m-blue").
+					Return(&domain.Variant{ID: "variant-m-blue", ProductID: shirt.ID, Stock: 5}, nil).Times(1)
+			},
+			expectedError: domain.ErrInsufficientStock,
+		},
 	}
 
 	for i := range testCases {
@@ -100,16 +278,25 @@ func TestCreateOrder(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockStorage.EXPECT().NextInvoiceNumber(gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockStorage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).AnyTimes()
 			productServiceMock := mocks.NewMockProductService(ctrl)
 			txManagerMock := mocks.NewMockTransactionManager(ctrl)
+			inventoryMock := mocks.NewMockInventoryService(ctrl)
+			pricingMock := mocks.NewMockPricingService(ctrl)
+			pricingMock.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).AnyTimes()
+			promotionMock := mocks.NewMockPromotionService(ctrl)
+			promotionMock.EXPECT().ApplyPromotions(gomock.Any(), gomock.Any()).Return([]domain.OrderDiscount{}, nil).AnyTimes()
+			taxMock := mocks.NewMockTaxCalculator(ctrl)
+			taxMock.EXPECT().Calculate(gomock.Any(), gomock.Any()).DoAndReturn(noTax).AnyTimes()
 
 			if tc.setupMock != nil {
-				tc.setupMock(mockStorage, productServiceMock, txManagerMock)
+				tc.setupMock(mockStorage, productServiceMock, txManagerMock, inventoryMock)
 			}
 
-			service := order.NewService(mockStorage, txManagerMock, productServiceMock)
+			service := order.NewService(mockStorage, txManagerMock, productServiceMock, inventoryMock, pricingMock, promotionMock, taxMock, approvedPayments(ctrl, mockStorage, txManagerMock))
 
-			err := service.CreateOrder(context.Background(), tc.productID, tc.quantity)
+			err := service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: tc.productID, VariantID: tc.variantID, Quantity: tc.quantity})
 
 			if tc.expectedError != nil {
 				assert.Error(t, err)
@@ -123,15 +310,239 @@ func TestCreateOrder(t *testing.T) {
 
 }
 
+const customerID = "5f3c2b1a-0d9e-4c8b-a7f6-e5d4c3b2a190"
+
+// noTax is a tax calculator for a region without taxes, the lines are charged as they are.
+func noTax(_ context.Context, request domain.TaxRequest) (domain.TaxBreakdown, error) {
+	currency := request.Lines[0].Amount.Currency
+	breakdown := domain.TaxBreakdown{Region: "US", Subtotal: domain.NewMoney(0, currency), Tax: domain.NewMoney(0, currency)}
+	for _, line := range request.Lines {
+		breakdown.Subtotal = breakdown.Subtotal.Add(line.Amount)
+		breakdown.Lines = append(breakdown.Lines, domain.LineTax{Subtotal: line.Amount, Tax: breakdown.Tax, Total: line.Amount})
+	}
+	breakdown.Total = breakdown.Subtotal
+	return breakdown, nil
+}
+
+// approvedPayments is a gateway that authorises and captures every order, the orders are
+// confirmed in the storage in a transaction of their own.
+func approvedPayments(ctrl *gomock.Controller, storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager) *mocks.MockPaymentGateway {
+	txManager.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).AnyTimes()
+	storage.EXPECT().UpdateOrderPayment(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	gateway := mocks.NewMockPaymentGateway(ctrl)
+	gateway.EXPECT().Authorize(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request domain.PaymentRequest) (domain.PaymentAuthorization, error) {
+		return domain.PaymentAuthorization{ID: "auth-" + request.OrderID, Amount: request.Amount}, nil
+	}).AnyTimes()
+	gateway.EXPECT().Capture(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return gateway
+}
+
+func TestCreateOrder_Currency(t *testing.T) {
+	rate := 0.92
+
+	type testCase struct {
+		testName      string
+		quoter        domain.Quoter
+		listPrices    []domain.Money
+		expectedOrder func(o domain.Order)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - converted total keeps the exchange rate",
+			quoter:   domain.Quoter{Currency: "EUR", Rate: rate},
+			expectedOrder: func(o domain.Order) {
+				assert.Equal(t, domain.NewMoney(2760, "EUR"), o.Total)
+				assert.Equal(t, "EUR", o.Currency)
+				assert.Equal(t, &rate, o.ExchangeRate)
+			},
+		},
+		{
+			testName:   "Success - list price has no exchange rate",
+			quoter:     domain.Quoter{Currency: "EUR", RateErr: domain.ErrExchangeRateNotFound},
+			listPrices: []domain.Money{domain.NewMoney(899, "EUR")},
+			expectedOrder: func(o domain.Order) {
+				assert.Equal(t, domain.NewMoney(2697, "EUR"), o.Total)
+				assert.Equal(t, "EUR", o.Currency)
+				assert.Nil(t, o.ExchangeRate)
+			},
+		},
+		{
+			testName:      "Failure - Exchange rate not found",
+			quoter:        domain.Quoter{Currency: "EUR", RateErr: domain.ErrExchangeRateNotFound},
+			expectedError: domain.ErrExchangeRateNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			product := &domain.Product{ID: "9d0e7c1b-3a4f-4b2e-8c6d-5f1a2b3c4d5e", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 10, Prices: tc.listPrices}
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockStorage.EXPECT().NextInvoiceNumber(gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockStorage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).AnyTimes()
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTxManager := mocks.NewMockTransactionManager(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
+			mockPricing := mocks.NewMockPricingService(ctrl)
+			mockPromotion := mocks.NewMockPromotionService(ctrl)
+			mockPromotion.EXPECT().ApplyPromotions(gomock.Any(), gomock.Any()).Return([]domain.OrderDiscount{}, nil).AnyTimes()
+			mockTax := mocks.NewMockTaxCalculator(ctrl)
+			mockTax.EXPECT().Calculate(gomock.Any(), gomock.Any()).DoAndReturn(noTax).AnyTimes()
+
+			// the rate is resolved before the transaction locks the product
+			gomock.InOrder(
+				mockPricing.EXPECT().Quoter(gomock.Any(), "EUR").Return(tc.quoter).Times(1),
+				mockTxManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					}).Times(1),
+			)
+			mockProductService.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(product, nil).Times(1)
+			if tc.expectedOrder != nil {
+				mockProductService.EXPECT().SaveProduct(gomock.Any(), product).Return(nil).Times(1)
+				mockStorage.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, o domain.Order) error {
+						tc.expectedOrder(o)
+						return nil
+					}).Times(1)
+			}
+
+			service := order.NewService(mockStorage, mockTxManager, mockProductService, mockInventory, mockPricing, mockPromotion, mockTax, approvedPayments(ctrl, mockStorage, mockTxManager))
+
+			// Act
+			err := service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: product.ID, Quantity: 3, Currency: "EUR"})
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateOrder_Promotions(t *testing.T) {
+	promotionID := "4c2f8a7e-1d3b-4e5f-9a6b-7c8d9e0f1a2b"
+	couponCode := "SUMMER10"
+
+	type testCase struct {
+		testName      string
+		discounts     []domain.OrderDiscount
+		applyErr      error
+		expectedTotal domain.Money
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - total is net of the discounts",
+			discounts: []domain.OrderDiscount{
+				{PromotionID: &promotionID, Name: "3x2", Amount: domain.NewMoney(1000, domain.BaseCurrency)},
+				{PromotionID: &promotionID, Name: "Summer coupon", CouponCode: &couponCode, Amount: domain.NewMoney(250, domain.BaseCurrency)},
+			},
+			// 3 x 10.00 - 10.00 - 2.50
+			expectedTotal: domain.NewMoney(1750, domain.BaseCurrency),
+		},
+		{
+			testName:      "Failure - coupon usage limit reached rolls back the order",
+			applyErr:      domain.ErrCouponUsageLimitReached,
+			expectedError: domain.ErrCouponUsageLimitReached,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			product := &domain.Product{ID: "b7e4c1d2-9f3a-4b5c-8d6e-0a1b2c3d4e5f", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 10}
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockStorage.EXPECT().NextInvoiceNumber(gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockStorage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).AnyTimes()
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTxManager := mocks.NewMockTransactionManager(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
+			mockPricing := mocks.NewMockPricingService(ctrl)
+			mockPromotion := mocks.NewMockPromotionService(ctrl)
+			mockTax := mocks.NewMockTaxCalculator(ctrl)
+			mockTax.EXPECT().Calculate(gomock.Any(), gomock.Any()).DoAndReturn(noTax).AnyTimes()
+
+			mockTxManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			mockProductService.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(product, nil).Times(1)
+			mockPricing.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).Times(1)
+			mockPromotion.EXPECT().
+				ApplyPromotions(gomock.Any(), domain.OrderLine{ProductID: product.ID, Quantity: 3, UnitPrice: product.Price, CouponCode: couponCode}).
+				Return(tc.discounts, tc.applyErr).Times(1)
+			if tc.expectedError == nil {
+				mockProductService.EXPECT().SaveProduct(gomock.Any(), product).Return(nil).Times(1)
+				mockStorage.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, o domain.Order) error {
+						assert.Equal(t, tc.expectedTotal, o.Total)
+						assert.Len(t, o.Discounts, len(tc.discounts))
+						for _, discount := range o.Discounts {
+							assert.Equal(t, o.ID, discount.OrderID)
+						}
+						return nil
+					}).Times(1)
+			}
+
+			service := order.NewService(mockStorage, mockTxManager, mockProductService, mockInventory, mockPricing, mockPromotion, mockTax, approvedPayments(ctrl, mockStorage, mockTxManager))
+
+			// Act
+			err := service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: product.ID, Quantity: 3, CouponCode: couponCode})
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestCreateOrder_Concurrent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockStorage.EXPECT().NextInvoiceNumber(gomock.Any()).Return(int64(1), nil).AnyTimes()
+	mockStorage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).AnyTimes()
 	mockProductService := mocks.NewMockProductService(ctrl)
 	mockTxManager := mocks.NewMockTransactionManager(ctrl)
+	mockInventory := mocks.NewMockInventoryService(ctrl)
+	mockPricing := mocks.NewMockPricingService(ctrl)
+	mockPricing.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).AnyTimes()
+	mockPromotion := mocks.NewMockPromotionService(ctrl)
+	mockPromotion.EXPECT().ApplyPromotions(gomock.Any(), gomock.Any()).Return([]domain.OrderDiscount{}, nil).AnyTimes()
+	mockTax := mocks.NewMockTaxCalculator(ctrl)
+	mockTax.EXPECT().Calculate(gomock.Any(), gomock.Any()).DoAndReturn(noTax).AnyTimes()
 
-	service := order.NewService(mockStorage, mockTxManager, mockProductService)
+	service := order.NewService(mockStorage, mockTxManager, mockProductService, mockInventory, mockPricing, mockPromotion, mockTax, approvedPayments(ctrl, mockStorage, mockTxManager))
 
 	const (
 		initialStock = 50
@@ -159,7 +570,7 @@ func TestCreateOrder_Concurrent(t *testing.T) {
 			return &domain.Product{
 				ID:    productID,
 				Stock: int(stock.Load()),
-				Price: 10,
+				Price: domain.NewMoney(1000, domain.BaseCurrency),
 			}, nil
 		}).
 		AnyTimes()
@@ -192,7 +603,7 @@ func TestCreateOrder_Concurrent(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			err := service.CreateOrder(context.Background(), productID, orderQty)
+			err := service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: productID, Quantity: orderQty})
 			errs <- err
 		}()
 	}
@@ -218,3 +629,190 @@ func TestCreateOrder_Concurrent(t *testing.T) {
 	assert.Equal(t, 2, insufficient)
 	assert.Equal(t, int32(2), stock.Load()) // 50 - (8 * 6) = 2
 }
+
+func TestCreateOrder_Tax(t *testing.T) {
+	categoryID := "2d4f6a8c-0e1b-4c3d-9e5f-7a9b1c3d5e7f"
+
+	type testCase struct {
+		testName      string
+		region        string
+		breakdown     domain.TaxBreakdown
+		taxErr        error
+		expectedOrder domain.Order
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - order keeps subtotal, tax and grand total",
+			region:   "AR",
+			breakdown: domain.TaxBreakdown{
+				Region:   "AR",
+				Subtotal: domain.NewMoney(2479, domain.BaseCurrency),
+				Tax:      domain.NewMoney(521, domain.BaseCurrency),
+				Total:    domain.NewMoney(3000, domain.BaseCurrency),
+			},
+			expectedOrder: domain.Order{
+				Subtotal:  domain.NewMoney(2479, domain.BaseCurrency),
+				Tax:       domain.NewMoney(521, domain.BaseCurrency),
+				Total:     domain.NewMoney(3000, domain.BaseCurrency),
+				TaxRegion: "AR",
+			},
+		},
+		{
+			testName:      "Failure - Unknown tax region",
+			region:        "XX",
+			taxErr:        domain.ErrTaxRegionNotFound,
+			expectedError: domain.ErrTaxRegionNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			product := &domain.Product{ID: "e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a5b", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 10, CategoryIDs: []string{categoryID}}
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockStorage.EXPECT().NextInvoiceNumber(gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockStorage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).AnyTimes()
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTxManager := mocks.NewMockTransactionManager(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
+			mockPricing := mocks.NewMockPricingService(ctrl)
+			mockPromotion := mocks.NewMockPromotionService(ctrl)
+			mockPromotion.EXPECT().ApplyPromotions(gomock.Any(), gomock.Any()).Return([]domain.OrderDiscount{}, nil).AnyTimes()
+			mockTax := mocks.NewMockTaxCalculator(ctrl)
+
+			mockTxManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			mockProductService.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(product, nil).Times(1)
+			mockPricing.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).Times(1)
+			mockTax.EXPECT().
+				Calculate(gomock.Any(), domain.TaxRequest{Region: tc.region, Lines: []domain.TaxLine{
+					{ProductID: product.ID, CategoryIDs: []string{categoryID}, Quantity: 3, Amount: domain.NewMoney(3000, domain.BaseCurrency)},
+				}}).
+				Return(tc.breakdown, tc.taxErr).Times(1)
+			if tc.expectedError == nil {
+				mockProductService.EXPECT().SaveProduct(gomock.Any(), product).Return(nil).Times(1)
+				mockStorage.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, o domain.Order) error {
+						assert.Equal(t, tc.expectedOrder.Subtotal, o.Subtotal)
+						assert.Equal(t, tc.expectedOrder.Tax, o.Tax)
+						assert.Equal(t, tc.expectedOrder.Total, o.Total)
+						assert.Equal(t, tc.expectedOrder.TaxRegion, o.TaxRegion)
+						return nil
+					}).Times(1)
+			}
+
+			service := order.NewService(mockStorage, mockTxManager, mockProductService, mockInventory, mockPricing, mockPromotion, mockTax, approvedPayments(ctrl, mockStorage, mockTxManager))
+
+			// Act
+			err := service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: product.ID, Quantity: 3, Region: tc.region})
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateOrder_Customer(t *testing.T) {
+	dbError := errors.New("my sql connection failed")
+
+	type testCase struct {
+		testName      string
+		customerID    string
+		customerErr   error
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName:   "Success - order belongs to the customer",
+			customerID: customerID,
+		},
+		{
+			testName:      "Failure - Customer is required",
+			expectedError: domain.ErrCustomerRequired,
+		},
+		{
+			testName:      "Failure - Customer not found",
+			customerID:    customerID,
+			customerErr:   domain.ErrCustomerNotFound,
+			expectedError: domain.ErrCustomerNotFound,
+		},
+		{
+			testName:      "Failure - Database error reading the customer",
+			customerID:    customerID,
+			customerErr:   dbError,
+			expectedError: dbError,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			product := &domain.Product{ID: "c3d4e5f6-a7b8-4c9d-8e0f-1a2b3c4d5e6f", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 10}
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockStorage.EXPECT().NextInvoiceNumber(gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTxManager := mocks.NewMockTransactionManager(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
+			mockPricing := mocks.NewMockPricingService(ctrl)
+			mockPricing.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).AnyTimes()
+			mockPromotion := mocks.NewMockPromotionService(ctrl)
+			mockPromotion.EXPECT().ApplyPromotions(gomock.Any(), gomock.Any()).Return([]domain.OrderDiscount{}, nil).AnyTimes()
+			mockTax := mocks.NewMockTaxCalculator(ctrl)
+			mockTax.EXPECT().Calculate(gomock.Any(), gomock.Any()).DoAndReturn(noTax).AnyTimes()
+
+			if tc.customerID != "" {
+				mockTxManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+				mockStorage.EXPECT().GetCustomerByID(gomock.Any(), tc.customerID).Return(&domain.Customer{ID: tc.customerID}, tc.customerErr).Times(1)
+			}
+			if tc.expectedError == nil {
+				mockProductService.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(product, nil).Times(1)
+				mockProductService.EXPECT().SaveProduct(gomock.Any(), product).Return(nil).Times(1)
+				mockStorage.EXPECT().
+					CreateOrder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, o domain.Order) error {
+						assert.Equal(t, &tc.customerID, o.CustomerID)
+						return nil
+					}).Times(1)
+			}
+
+			service := order.NewService(mockStorage, mockTxManager, mockProductService, mockInventory, mockPricing, mockPromotion, mockTax, approvedPayments(ctrl, mockStorage, mockTxManager))
+
+			// Act
+			err := service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: tc.customerID, ProductID: product.ID, Quantity: 1})
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
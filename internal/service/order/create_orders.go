@@ -0,0 +1,144 @@
+package order
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"slices"
+)
+
+// CreateOrders places one order per request in a single transaction, all of them or none. The
+// stock of every line is checked before placing any, so a shortage is reported for all the
+// lines at once in a *domain.CheckoutConflictError. afterPlaced, when set, runs inside the
+// transaction with the placed orders and rolls them back by returning an error.
+//
+// Once committed the orders are paid and confirmed together, when the payment fails all of
+// them are compensated and the payment error is returned.
+func (s *Service) CreateOrders(ctx context.Context, requests []domain.OrderRequest, afterPlaced func(ctx context.Context, orders []domain.Order) error) ([]domain.Order, error) {
+	for _, request := range requests {
+		if request.CustomerID == "" {
+			return nil, domain.ErrCustomerRequired
+		}
+	}
+
+	// the rows are locked in product order so two checkouts sharing products cannot deadlock
+	requests = slices.Clone(requests)
+	slices.SortFunc(requests, func(a, b domain.OrderRequest) int {
+		return cmp.Or(cmp.Compare(a.ProductID, b.ProductID), cmp.Compare(a.VariantID, b.VariantID))
+	})
+
+	var orders []domain.Order
+	var alerts []domain.StockAlert
+
+	quoters := map[string]domain.Quoter{}
+	for _, request := range requests {
+		if _, ok := quoters[request.Currency]; !ok {
+			quoters[request.Currency] = s.PricingService.Quoter(ctx, request.Currency)
+		}
+	}
+
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		orders, alerts = nil, nil
+
+		customers := map[string]bool{}
+		for _, request := range requests {
+			if customers[request.CustomerID] {
+				continue
+			}
+			if _, err := s.Storage.GetCustomerByID(txCtx, request.CustomerID); err != nil {
+				return err
+			}
+			customers[request.CustomerID] = true
+		}
+
+		var conflicts []domain.LineConflict
+		for _, request := range requests {
+			conflict, err := s.checkOrderLine(txCtx, request)
+			if err != nil {
+				return err
+			}
+			if conflict != nil {
+				conflicts = append(conflicts, *conflict)
+			}
+		}
+		if len(conflicts) > 0 {
+			return &domain.CheckoutConflictError{Lines: conflicts}
+		}
+
+		for _, request := range requests {
+			order, alert, err := s.placeOrder(txCtx, request, quoters[request.Currency])
+			if err != nil {
+				return err
+			}
+			orders = append(orders, order)
+			if alert != nil {
+				alerts = append(alerts, *alert)
+			}
+		}
+
+		if afterPlaced != nil {
+			return afterPlaced(txCtx, orders)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.settleOrders(ctx, orders); err != nil {
+		return nil, err
+	}
+
+	for _, alert := range alerts {
+		if err := s.InventoryService.NotifyStockAlert(ctx, alert); err != nil {
+			logging.FromContext(ctx).Error("error notifying stock alert", "error", err)
+		}
+	}
+	return orders, nil
+}
+
+// checkOrderLine locks the product (and the variant) of the request and reports why it cannot
+// be ordered, nil when it can.
+func (s *Service) checkOrderLine(ctx context.Context, request domain.OrderRequest) (*domain.LineConflict, error) {
+	conflict := &domain.LineConflict{
+		ProductID: request.ProductID,
+		VariantID: request.VariantID,
+		Requested: request.Quantity,
+	}
+
+	product, err := s.ProductService.GetProductByID(ctx, request.ProductID)
+	if errors.Is(err, domain.ErrProductNotFound) {
+		conflict.Reason = domain.LineProductNotFound
+		return conflict, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	stock := product.Stock
+	switch {
+	case request.VariantID != "":
+		variant, err := s.ProductService.GetVariantByID(ctx, request.VariantID)
+		if errors.Is(err, domain.ErrVariantNotFound) || (err == nil && variant.ProductID != product.ID) {
+			conflict.Reason = domain.LineVariantNotFound
+			return conflict, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		stock = variant.Stock
+
+	case len(product.Variants) > 0:
+		conflict.Reason = domain.LineVariantRequired
+		return conflict, nil
+	}
+
+	if stock < request.Quantity {
+		conflict.Available = stock
+		conflict.Reason = domain.LineInsufficientStock
+		return conflict, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,130 @@
+package order_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/order"
+	"microservice-products-catalog/internal/service/order/mocks"
+	"testing"
+)
+
+func TestCreateOrders(t *testing.T) {
+	afterPlacedErr := errors.New("cart already checked out")
+
+	type testCase struct {
+		testName          string
+		gopherStock       int
+		shirtStock        int
+		afterPlacedErr    error
+		expectedOrders    int
+		expectedConflicts []domain.LineConflict
+		expectedError     error
+	}
+
+	testCases := []testCase{
+		{
+			testName:       "Success - every line is placed in the same transaction",
+			gopherStock:    10,
+			shirtStock:     10,
+			expectedOrders: 2,
+		},
+		{
+			testName:    "Failure - every line without stock is reported and nothing is placed",
+			gopherStock: 1,
+			shirtStock:  0,
+			expectedConflicts: []domain.LineConflict{
+				{ProductID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Requested: 2, Available: 1, Reason: domain.LineInsufficientStock},
+				{ProductID: "9b1f3c2e-6a4d-4f8b-8e7a-5d2c1b0a9f86", VariantID: "5e4d3c2b-1a09-4f8e-9d7c-6b5a4f3e2d1c", Requested: 1, Available: 0, Reason: domain.LineInsufficientStock},
+			},
+			expectedError: domain.ErrCheckoutConflict,
+		},
+		{
+			testName:       "Failure - afterPlaced rolls the orders back",
+			gopherStock:    10,
+			shirtStock:     10,
+			afterPlacedErr: afterPlacedErr,
+			expectedError:  afterPlacedErr,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			customerID := "5f3c2b1a-0d9e-4c8b-a7f6-e5d4c3b2a190"
+			gopher := &domain.Product{ID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: tc.gopherStock}
+			shirt := &domain.Product{ID: "9b1f3c2e-6a4d-4f8b-8e7a-5d2c1b0a9f86", Price: domain.NewMoney(2500, domain.BaseCurrency)}
+			variant := &domain.Variant{ID: "5e4d3c2b-1a09-4f8e-9d7c-6b5a4f3e2d1c", ProductID: shirt.ID, Stock: tc.shirtStock}
+			shirt.Variants = []domain.Variant{*variant}
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockStorage.EXPECT().NextInvoiceNumber(gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTxManager := mocks.NewMockTransactionManager(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
+			mockPricing := mocks.NewMockPricingService(ctrl)
+			mockPricing.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).AnyTimes()
+			mockPromotion := mocks.NewMockPromotionService(ctrl)
+			mockPromotion.EXPECT().ApplyPromotions(gomock.Any(), gomock.Any()).Return([]domain.OrderDiscount{}, nil).AnyTimes()
+			mockTax := mocks.NewMockTaxCalculator(ctrl)
+			mockTax.EXPECT().Calculate(gomock.Any(), gomock.Any()).DoAndReturn(noTax).AnyTimes()
+
+			mockTxManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			mockStorage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).Times(1)
+			mockProductService.EXPECT().GetProductByID(gomock.Any(), gopher.ID).Return(gopher, nil).AnyTimes()
+			mockProductService.EXPECT().GetProductByID(gomock.Any(), shirt.ID).Return(shirt, nil).AnyTimes()
+			mockProductService.EXPECT().GetVariantByID(gomock.Any(), variant.ID).Return(variant, nil).AnyTimes()
+			if tc.expectedConflicts == nil {
+				mockProductService.EXPECT().SaveProduct(gomock.Any(), gopher).Return(nil).Times(1)
+				mockProductService.EXPECT().SaveVariant(gomock.Any(), variant).Return(nil).Times(1)
+				mockStorage.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			}
+
+			service := order.NewService(mockStorage, mockTxManager, mockProductService, mockInventory, mockPricing, mockPromotion, mockTax, approvedPayments(ctrl, mockStorage, mockTxManager))
+
+			// the shirt is requested first, the lines are placed in product order
+			requests := []domain.OrderRequest{
+				{CustomerID: customerID, ProductID: shirt.ID, VariantID: variant.ID, Quantity: 1},
+				{CustomerID: customerID, ProductID: gopher.ID, Quantity: 2},
+			}
+
+			// Act
+			var placed []domain.Order
+			orders, err := service.CreateOrders(context.Background(), requests, func(ctx context.Context, orders []domain.Order) error {
+				placed = orders
+				return tc.afterPlacedErr
+			})
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, orders)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, orders, tc.expectedOrders)
+				assert.Equal(t, placed, orders)
+				assert.Equal(t, gopher.ID, orders[0].ProductID)
+				assert.Equal(t, 8, gopher.Stock)
+				assert.Equal(t, 9, variant.Stock)
+			}
+
+			var conflictErr *domain.CheckoutConflictError
+			if tc.expectedConflicts != nil {
+				require.ErrorAs(t, err, &conflictErr)
+				assert.Equal(t, tc.expectedConflicts, conflictErr.Lines)
+			}
+		})
+	}
+}
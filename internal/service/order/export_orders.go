@@ -0,0 +1,16 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+)
+
+// ExportOrders calls fn for every order matching the filter while they are read from
+// the storage, so the orders table is never loaded in memory.
+func (s *Service) ExportOrders(ctx context.Context, filter domain.OrderFilter, fn func(order domain.Order) error) error {
+	if err := s.Storage.StreamOrders(ctx, filter, fn); err != nil {
+		return fmt.Errorf("export orders error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package order_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/order"
+	"microservice-products-catalog/internal/service/order/mocks"
+	"testing"
+	"time"
+)
+
+func TestExportOrders(t *testing.T) {
+	mocksOrders := []domain.Order{
+		{ID: uuid.New().String(), ProductID: uuid.New().String(), Quantity: 5, Total: domain.NewMoney(3223, domain.BaseCurrency), Date: time.Now()},
+		{ID: uuid.New().String(), ProductID: uuid.New().String(), Quantity: 7, Total: domain.NewMoney(2190, domain.BaseCurrency), Date: time.Now()},
+	}
+	from := time.Date(2026, time.September, 1, 0, 0, 0, 0, time.UTC)
+	filter := domain.OrderFilter{From: &from}
+	dbError := errors.New("my sql connection failed")
+
+	type testCase struct {
+		testName       string
+		setupMock      func(storage *mocks.MockStorageRepository)
+		expectedOrders []domain.Order
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - every streamed order reaches the callback",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().
+					StreamOrders(gomock.Any(), filter, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, filter domain.OrderFilter, fn func(domain.Order) error) error {
+						for _, o := range mocksOrders {
+							if err := fn(o); err != nil {
+								return err
+							}
+						}
+						return nil
+					}).Times(1)
+			},
+			expectedOrders: mocksOrders,
+		},
+		{
+			testName: "Failure - Database fails while streaming",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().StreamOrders(gomock.Any(), filter, gomock.Any()).Return(dbError).Times(1)
+			},
+			expectedError: dbError,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			productService := mocks.NewMockProductService(ctrl)
+			inventoryService := mocks.NewMockInventoryService(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(mockStorage)
+			}
+
+			service := order.NewService(mockStorage, mockTransaction, productService, inventoryService, nil, nil, nil, nil)
+
+			// Act
+			var exported []domain.Order
+			err := service.ExportOrders(context.Background(), filter, func(o domain.Order) error {
+				exported = append(exported, o)
+				return nil
+			})
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedOrders, exported)
+		})
+	}
+}
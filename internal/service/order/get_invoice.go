@@ -0,0 +1,34 @@
+package order
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetInvoice returns the invoice of the order with the names and prices of the purchase,
+// customerID restricts it to the orders of a customer like GetOrderByID. Only the confirmed
+// orders have an invoice, the orders pending, not paid or placed before the invoices existed
+// have none.
+func (s *Service) GetInvoice(ctx context.Context, orderID string, customerID string) (*domain.Invoice, error) {
+	order, err := s.GetOrderByID(ctx, orderID, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != domain.OrderConfirmed || order.InvoiceNumber == nil {
+		return nil, domain.ErrInvoiceNotFound
+	}
+
+	invoice := &domain.Invoice{
+		Number:   domain.FormatInvoiceNumber(*order.InvoiceNumber),
+		IssuedAt: order.Date,
+		Order:    *order,
+	}
+	if order.CustomerID != nil {
+		customer, err := s.Storage.GetCustomerByID(ctx, *order.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+		invoice.Customer = customer
+	}
+	return invoice, nil
+}
@@ -0,0 +1,85 @@
+package order_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/order"
+	"microservice-products-catalog/internal/service/order/mocks"
+	"testing"
+	"time"
+)
+
+func TestGetInvoice(t *testing.T) {
+	orderID := uuid.New().String()
+	owner := uuid.New().String()
+	number := int64(42)
+	date := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+
+	type testCase struct {
+		testName       string
+		stored         domain.Order
+		expectedNumber string
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			testName:       "Success - invoice of a confirmed order",
+			stored:         domain.Order{ID: orderID, CustomerID: &owner, InvoiceNumber: &number, ProductName: "Gopher plush", Quantity: 1, Status: domain.OrderConfirmed, Date: date},
+			expectedNumber: "INV-000042",
+		},
+		{
+			testName:      "Failure - pending order has no invoice yet",
+			stored:        domain.Order{ID: orderID, CustomerID: &owner, Quantity: 1, Status: domain.OrderPending},
+			expectedError: domain.ErrInvoiceNotFound,
+		},
+		{
+			testName:      "Failure - order whose payment failed has no invoice",
+			stored:        domain.Order{ID: orderID, CustomerID: &owner, InvoiceNumber: &number, Quantity: 1, Status: domain.OrderPaymentFailed},
+			expectedError: domain.ErrInvoiceNotFound,
+		},
+		{
+			testName:      "Failure - order placed before the invoices",
+			stored:        domain.Order{ID: orderID, CustomerID: &owner, Quantity: 1, Status: domain.OrderConfirmed},
+			expectedError: domain.ErrInvoiceNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			stored := tc.stored
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockStorage.EXPECT().GetOrderByID(gomock.Any(), orderID).Return(&stored, nil).Times(1)
+			mockStorage.EXPECT().GetOrderRefunds(gomock.Any(), orderID).Return([]domain.Refund{}, nil).Times(1)
+			if tc.expectedError == nil {
+				mockStorage.EXPECT().GetCustomerByID(gomock.Any(), owner).Return(&domain.Customer{ID: owner, Name: "Ada"}, nil).Times(1)
+			}
+
+			service := order.NewService(mockStorage, mocks.NewMockTransactionManager(ctrl), nil, nil, nil, nil, nil, nil)
+
+			// Act
+			invoice, err := service.GetInvoice(context.Background(), orderID, owner)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, invoice)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedNumber, invoice.Number)
+			assert.Equal(t, date, invoice.IssuedAt)
+			assert.Equal(t, "Gopher plush", invoice.Order.ProductName)
+			assert.Equal(t, "Ada", invoice.Customer.Name)
+		})
+	}
+}
@@ -6,11 +6,32 @@ import (
 	"microservice-products-catalog/internal/domain"
 )
 
-func (s *Service) GetOrders(ctx context.Context) ([]domain.Order, error) {
-	orders, err := s.Storage.GetOrders(ctx)
+// GetOrders lists the orders matching the filter, filter.CustomerID restricts them to the
+// orders of a customer.
+func (s *Service) GetOrders(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, error) {
+	orders, err := s.Storage.GetOrders(ctx, filter)
 	if err != nil {
 		return []domain.Order{}, fmt.Errorf("get orders error: %w", err)
 	}
 	return orders, nil
 
 }
+
+// GetOrderByID returns the order with its refunds, a non empty customerID only finds the
+// orders of that customer so the orders of the others are reported as not found.
+func (s *Service) GetOrderByID(ctx context.Context, id string, customerID string) (*domain.Order, error) {
+	order, err := s.Storage.GetOrderByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if customerID != "" && (order.CustomerID == nil || *order.CustomerID != customerID) {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	refunds, err := s.Storage.GetOrderRefunds(ctx, order.ID)
+	if err != nil {
+		return nil, err
+	}
+	order.ApplyRefunds(refunds)
+	return order, nil
+}
@@ -16,8 +16,8 @@ import (
 func TestGetProducts(t *testing.T) {
 
 	mocksOrders := []domain.Order{
-		{ID: uuid.New().String(), ProductID: uuid.New().String(), Quantity: 5, Total: 32.23, Date: time.Now()},
-		{ID: uuid.New().String(), ProductID: uuid.New().String(), Quantity: 7, Total: 21.90, Date: time.Now()},
+		{ID: uuid.New().String(), ProductID: uuid.New().String(), Quantity: 5, Total: domain.NewMoney(3223, domain.BaseCurrency), Date: time.Now()},
+		{ID: uuid.New().String(), ProductID: uuid.New().String(), Quantity: 7, Total: domain.NewMoney(2190, domain.BaseCurrency), Date: time.Now()},
 	}
 
 	dbError := errors.New("my sql connection failed")
@@ -33,7 +33,7 @@ func TestGetProducts(t *testing.T) {
 		{
 			testName: "Success - Fetch Orders",
 			setupMock: func(storage *mocks.MockStorageRepository) {
-				storage.EXPECT().GetOrders(gomock.Any()).Return(mocksOrders, nil).Times(1)
+				storage.EXPECT().GetOrders(gomock.Any(), domain.OrderFilter{}).Return(mocksOrders, nil).Times(1)
 			},
 			expectedProducts: mocksOrders,
 			expectedError:    nil,
@@ -42,7 +42,7 @@ func TestGetProducts(t *testing.T) {
 			testName: "Success - Products table is empty",
 			setupMock: func(storage *mocks.MockStorageRepository) {
 				storage.EXPECT().
-					GetOrders(gomock.Any()).
+					GetOrders(gomock.Any(), domain.OrderFilter{}).
 					Return([]domain.Order{}, nil).
 					Times(1)
 			},
@@ -53,7 +53,7 @@ func TestGetProducts(t *testing.T) {
 			testName: "Failure - Database fails when call to GetOrders()",
 			setupMock: func(storage *mocks.MockStorageRepository) {
 				storage.EXPECT().
-					GetOrders(gomock.Any()).
+					GetOrders(gomock.Any(), domain.OrderFilter{}).
 					Return([]domain.Order{}, dbError).
 					Times(1)
 			},
@@ -74,14 +74,15 @@ func TestGetProducts(t *testing.T) {
 			mockTransaction := mocks.NewMockTransactionManager(ctrl)
 			mockStorage := mocks.NewMockStorageRepository(ctrl)
 			productService := mocks.NewMockProductService(ctrl)
+			inventoryService := mocks.NewMockInventoryService(ctrl)
 			if tc.setupMock != nil {
 				tc.setupMock(mockStorage)
 			}
 
-			service := order.NewService(mockStorage, mockTransaction, productService)
+			service := order.NewService(mockStorage, mockTransaction, productService, inventoryService, nil, nil, nil, nil)
 
 			// Act
-			_, err := service.GetOrders(context.Background())
+			_, err := service.GetOrders(context.Background(), domain.OrderFilter{})
 
 			// Assert
 			if tc.expectedError != nil {
@@ -96,3 +97,88 @@ func TestGetProducts(t *testing.T) {
 
 	}
 }
+
+func TestGetOrderByID(t *testing.T) {
+	orderID := uuid.New().String()
+	owner := uuid.New().String()
+	total := domain.NewMoney(3000, domain.BaseCurrency)
+	stored := &domain.Order{ID: orderID, CustomerID: &owner, ProductID: uuid.New().String(), Quantity: 3, Total: total}
+	legacy := &domain.Order{ID: orderID, ProductID: uuid.New().String(), Quantity: 1, Total: total}
+	refunds := []domain.Refund{{ID: uuid.New().String(), OrderID: orderID, Quantity: 1, Amount: domain.NewMoney(1000, domain.BaseCurrency)}}
+
+	type testCase struct {
+		testName      string
+		customerID    string
+		stored        *domain.Order
+		storageErr    error
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName:   "Success - Customer reads its own order",
+			customerID: owner,
+			stored:     stored,
+		},
+		{
+			testName: "Success - Admin reads any order",
+			stored:   legacy,
+		},
+		{
+			testName:      "Failure - Order of another customer is not found",
+			customerID:    uuid.New().String(),
+			stored:        stored,
+			expectedError: domain.ErrOrderNotFound,
+		},
+		{
+			testName:      "Failure - Order without customer is not found for a customer",
+			customerID:    owner,
+			stored:        legacy,
+			expectedError: domain.ErrOrderNotFound,
+		},
+		{
+			testName:      "Failure - Order not found",
+			customerID:    owner,
+			storageErr:    domain.ErrOrderNotFound,
+			expectedError: domain.ErrOrderNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			var stored *domain.Order
+			if tc.stored != nil {
+				copied := *tc.stored
+				stored = &copied
+			}
+			mockStorage.EXPECT().GetOrderByID(gomock.Any(), orderID).Return(stored, tc.storageErr).Times(1)
+			if tc.expectedError == nil {
+				mockStorage.EXPECT().GetOrderRefunds(gomock.Any(), orderID).Return(refunds, nil).Times(1)
+			}
+
+			service := order.NewService(mockStorage, mocks.NewMockTransactionManager(ctrl), nil, nil, nil, nil, nil, nil)
+
+			// Act
+			got, err := service.GetOrderByID(context.Background(), orderID, tc.customerID)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.stored.ID, got.ID)
+				assert.Equal(t, refunds, got.Refunds)
+				assert.Equal(t, domain.NewMoney(2000, domain.BaseCurrency), *got.NetPaid)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"go.opentelemetry.io/otel/trace"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/tracing"
+)
+
+// Outcomes of the orders counted by InstrumentedService.
+const (
+	OutcomeCreated           = "created"
+	OutcomeInsufficientStock = "insufficient_stock"
+	OutcomeNotFound          = "not_found"
+	OutcomePaymentFailed     = "payment_failed"
+	OutcomeFailed            = "failed"
+)
+
+// OutcomeRecorder counts the orders by outcome, see metrics.Metrics.
+type OutcomeRecorder interface {
+	RecordOrders(outcome string, count int)
+}
+
+// InstrumentedService decorates the Service with a span per method and counts the outcome
+// of every order placed.
+type InstrumentedService struct {
+	*Service
+	Recorder OutcomeRecorder
+	Tracer   trace.Tracer
+}
+
+func NewInstrumentedService(service *Service, recorder OutcomeRecorder, tracer trace.Tracer) *InstrumentedService {
+	return &InstrumentedService{
+		Service:  service,
+		Recorder: recorder,
+		Tracer:   tracer,
+	}
+}
+
+func (s *InstrumentedService) CreateOrder(ctx context.Context, request domain.OrderRequest) error {
+	ctx, span := s.Tracer.Start(ctx, "order.Service.CreateOrder")
+	err := s.Service.CreateOrder(ctx, request)
+	tracing.End(span, err)
+
+	s.Recorder.RecordOrders(orderOutcome(err), 1)
+	return err
+}
+
+// CreateOrders counts every order of a placed batch as created. A conflict counts each of
+// its lines by reason, any other error counts the batch once.
+func (s *InstrumentedService) CreateOrders(ctx context.Context, requests []domain.OrderRequest, afterPlaced func(ctx context.Context, orders []domain.Order) error) ([]domain.Order, error) {
+	ctx, span := s.Tracer.Start(ctx, "order.Service.CreateOrders")
+	orders, err := s.Service.CreateOrders(ctx, requests, afterPlaced)
+	tracing.End(span, err)
+
+	if err == nil {
+		s.Recorder.RecordOrders(OutcomeCreated, len(orders))
+		return orders, nil
+	}
+
+	var conflict *domain.CheckoutConflictError
+	if !errors.As(err, &conflict) {
+		s.Recorder.RecordOrders(orderOutcome(err), 1)
+		return orders, err
+	}
+	for _, line := range conflict.Lines {
+		switch line.Reason {
+		case domain.LineInsufficientStock:
+			s.Recorder.RecordOrders(OutcomeInsufficientStock, 1)
+		case domain.LineProductNotFound, domain.LineVariantNotFound:
+			s.Recorder.RecordOrders(OutcomeNotFound, 1)
+		default:
+			s.Recorder.RecordOrders(OutcomeFailed, 1)
+		}
+	}
+	return orders, err
+}
+
+func (s *InstrumentedService) ExportOrders(ctx context.Context, filter domain.OrderFilter, fn func(order domain.Order) error) error {
+	ctx, span := s.Tracer.Start(ctx, "order.Service.ExportOrders")
+	err := s.Service.ExportOrders(ctx, filter, fn)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *InstrumentedService) GetInvoice(ctx context.Context, orderID string, customerID string) (*domain.Invoice, error) {
+	ctx, span := s.Tracer.Start(ctx, "order.Service.GetInvoice")
+	invoice, err := s.Service.GetInvoice(ctx, orderID, customerID)
+	tracing.End(span, err)
+	return invoice, err
+}
+
+func (s *InstrumentedService) GetOrders(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, error) {
+	ctx, span := s.Tracer.Start(ctx, "order.Service.GetOrders")
+	orders, err := s.Service.GetOrders(ctx, filter)
+	tracing.End(span, err)
+	return orders, err
+}
+
+func (s *InstrumentedService) GetOrderByID(ctx context.Context, id string, customerID string) (*domain.Order, error) {
+	ctx, span := s.Tracer.Start(ctx, "order.Service.GetOrderByID")
+	order, err := s.Service.GetOrderByID(ctx, id, customerID)
+	tracing.End(span, err)
+	return order, err
+}
+
+func (s *InstrumentedService) RefundOrder(ctx context.Context, request domain.RefundRequest) (*domain.Refund, error) {
+	ctx, span := s.Tracer.Start(ctx, "order.Service.RefundOrder")
+	refund, err := s.Service.RefundOrder(ctx, request)
+	tracing.End(span, err)
+	return refund, err
+}
+
+func orderOutcome(err error) string {
+	switch {
+	case err == nil:
+		return OutcomeCreated
+	case errors.Is(err, domain.ErrInsufficientStock):
+		return OutcomeInsufficientStock
+	case errors.Is(err, domain.ErrProductNotFound), errors.Is(err, domain.ErrVariantNotFound), errors.Is(err, domain.ErrCustomerNotFound):
+		return OutcomeNotFound
+	case errors.Is(err, domain.ErrPaymentDeclined), errors.Is(err, domain.ErrPaymentTimeout), errors.Is(err, domain.ErrPaymentFailed):
+		return OutcomePaymentFailed
+	}
+	return OutcomeFailed
+}
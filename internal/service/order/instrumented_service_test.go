@@ -0,0 +1,190 @@
+package order_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/tracing"
+	"microservice-products-catalog/internal/service/order"
+	"microservice-products-catalog/internal/service/order/mocks"
+	"microservice-products-catalog/internal/service/product"
+	productmocks "microservice-products-catalog/internal/service/product/mocks"
+	"testing"
+)
+
+type outcomeCounter map[string]int
+
+func (c outcomeCounter) RecordOrders(outcome string, count int) {
+	c[outcome] += count
+}
+
+func TestInstrumentedService(t *testing.T) {
+	customerID := "5f3c2b1a-0d9e-4c8b-a7f6-e5d4c3b2a190"
+	gopher := &domain.Product{ID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 1}
+	missingID := "9b1f3c2e-6a4d-4f8b-8e7a-5d2c1b0a9f86"
+
+	type testCase struct {
+		testName         string
+		setupMock        func(storage *mocks.MockStorageRepository, products *mocks.MockProductService)
+		act              func(service *order.InstrumentedService) error
+		expectedOutcomes outcomeCounter
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Failure - Order without customer counts as failed",
+			act: func(service *order.InstrumentedService) error {
+				return service.CreateOrder(context.Background(), domain.OrderRequest{ProductID: gopher.ID, Quantity: 1})
+			},
+			expectedOutcomes: outcomeCounter{order.OutcomeFailed: 1},
+		},
+		{
+			testName: "Failure - Unknown customer counts as not found",
+			setupMock: func(storage *mocks.MockStorageRepository, products *mocks.MockProductService) {
+				storage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(nil, domain.ErrCustomerNotFound).Times(1)
+			},
+			act: func(service *order.InstrumentedService) error {
+				return service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: gopher.ID, Quantity: 1})
+			},
+			expectedOutcomes: outcomeCounter{order.OutcomeNotFound: 1},
+		},
+		{
+			testName: "Failure - Order above the stock counts as insufficient stock",
+			setupMock: func(storage *mocks.MockStorageRepository, products *mocks.MockProductService) {
+				storage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).Times(1)
+				products.EXPECT().GetProductByID(gomock.Any(), gopher.ID).Return(gopher, nil).Times(1)
+			},
+			act: func(service *order.InstrumentedService) error {
+				return service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: gopher.ID, Quantity: 2})
+			},
+			expectedOutcomes: outcomeCounter{order.OutcomeInsufficientStock: 1},
+		},
+		{
+			testName: "Failure - Every line of a conflict is counted by reason",
+			setupMock: func(storage *mocks.MockStorageRepository, products *mocks.MockProductService) {
+				storage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).Times(1)
+				products.EXPECT().GetProductByID(gomock.Any(), gopher.ID).Return(gopher, nil).Times(1)
+				products.EXPECT().GetProductByID(gomock.Any(), missingID).Return(nil, domain.ErrProductNotFound).Times(1)
+			},
+			act: func(service *order.InstrumentedService) error {
+				_, err := service.CreateOrders(context.Background(), []domain.OrderRequest{
+					{CustomerID: customerID, ProductID: gopher.ID, Quantity: 2},
+					{CustomerID: customerID, ProductID: missingID, Quantity: 1},
+				}, nil)
+				return err
+			},
+			expectedOutcomes: outcomeCounter{order.OutcomeInsufficientStock: 1, order.OutcomeNotFound: 1},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTxManager := mocks.NewMockTransactionManager(ctrl)
+			mockTxManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).AnyTimes()
+			if tc.setupMock != nil {
+				tc.setupMock(mockStorage, mockProductService)
+			}
+
+			mockPricing := mocks.NewMockPricingService(ctrl)
+			mockPricing.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).AnyTimes()
+
+			outcomes := outcomeCounter{}
+			service := order.NewInstrumentedService(
+				order.NewService(mockStorage, mockTxManager, mockProductService, nil, mockPricing, nil, nil, nil),
+				outcomes,
+				noop.NewTracerProvider().Tracer(""),
+			)
+
+			// Act
+			err := tc.act(service)
+
+			// Assert
+			assert.Error(t, err)
+			assert.Equal(t, tc.expectedOutcomes, outcomes)
+		})
+	}
+}
+
+func TestInstrumentedService_CreateOrderSpans(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	customerID := "5f3c2b1a-0d9e-4c8b-a7f6-e5d4c3b2a190"
+	gopher := &domain.Product{ID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 10}
+
+	mockTxManager := mocks.NewMockTransactionManager(ctrl)
+	mockTxManager.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).Times(1)
+	txManager := tracing.NewTxManager(mockTxManager, tracer)
+
+	productStorage := productmocks.NewMockStorageRepository(ctrl)
+	productStorage.EXPECT().GetProductByID(gomock.Any(), gopher.ID).Return(gopher, nil).Times(1)
+	productStorage.EXPECT().SaveProduct(gomock.Any(), gopher).Return(nil).Times(1)
+	productService := product.NewInstrumentedService(product.NewService(productStorage, txManager, nil), tracer)
+
+	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockStorage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).Times(1)
+	mockStorage.EXPECT().NextInvoiceNumber(gomock.Any()).Return(int64(1), nil).Times(1)
+	mockStorage.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockPricing := mocks.NewMockPricingService(ctrl)
+	mockPricing.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).Times(1)
+	mockPromotion := mocks.NewMockPromotionService(ctrl)
+	mockPromotion.EXPECT().ApplyPromotions(gomock.Any(), gomock.Any()).Return([]domain.OrderDiscount{}, nil).Times(1)
+	mockTax := mocks.NewMockTaxCalculator(ctrl)
+	mockTax.EXPECT().Calculate(gomock.Any(), gomock.Any()).DoAndReturn(noTax).Times(1)
+
+	service := order.NewInstrumentedService(
+		order.NewService(mockStorage, txManager, productService, mocks.NewMockInventoryService(ctrl), mockPricing, mockPromotion, mockTax, approvedPayments(ctrl, mockStorage, mockTxManager)),
+		outcomeCounter{},
+		tracer,
+	)
+
+	// Act
+	err := service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: gopher.ID, Quantity: 2})
+
+	// Assert
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	names := map[trace.SpanID]string{}
+	for _, span := range spans {
+		names[span.SpanContext().SpanID()] = span.Name()
+	}
+	parents := map[string]string{}
+	for _, span := range spans {
+		parents[span.Name()] = names[span.Parent().SpanID()]
+		assert.Equal(t, spans[0].SpanContext().TraceID(), span.SpanContext().TraceID())
+	}
+
+	assert.Equal(t, map[string]string{
+		"order.Service.CreateOrder":      "",
+		"WithTransaction":                "order.Service.CreateOrder",
+		"product.Service.GetProductByID": "WithTransaction",
+		"product.Service.SaveProduct":    "WithTransaction",
+	}, parents)
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	domain "microservice-products-catalog/internal/domain"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -50,6 +51,21 @@ func (mr *MockProductServiceMockRecorder) GetProductByID(ctx, id interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByID", reflect.TypeOf((*MockProductService)(nil).GetProductByID), ctx, id)
 }
 
+// GetVariantByID mocks base method.
+func (m *MockProductService) GetVariantByID(ctx context.Context, id string) (*domain.Variant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Variant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantByID indicates an expected call of GetVariantByID.
+func (mr *MockProductServiceMockRecorder) GetVariantByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantByID", reflect.TypeOf((*MockProductService)(nil).GetVariantByID), ctx, id)
+}
+
 // SaveProduct mocks base method.
 func (m *MockProductService) SaveProduct(ctx context.Context, product *domain.Product) error {
 	m.ctrl.T.Helper()
@@ -64,6 +80,279 @@ func (mr *MockProductServiceMockRecorder) SaveProduct(ctx, product interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProduct", reflect.TypeOf((*MockProductService)(nil).SaveProduct), ctx, product)
 }
 
+// SaveVariant mocks base method.
+func (m *MockProductService) SaveVariant(ctx context.Context, variant *domain.Variant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveVariant", ctx, variant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveVariant indicates an expected call of SaveVariant.
+func (mr *MockProductServiceMockRecorder) SaveVariant(ctx, variant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveVariant", reflect.TypeOf((*MockProductService)(nil).SaveVariant), ctx, variant)
+}
+
+// MockInventoryService is a mock of InventoryService interface.
+type MockInventoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryServiceMockRecorder
+}
+
+// MockInventoryServiceMockRecorder is the mock recorder for MockInventoryService.
+type MockInventoryServiceMockRecorder struct {
+	mock *MockInventoryService
+}
+
+// NewMockInventoryService creates a new mock instance.
+func NewMockInventoryService(ctrl *gomock.Controller) *MockInventoryService {
+	mock := &MockInventoryService{ctrl: ctrl}
+	mock.recorder = &MockInventoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryService) EXPECT() *MockInventoryServiceMockRecorder {
+	return m.recorder
+}
+
+// EvaluateStock mocks base method.
+func (m *MockInventoryService) EvaluateStock(ctx context.Context, product *domain.Product) (*domain.StockAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateStock", ctx, product)
+	ret0, _ := ret[0].(*domain.StockAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateStock indicates an expected call of EvaluateStock.
+func (mr *MockInventoryServiceMockRecorder) EvaluateStock(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateStock", reflect.TypeOf((*MockInventoryService)(nil).EvaluateStock), ctx, product)
+}
+
+// NotifyStockAlert mocks base method.
+func (m *MockInventoryService) NotifyStockAlert(ctx context.Context, alert domain.StockAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyStockAlert", ctx, alert)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyStockAlert indicates an expected call of NotifyStockAlert.
+func (mr *MockInventoryServiceMockRecorder) NotifyStockAlert(ctx, alert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyStockAlert", reflect.TypeOf((*MockInventoryService)(nil).NotifyStockAlert), ctx, alert)
+}
+
+// MockPricingService is a mock of PricingService interface.
+type MockPricingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPricingServiceMockRecorder
+}
+
+// MockPricingServiceMockRecorder is the mock recorder for MockPricingService.
+type MockPricingServiceMockRecorder struct {
+	mock *MockPricingService
+}
+
+// NewMockPricingService creates a new mock instance.
+func NewMockPricingService(ctrl *gomock.Controller) *MockPricingService {
+	mock := &MockPricingService{ctrl: ctrl}
+	mock.recorder = &MockPricingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPricingService) EXPECT() *MockPricingServiceMockRecorder {
+	return m.recorder
+}
+
+// Quoter mocks base method.
+func (m *MockPricingService) Quoter(ctx context.Context, currency string) domain.Quoter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quoter", ctx, currency)
+	ret0, _ := ret[0].(domain.Quoter)
+	return ret0
+}
+
+// Quoter indicates an expected call of Quoter.
+func (mr *MockPricingServiceMockRecorder) Quoter(ctx, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quoter", reflect.TypeOf((*MockPricingService)(nil).Quoter), ctx, currency)
+}
+
+// MockPromotionService is a mock of PromotionService interface.
+type MockPromotionService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionServiceMockRecorder
+}
+
+// MockPromotionServiceMockRecorder is the mock recorder for MockPromotionService.
+type MockPromotionServiceMockRecorder struct {
+	mock *MockPromotionService
+}
+
+// NewMockPromotionService creates a new mock instance.
+func NewMockPromotionService(ctrl *gomock.Controller) *MockPromotionService {
+	mock := &MockPromotionService{ctrl: ctrl}
+	mock.recorder = &MockPromotionServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionService) EXPECT() *MockPromotionServiceMockRecorder {
+	return m.recorder
+}
+
+// ApplyPromotions mocks base method.
+func (m *MockPromotionService) ApplyPromotions(ctx context.Context, line domain.OrderLine) ([]domain.OrderDiscount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyPromotions", ctx, line)
+	ret0, _ := ret[0].([]domain.OrderDiscount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyPromotions indicates an expected call of ApplyPromotions.
+func (mr *MockPromotionServiceMockRecorder) ApplyPromotions(ctx, line interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyPromotions", reflect.TypeOf((*MockPromotionService)(nil).ApplyPromotions), ctx, line)
+}
+
+// ReleasePromotions mocks base method.
+func (m *MockPromotionService) ReleasePromotions(ctx context.Context, discounts []domain.OrderDiscount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePromotions", ctx, discounts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleasePromotions indicates an expected call of ReleasePromotions.
+func (mr *MockPromotionServiceMockRecorder) ReleasePromotions(ctx, discounts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePromotions", reflect.TypeOf((*MockPromotionService)(nil).ReleasePromotions), ctx, discounts)
+}
+
+// MockTaxCalculator is a mock of TaxCalculator interface.
+type MockTaxCalculator struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxCalculatorMockRecorder
+}
+
+// MockTaxCalculatorMockRecorder is the mock recorder for MockTaxCalculator.
+type MockTaxCalculatorMockRecorder struct {
+	mock *MockTaxCalculator
+}
+
+// NewMockTaxCalculator creates a new mock instance.
+func NewMockTaxCalculator(ctrl *gomock.Controller) *MockTaxCalculator {
+	mock := &MockTaxCalculator{ctrl: ctrl}
+	mock.recorder = &MockTaxCalculatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaxCalculator) EXPECT() *MockTaxCalculatorMockRecorder {
+	return m.recorder
+}
+
+// Calculate mocks base method.
+func (m *MockTaxCalculator) Calculate(ctx context.Context, request domain.TaxRequest) (domain.TaxBreakdown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Calculate", ctx, request)
+	ret0, _ := ret[0].(domain.TaxBreakdown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Calculate indicates an expected call of Calculate.
+func (mr *MockTaxCalculatorMockRecorder) Calculate(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Calculate", reflect.TypeOf((*MockTaxCalculator)(nil).Calculate), ctx, request)
+}
+
+// MockPaymentGateway is a mock of PaymentGateway interface.
+type MockPaymentGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentGatewayMockRecorder
+}
+
+// MockPaymentGatewayMockRecorder is the mock recorder for MockPaymentGateway.
+type MockPaymentGatewayMockRecorder struct {
+	mock *MockPaymentGateway
+}
+
+// NewMockPaymentGateway creates a new mock instance.
+func NewMockPaymentGateway(ctrl *gomock.Controller) *MockPaymentGateway {
+	mock := &MockPaymentGateway{ctrl: ctrl}
+	mock.recorder = &MockPaymentGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentGateway) EXPECT() *MockPaymentGatewayMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockPaymentGateway) Authorize(ctx context.Context, request domain.PaymentRequest) (domain.PaymentAuthorization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, request)
+	ret0, _ := ret[0].(domain.PaymentAuthorization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockPaymentGatewayMockRecorder) Authorize(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockPaymentGateway)(nil).Authorize), ctx, request)
+}
+
+// Capture mocks base method.
+func (m *MockPaymentGateway) Capture(ctx context.Context, authorizationID string, amount domain.Money) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", ctx, authorizationID, amount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockPaymentGatewayMockRecorder) Capture(ctx, authorizationID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockPaymentGateway)(nil).Capture), ctx, authorizationID, amount)
+}
+
+// Refund mocks base method.
+func (m *MockPaymentGateway) Refund(ctx context.Context, authorizationID string, amount domain.Money, idempotencyKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, authorizationID, amount, idempotencyKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockPaymentGatewayMockRecorder) Refund(ctx, authorizationID, amount, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockPaymentGateway)(nil).Refund), ctx, authorizationID, amount, idempotencyKey)
+}
+
+// Void mocks base method.
+func (m *MockPaymentGateway) Void(ctx context.Context, authorizationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", ctx, authorizationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockPaymentGatewayMockRecorder) Void(ctx, authorizationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockPaymentGateway)(nil).Void), ctx, authorizationID)
+}
+
 // MockTransactionManager is a mock of TransactionManager interface.
 type MockTransactionManager struct {
 	ctrl     *gomock.Controller
@@ -138,17 +427,148 @@ func (mr *MockStorageRepositoryMockRecorder) CreateOrder(ctx, order interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockStorageRepository)(nil).CreateOrder), ctx, order)
 }
 
+// CreateRefund mocks base method.
+func (m *MockStorageRepository) CreateRefund(ctx context.Context, refund domain.Refund) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefund", ctx, refund)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefund indicates an expected call of CreateRefund.
+func (mr *MockStorageRepositoryMockRecorder) CreateRefund(ctx, refund interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefund", reflect.TypeOf((*MockStorageRepository)(nil).CreateRefund), ctx, refund)
+}
+
+// GetCustomerByID mocks base method.
+func (m *MockStorageRepository) GetCustomerByID(ctx context.Context, id string) (*domain.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomerByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomerByID indicates an expected call of GetCustomerByID.
+func (mr *MockStorageRepositoryMockRecorder) GetCustomerByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomerByID", reflect.TypeOf((*MockStorageRepository)(nil).GetCustomerByID), ctx, id)
+}
+
+// GetOrderByID mocks base method.
+func (m *MockStorageRepository) GetOrderByID(ctx context.Context, id string) (*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderByID indicates an expected call of GetOrderByID.
+func (mr *MockStorageRepositoryMockRecorder) GetOrderByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByID", reflect.TypeOf((*MockStorageRepository)(nil).GetOrderByID), ctx, id)
+}
+
+// GetOrderRefunds mocks base method.
+func (m *MockStorageRepository) GetOrderRefunds(ctx context.Context, orderID string) ([]domain.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderRefunds", ctx, orderID)
+	ret0, _ := ret[0].([]domain.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderRefunds indicates an expected call of GetOrderRefunds.
+func (mr *MockStorageRepositoryMockRecorder) GetOrderRefunds(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderRefunds", reflect.TypeOf((*MockStorageRepository)(nil).GetOrderRefunds), ctx, orderID)
+}
+
 // GetOrders mocks base method.
-func (m *MockStorageRepository) GetOrders(ctx context.Context) ([]domain.Order, error) {
+func (m *MockStorageRepository) GetOrders(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrders", ctx)
+	ret := m.ctrl.Call(m, "GetOrders", ctx, filter)
 	ret0, _ := ret[0].([]domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOrders indicates an expected call of GetOrders.
-func (mr *MockStorageRepositoryMockRecorder) GetOrders(ctx interface{}) *gomock.Call {
+func (mr *MockStorageRepositoryMockRecorder) GetOrders(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockStorageRepository)(nil).GetOrders), ctx, filter)
+}
+
+// GetPendingRefunds mocks base method.
+func (m *MockStorageRepository) GetPendingRefunds(ctx context.Context, createdBefore time.Time, limit int) ([]domain.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingRefunds", ctx, createdBefore, limit)
+	ret0, _ := ret[0].([]domain.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingRefunds indicates an expected call of GetPendingRefunds.
+func (mr *MockStorageRepositoryMockRecorder) GetPendingRefunds(ctx, createdBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingRefunds", reflect.TypeOf((*MockStorageRepository)(nil).GetPendingRefunds), ctx, createdBefore, limit)
+}
+
+// NextInvoiceNumber mocks base method.
+func (m *MockStorageRepository) NextInvoiceNumber(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextInvoiceNumber", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextInvoiceNumber indicates an expected call of NextInvoiceNumber.
+func (mr *MockStorageRepositoryMockRecorder) NextInvoiceNumber(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextInvoiceNumber", reflect.TypeOf((*MockStorageRepository)(nil).NextInvoiceNumber), ctx)
+}
+
+// StreamOrders mocks base method.
+func (m *MockStorageRepository) StreamOrders(ctx context.Context, filter domain.OrderFilter, fn func(domain.Order) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamOrders", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamOrders indicates an expected call of StreamOrders.
+func (mr *MockStorageRepositoryMockRecorder) StreamOrders(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamOrders", reflect.TypeOf((*MockStorageRepository)(nil).StreamOrders), ctx, filter, fn)
+}
+
+// UpdateOrderPayment mocks base method.
+func (m *MockStorageRepository) UpdateOrderPayment(ctx context.Context, order domain.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderPayment", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrderPayment indicates an expected call of UpdateOrderPayment.
+func (mr *MockStorageRepositoryMockRecorder) UpdateOrderPayment(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderPayment", reflect.TypeOf((*MockStorageRepository)(nil).UpdateOrderPayment), ctx, order)
+}
+
+// UpdateRefundStatus mocks base method.
+func (m *MockStorageRepository) UpdateRefundStatus(ctx context.Context, refund domain.Refund) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRefundStatus", ctx, refund)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRefundStatus indicates an expected call of UpdateRefundStatus.
+func (mr *MockStorageRepositoryMockRecorder) UpdateRefundStatus(ctx, refund interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockStorageRepository)(nil).GetOrders), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRefundStatus", reflect.TypeOf((*MockStorageRepository)(nil).UpdateRefundStatus), ctx, refund)
 }
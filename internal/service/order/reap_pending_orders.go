@@ -0,0 +1,74 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"time"
+)
+
+// reapBatchSize is how many pending orders a run of the reaper compensates at most, the rest
+// are left to the next run.
+const reapBatchSize = 100
+
+// RunPendingOrderReaper compensates the orders pending for longer than timeout every interval
+// until the context is cancelled. An order stays pending when the service stops between the
+// order transaction and the settlement, its stock and coupon redemptions would be held
+// forever. Every order is compensated in its own transaction conditional on its status, so
+// every replica can run it.
+func (s *Service) RunPendingOrderReaper(ctx context.Context, interval time.Duration, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ReapPendingOrders(ctx, time.Now().Add(-timeout)); err != nil {
+			logging.FromContext(ctx).Error("error reaping pending orders", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReapPendingOrders compensates the orders placed before placedBefore that are still pending
+// and returns how many were compensated. An order settled or compensated meanwhile is skipped,
+// an order that cannot be compensated does not hold back the others.
+// The payment of a reaped order is not released here. A settlement still running finds the
+// order compensated when it confirms it and releases its payments, but the authorisation of
+// a settlement cut by a crash is unknown to the service: the gateway expires it unless it
+// was captured, a captured one must be refunded by hand.
+func (s *Service) ReapPendingOrders(ctx context.Context, placedBefore time.Time) (int, error) {
+	orders, err := s.Storage.GetOrders(ctx, domain.OrderFilter{
+		Status: domain.OrderPending,
+		To:     &placedBefore,
+		Limit:  reapBatchSize,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get pending orders error: %w", err)
+	}
+
+	reaped := 0
+	var errs []error
+	for i := range orders {
+		var undone int
+		err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+			var err error
+			undone, err = s.undoOrders(txCtx, orders[i:i+1])
+			return err
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error compensating pending order %s: %w", orders[i].ID, err))
+			continue
+		}
+		if undone > 0 {
+			logging.FromContext(ctx).Warn("pending order compensated", "order_id", orders[i].ID, "date", orders[i].Date)
+		}
+		reaped += undone
+	}
+	return reaped, errors.Join(errs...)
+}
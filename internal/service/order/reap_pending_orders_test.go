@@ -0,0 +1,161 @@
+package order_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/order"
+	"microservice-products-catalog/internal/service/order/mocks"
+	"testing"
+	"time"
+)
+
+func TestReapPendingOrders(t *testing.T) {
+	placedBefore := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	storageErr := errors.New("lock wait timeout exceeded")
+	promotionID := "8a7b6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+	discounts := []domain.OrderDiscount{{PromotionID: &promotionID, Name: "Summer", Amount: domain.NewMoney(100, domain.BaseCurrency)}}
+
+	type testCase struct {
+		testName       string
+		updateErrs     []error
+		listErr        error
+		expectedReaped int
+		expectedStock  int
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			testName:       "Success - the pending orders are compensated",
+			updateErrs:     []error{nil, nil},
+			expectedReaped: 2,
+			expectedStock:  10,
+		},
+		{
+			testName:       "Success - an order settled meanwhile is skipped",
+			updateErrs:     []error{domain.ErrOrderNotPending, nil},
+			expectedReaped: 1,
+			expectedStock:  8,
+		},
+		{
+			testName:       "Failure - an order that cannot be compensated does not hold back the others",
+			updateErrs:     []error{storageErr, nil},
+			expectedReaped: 1,
+			expectedStock:  8,
+			expectedError:  storageErr,
+		},
+		{
+			testName:      "Failure - the pending orders cannot be listed",
+			listErr:       storageErr,
+			expectedStock: 6,
+			expectedError: storageErr,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			product := &domain.Product{ID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Stock: 6}
+			pending := []domain.Order{
+				{ID: "order-1", ProductID: product.ID, Quantity: 2, Status: domain.OrderPending, Discounts: discounts},
+				{ID: "order-2", ProductID: product.ID, Quantity: 2, Status: domain.OrderPending, Discounts: discounts},
+			}
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTxManager := mocks.NewMockTransactionManager(ctrl)
+			mockPromotion := mocks.NewMockPromotionService(ctrl)
+
+			mockStorage.EXPECT().
+				GetOrders(gomock.Any(), domain.OrderFilter{Status: domain.OrderPending, To: &placedBefore, Limit: 100}).
+				Return(pending, tc.listErr).Times(1)
+			if tc.listErr == nil {
+				mockTxManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					}).Times(len(pending))
+			}
+			for j, updateErr := range tc.updateErrs {
+				mockStorage.EXPECT().
+					UpdateOrderPayment(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, order domain.Order) error {
+						assert.Equal(t, pending[j].ID, order.ID)
+						assert.Equal(t, domain.OrderPaymentFailed, order.Status)
+						return updateErr
+					}).Times(1)
+			}
+			mockProductService.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(product, nil).Times(tc.expectedReaped)
+			mockProductService.EXPECT().SaveProduct(gomock.Any(), product).Return(nil).Times(tc.expectedReaped)
+			mockPromotion.EXPECT().ReleasePromotions(gomock.Any(), discounts).Return(nil).Times(tc.expectedReaped)
+
+			service := order.NewService(mockStorage, mockTxManager, mockProductService, nil, nil, mockPromotion, nil, nil)
+
+			// Act
+			reaped, err := service.ReapPendingOrders(context.Background(), placedBefore)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedReaped, reaped)
+			assert.Equal(t, tc.expectedStock, product.Stock)
+		})
+	}
+}
+
+func TestCreateOrder_ReapedBeforeConfirmation(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	product := &domain.Product{ID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 10}
+
+	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockStorage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).Times(1)
+	mockStorage.EXPECT().NextInvoiceNumber(gomock.Any()).Return(int64(1), nil).AnyTimes()
+	mockStorage.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	// the reaper compensated the order between the capture and the confirmation
+	mockStorage.EXPECT().UpdateOrderPayment(gomock.Any(), gomock.Any()).Return(domain.ErrOrderNotPending).Times(2)
+	mockProductService := mocks.NewMockProductService(ctrl)
+	mockProductService.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(product, nil).Times(1)
+	mockProductService.EXPECT().SaveProduct(gomock.Any(), product).Return(nil).Times(1)
+	mockTxManager := mocks.NewMockTransactionManager(ctrl)
+	mockTxManager.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).Times(3)
+	mockPricing := mocks.NewMockPricingService(ctrl)
+	mockPricing.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).Times(1)
+	mockPromotion := mocks.NewMockPromotionService(ctrl)
+	mockPromotion.EXPECT().ApplyPromotions(gomock.Any(), gomock.Any()).Return([]domain.OrderDiscount{}, nil).Times(1)
+	mockTax := mocks.NewMockTaxCalculator(ctrl)
+	mockTax.EXPECT().Calculate(gomock.Any(), gomock.Any()).DoAndReturn(noTax).Times(1)
+	mockGateway := mocks.NewMockPaymentGateway(ctrl)
+	mockGateway.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(domain.PaymentAuthorization{ID: "auth-1", Amount: domain.NewMoney(2000, domain.BaseCurrency)}, nil).Times(1)
+	mockGateway.EXPECT().Capture(gomock.Any(), "auth-1", gomock.Any()).Return(nil).Times(1)
+	mockGateway.EXPECT().Refund(gomock.Any(), "auth-1", domain.NewMoney(2000, domain.BaseCurrency), "auth-1").Return(nil).Times(1)
+
+	service := order.NewService(mockStorage, mockTxManager, mockProductService, mocks.NewMockInventoryService(ctrl), mockPricing, mockPromotion, mockTax, mockGateway)
+
+	// Act
+	err := service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: product.ID, Quantity: 2})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrPaymentFailed)
+	assert.ErrorIs(t, err, domain.ErrOrderNotPending)
+	// the stock was put back by the reaper, not a second time
+	assert.Equal(t, 8, product.Stock)
+}
@@ -0,0 +1,88 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"time"
+)
+
+// reconcileBatchSize is how many pending refunds a run of the reconciler re-drives at most,
+// the rest are left to the next run.
+const reconcileBatchSize = 100
+
+// RunPendingRefundReconciler re-drives the refunds pending for longer than timeout every
+// interval until the context is cancelled. A refund stays pending when the service stops
+// between its record and its completion, or when the completion fails, its units would be
+// held forever. The refund is sent again with its ID as idempotency key and completed
+// conditional on its status, so every replica can run it.
+func (s *Service) RunPendingRefundReconciler(ctx context.Context, interval time.Duration, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ReconcilePendingRefunds(ctx, time.Now().Add(-timeout)); err != nil {
+			logging.FromContext(ctx).Error("error reconciling pending refunds", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReconcilePendingRefunds refunds again the refunds created before createdBefore that are
+// still pending, then marks them completed or failed as RefundOrder does, and returns how
+// many were reconciled. A refund completed meanwhile is skipped. A refund the gateway timed
+// out on stays pending for the next run, it may have been given back.
+func (s *Service) ReconcilePendingRefunds(ctx context.Context, createdBefore time.Time) (int, error) {
+	refunds, err := s.Storage.GetPendingRefunds(ctx, createdBefore, reconcileBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("get pending refunds error: %w", err)
+	}
+
+	reconciled := 0
+	var errs []error
+	for i := range refunds {
+		done, err := s.reconcileRefund(ctx, &refunds[i])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error reconciling pending refund %s: %w", refunds[i].ID, err))
+			continue
+		}
+		if done {
+			logging.FromContext(ctx).Warn("pending refund reconciled", "refund_id", refunds[i].ID, "order_id", refunds[i].OrderID, "status", refunds[i].Status)
+			reconciled++
+		}
+	}
+	return reconciled, errors.Join(errs...)
+}
+
+// reconcileRefund refunds the payment of the pending refund with its ID as idempotency key and
+// completes it, it reports false when the refund was no longer pending.
+func (s *Service) reconcileRefund(ctx context.Context, refund *domain.Refund) (bool, error) {
+	order, err := s.Storage.GetOrderByID(ctx, refund.OrderID)
+	if err != nil {
+		return false, err
+	}
+
+	var paymentErr error
+	if order.PaymentID != nil && !refund.Amount.IsZero() {
+		paymentErr = s.PaymentGateway.Refund(ctx, *order.PaymentID, refund.Amount, refund.ID)
+	}
+	if errors.Is(paymentErr, domain.ErrPaymentTimeout) {
+		return false, paymentErr
+	}
+
+	err = s.completeRefund(ctx, *order, refund, paymentErr)
+	if errors.Is(err, domain.ErrRefundNotPending) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
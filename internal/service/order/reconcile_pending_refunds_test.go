@@ -0,0 +1,123 @@
+package order_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/order"
+	"microservice-products-catalog/internal/service/order/mocks"
+	"testing"
+	"time"
+)
+
+func TestReconcilePendingRefunds(t *testing.T) {
+	createdBefore := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	storageErr := errors.New("lock wait timeout exceeded")
+	paymentID := "auth-1"
+	amount := domain.NewMoney(500, domain.BaseCurrency)
+
+	type testCase struct {
+		testName           string
+		listErr            error
+		gatewayErr         error
+		updateErr          error
+		expectedStatus     domain.RefundStatus
+		expectedReconciled int
+		expectedStock      int
+		expectedError      error
+	}
+
+	testCases := []testCase{
+		{
+			testName:           "Success - the refund is sent again and completed with its units restocked",
+			expectedStatus:     domain.RefundCompleted,
+			expectedReconciled: 1,
+			expectedStock:      7,
+		},
+		{
+			testName:           "Success - a refund refused by the gateway is failed and not restocked",
+			gatewayErr:         domain.ErrInvalidPaymentState,
+			expectedStatus:     domain.RefundFailed,
+			expectedReconciled: 1,
+			expectedStock:      5,
+		},
+		{
+			testName:       "Success - a refund completed meanwhile is skipped",
+			updateErr:      domain.ErrRefundNotPending,
+			expectedStatus: domain.RefundCompleted,
+			expectedStock:  5,
+		},
+		{
+			testName:      "Failure - a gateway timeout leaves the refund pending",
+			gatewayErr:    domain.ErrPaymentTimeout,
+			expectedStock: 5,
+			expectedError: domain.ErrPaymentTimeout,
+		},
+		{
+			testName:      "Failure - the pending refunds cannot be listed",
+			listErr:       storageErr,
+			expectedStock: 5,
+			expectedError: storageErr,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			product := &domain.Product{ID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Stock: 5}
+			confirmed := &domain.Order{ID: "order-1", ProductID: product.ID, Quantity: 4, Status: domain.OrderConfirmed, PaymentID: &paymentID}
+			refund := domain.Refund{ID: "refund-1", OrderID: confirmed.ID, Quantity: 2, Amount: amount, Restock: true, Status: domain.RefundPending}
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTxManager := mocks.NewMockTransactionManager(ctrl)
+			mockGateway := mocks.NewMockPaymentGateway(ctrl)
+
+			mockStorage.EXPECT().GetPendingRefunds(gomock.Any(), createdBefore, 100).Return([]domain.Refund{refund}, tc.listErr).Times(1)
+			if tc.listErr == nil {
+				mockStorage.EXPECT().GetOrderByID(gomock.Any(), confirmed.ID).Return(confirmed, nil).Times(1)
+				// the same refund ID, the gateway gives the amount back once
+				mockGateway.EXPECT().Refund(gomock.Any(), paymentID, amount, refund.ID).Return(tc.gatewayErr).Times(1)
+			}
+			if tc.expectedStatus != "" {
+				mockTxManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+				mockStorage.EXPECT().
+					UpdateRefundStatus(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, updated domain.Refund) error {
+						assert.Equal(t, refund.ID, updated.ID)
+						assert.Equal(t, tc.expectedStatus, updated.Status)
+						return tc.updateErr
+					}).Times(1)
+			}
+			if tc.expectedStock != product.Stock {
+				mockProductService.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(product, nil).Times(1)
+				mockProductService.EXPECT().SaveProduct(gomock.Any(), product).Return(nil).Times(1)
+			}
+
+			service := order.NewService(mockStorage, mockTxManager, mockProductService, nil, nil, nil, nil, mockGateway)
+
+			// Act
+			reconciled, err := service.ReconcilePendingRefunds(context.Background(), createdBefore)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedReconciled, reconciled)
+			assert.Equal(t, tc.expectedStock, product.Stock)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"time"
+)
+
+// RefundOrder refunds request.Quantity units of a confirmed order, at most the units not
+// refunded yet, and puts them back in stock when request.Restock is set. The refund is
+// recorded pending in a first transaction that locks the order row, so concurrent refunds
+// cannot exceed its quantity. The payment is refunded after the commit, with the refund ID as
+// idempotency key, and a second transaction marks the refund completed and restocks the
+// units, or marks it failed. No row stays locked while the gateway is called. A refund left
+// pending is completed by the reconciler, see RunPendingRefundReconciler.
+func (s *Service) RefundOrder(ctx context.Context, request domain.RefundRequest) (*domain.Refund, error) {
+	var refund domain.Refund
+	var order *domain.Order
+
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		order, err = s.Storage.GetOrderByID(txCtx, request.OrderID)
+		if err != nil {
+			return err
+		}
+		if order.Status != domain.OrderConfirmed {
+			return domain.ErrOrderNotRefundable
+		}
+
+		refunds, err := s.Storage.GetOrderRefunds(txCtx, order.ID)
+		if err != nil {
+			return err
+		}
+		order.ApplyRefunds(refunds)
+		if request.Quantity > order.Quantity-order.RefundedQuantity() {
+			return fmt.Errorf("%w: %d of %d left", domain.ErrRefundQuantityExceeded, order.Quantity-order.RefundedQuantity(), order.Quantity)
+		}
+
+		refund = domain.Refund{
+			ID:        uuid.New().String(),
+			OrderID:   order.ID,
+			Quantity:  request.Quantity,
+			Amount:    order.RefundAmount(request.Quantity),
+			Reason:    request.Reason,
+			Restock:   request.Restock,
+			Status:    domain.RefundPending,
+			CreatedAt: time.Now(),
+		}
+		return s.Storage.CreateRefund(txCtx, refund)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var paymentErr error
+	if order.PaymentID != nil && !refund.Amount.IsZero() {
+		paymentErr = s.PaymentGateway.Refund(ctx, *order.PaymentID, refund.Amount, refund.ID)
+	}
+	if err := s.completeRefund(ctx, *order, &refund, paymentErr); err != nil {
+		// the refund stays pending and keeps its units until the reconciler sends it again with
+		// the same refund ID, see ReconcilePendingRefunds
+		logging.FromContext(ctx).Error("error completing refund", "refund_id", refund.ID, "order_id", refund.OrderID, "error", err)
+		if paymentErr == nil {
+			return nil, err
+		}
+	}
+	if paymentErr != nil {
+		return nil, fmt.Errorf("%w: %w", domain.ErrPaymentFailed, paymentErr)
+	}
+	return &refund, nil
+}
+
+// completeRefund marks the pending refund failed when the payment could not be refunded,
+// otherwise completed with its units put back in the stock of the order when it restocks.
+func (s *Service) completeRefund(ctx context.Context, order domain.Order, refund *domain.Refund, paymentErr error) error {
+	refund.Status = domain.RefundCompleted
+	if paymentErr != nil {
+		refund.Status = domain.RefundFailed
+	}
+
+	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.Storage.UpdateRefundStatus(txCtx, *refund); err != nil {
+			return err
+		}
+		if refund.Status != domain.RefundCompleted || !refund.Restock {
+			return nil
+		}
+		return s.restock(txCtx, order.ProductID, order.VariantID, refund.Quantity)
+	})
+}
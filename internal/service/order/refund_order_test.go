@@ -0,0 +1,168 @@
+package order_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/order"
+	"microservice-products-catalog/internal/service/order/mocks"
+	"testing"
+)
+
+func TestRefundOrder(t *testing.T) {
+	orderID := "4839df42-8a9e-464c-8a7f-a8f7b9d8bdba"
+	paymentID := "fake_auth_000001"
+	oneUnit := domain.Refund{ID: "refund-1", OrderID: orderID, Quantity: 1, Amount: domain.NewMoney(333, domain.BaseCurrency), Status: domain.RefundCompleted}
+	failedUnit := domain.Refund{ID: "refund-2", OrderID: orderID, Quantity: 1, Amount: domain.NewMoney(333, domain.BaseCurrency), Status: domain.RefundFailed}
+	gatewayErr := errors.New("refund window closed")
+	storageErr := errors.New("lock wait timeout exceeded")
+
+	type testCase struct {
+		testName       string
+		status         domain.OrderStatus
+		refunds        []domain.Refund
+		request        domain.RefundRequest
+		gatewayErr     error
+		completeErr    error
+		expectedAmount domain.Money
+		expectedStock  int
+		expectedError  error
+	}
+
+	testCases := []testCase{
+		{
+			testName:       "Success - one unit is refunded at its share of the total and restocked",
+			status:         domain.OrderConfirmed,
+			refunds:        []domain.Refund{oneUnit},
+			request:        domain.RefundRequest{OrderID: orderID, Quantity: 1, Reason: "damaged", Restock: true},
+			expectedAmount: domain.NewMoney(333, domain.BaseCurrency),
+			expectedStock:  6,
+		},
+		{
+			testName:       "Success - the last units take what is left paid",
+			status:         domain.OrderConfirmed,
+			refunds:        []domain.Refund{oneUnit},
+			request:        domain.RefundRequest{OrderID: orderID, Quantity: 2, Reason: "not as described"},
+			expectedAmount: domain.NewMoney(667, domain.BaseCurrency),
+			expectedStock:  5,
+		},
+		{
+			testName:       "Success - the units of a failed refund can be refunded again",
+			status:         domain.OrderConfirmed,
+			refunds:        []domain.Refund{oneUnit, oneUnit, failedUnit},
+			request:        domain.RefundRequest{OrderID: orderID, Quantity: 1, Reason: "damaged"},
+			expectedAmount: domain.NewMoney(334, domain.BaseCurrency),
+			expectedStock:  5,
+		},
+		{
+			testName:      "Failure - more units than the ones not refunded yet",
+			status:        domain.OrderConfirmed,
+			refunds:       []domain.Refund{oneUnit, oneUnit},
+			request:       domain.RefundRequest{OrderID: orderID, Quantity: 2, Reason: "damaged", Restock: true},
+			expectedStock: 5,
+			expectedError: domain.ErrRefundQuantityExceeded,
+		},
+		{
+			testName:      "Failure - the order was not paid",
+			status:        domain.OrderPaymentFailed,
+			request:       domain.RefundRequest{OrderID: orderID, Quantity: 1, Reason: "damaged"},
+			expectedStock: 5,
+			expectedError: domain.ErrOrderNotRefundable,
+		},
+		{
+			testName:      "Failure - the gateway refuses the refund, it is marked failed without restocking",
+			status:        domain.OrderConfirmed,
+			request:       domain.RefundRequest{OrderID: orderID, Quantity: 1, Reason: "damaged", Restock: true},
+			gatewayErr:    gatewayErr,
+			expectedStock: 5,
+			expectedError: domain.ErrPaymentFailed,
+		},
+		{
+			testName:      "Failure - the refund paid but not completed stays pending",
+			status:        domain.OrderConfirmed,
+			request:       domain.RefundRequest{OrderID: orderID, Quantity: 1, Reason: "damaged", Restock: true},
+			completeErr:   storageErr,
+			expectedStock: 5,
+			expectedError: storageErr,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			product := &domain.Product{ID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Stock: 5}
+			stored := &domain.Order{ID: orderID, ProductID: product.ID, Quantity: 3, Total: domain.NewMoney(1000, domain.BaseCurrency), Status: tc.status, PaymentID: &paymentID}
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTxManager := mocks.NewMockTransactionManager(ctrl)
+			mockGateway := mocks.NewMockPaymentGateway(ctrl)
+
+			// the refund is recorded in a transaction and completed in a second one
+			recorded := tc.expectedError == nil || tc.gatewayErr != nil || tc.completeErr != nil
+			transactions := 1
+			if recorded {
+				transactions = 2
+			}
+			mockTxManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).Times(transactions)
+			mockStorage.EXPECT().GetOrderByID(gomock.Any(), orderID).Return(stored, nil).Times(1)
+			mockStorage.EXPECT().GetOrderRefunds(gomock.Any(), orderID).Return(tc.refunds, nil).AnyTimes()
+			if tc.request.Restock && tc.expectedError == nil {
+				mockProductService.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(product, nil).Times(1)
+				mockProductService.EXPECT().SaveProduct(gomock.Any(), product).Return(nil).Times(1)
+			}
+			if recorded {
+				var created domain.Refund
+				mockStorage.EXPECT().CreateRefund(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, refund domain.Refund) error {
+					created = refund
+					assert.Equal(t, domain.RefundPending, refund.Status)
+					return nil
+				}).Times(1)
+				mockGateway.EXPECT().Refund(gomock.Any(), paymentID, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, amount domain.Money, idempotencyKey string) error {
+					assert.Equal(t, created.ID, idempotencyKey)
+					assert.Equal(t, created.Amount, amount)
+					return tc.gatewayErr
+				}).Times(1)
+
+				expectedStatus := domain.RefundCompleted
+				if tc.gatewayErr != nil {
+					expectedStatus = domain.RefundFailed
+				}
+				mockStorage.EXPECT().UpdateRefundStatus(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, refund domain.Refund) error {
+					assert.Equal(t, created.ID, refund.ID)
+					assert.Equal(t, expectedStatus, refund.Status)
+					return tc.completeErr
+				}).Times(1)
+			}
+
+			service := order.NewService(mockStorage, mockTxManager, mockProductService, nil, nil, nil, nil, mockGateway)
+
+			// Act
+			refund, err := service.RefundOrder(context.Background(), tc.request)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, refund)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedAmount, refund.Amount)
+				assert.Equal(t, tc.request.Quantity, refund.Quantity)
+				assert.Equal(t, tc.request.Restock, refund.Restock)
+				assert.Equal(t, domain.RefundCompleted, refund.Status)
+			}
+			assert.Equal(t, tc.expectedStock, product.Stock)
+		})
+	}
+}
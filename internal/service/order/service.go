@@ -3,6 +3,7 @@ package order
 import (
 	"context"
 	"microservice-products-catalog/internal/domain"
+	"time"
 )
 
 //go:generate mockgen -source=service.go -destination=././mocks/order_repository_mock.go -package=mocks
@@ -10,6 +11,44 @@ import (
 type ProductService interface {
 	GetProductByID(ctx context.Context, id string) (*domain.Product, error)
 	SaveProduct(ctx context.Context, product *domain.Product) error
+	GetVariantByID(ctx context.Context, id string) (*domain.Variant, error)
+	SaveVariant(ctx context.Context, variant *domain.Variant) error
+}
+
+type InventoryService interface {
+	EvaluateStock(ctx context.Context, product *domain.Product) (*domain.StockAlert, error)
+	NotifyStockAlert(ctx context.Context, alert domain.StockAlert) error
+}
+
+// PricingService resolves the exchange rate of an order currency, it must be called before the
+// order transaction so no rate is fetched while the product rows are locked.
+type PricingService interface {
+	Quoter(ctx context.Context, currency string) domain.Quoter
+}
+
+// PromotionService computes the discounts of an order line, it must be called inside the
+// order transaction so the coupon redemptions commit or roll back with the order.
+// ReleasePromotions gives back the redemptions of an order whose payment failed.
+type PromotionService interface {
+	ApplyPromotions(ctx context.Context, line domain.OrderLine) ([]domain.OrderDiscount, error)
+	ReleasePromotions(ctx context.Context, discounts []domain.OrderDiscount) error
+}
+
+// TaxCalculator computes the taxes of the order lines for a destination region.
+type TaxCalculator interface {
+	Calculate(ctx context.Context, request domain.TaxRequest) (domain.TaxBreakdown, error)
+}
+
+// PaymentGateway charges the orders. Authorize reserves the amount on the customer's payment
+// method, Capture takes it, Void cancels an authorisation that was not captured and Refund
+// gives back part or all of a captured amount. Authorize returns domain.ErrPaymentDeclined
+// or domain.ErrPaymentTimeout when the payment cannot be taken. Refund must use
+// idempotencyKey so a retried refund never gives the amount back twice.
+type PaymentGateway interface {
+	Authorize(ctx context.Context, request domain.PaymentRequest) (domain.PaymentAuthorization, error)
+	Capture(ctx context.Context, authorizationID string, amount domain.Money) error
+	Void(ctx context.Context, authorizationID string) error
+	Refund(ctx context.Context, authorizationID string, amount domain.Money, idempotencyKey string) error
 }
 
 type TransactionManager interface {
@@ -18,19 +57,38 @@ type TransactionManager interface {
 
 type StorageRepository interface {
 	CreateOrder(ctx context.Context, order domain.Order) error
-	GetOrders(ctx context.Context) ([]domain.Order, error)
+	NextInvoiceNumber(ctx context.Context) (int64, error)
+	UpdateOrderPayment(ctx context.Context, order domain.Order) error
+	GetOrders(ctx context.Context, filter domain.OrderFilter) ([]domain.Order, error)
+	GetOrderByID(ctx context.Context, id string) (*domain.Order, error)
+	GetCustomerByID(ctx context.Context, id string) (*domain.Customer, error)
+	CreateRefund(ctx context.Context, refund domain.Refund) error
+	UpdateRefundStatus(ctx context.Context, refund domain.Refund) error
+	GetOrderRefunds(ctx context.Context, orderID string) ([]domain.Refund, error)
+	GetPendingRefunds(ctx context.Context, createdBefore time.Time, limit int) ([]domain.Refund, error)
+	StreamOrders(ctx context.Context, filter domain.OrderFilter, fn func(order domain.Order) error) error
 }
 
 type Service struct {
 	Storage            StorageRepository
 	TransactionManager TransactionManager
 	ProductService     ProductService
+	InventoryService   InventoryService
+	PricingService     PricingService
+	PromotionService   PromotionService
+	TaxCalculator      TaxCalculator
+	PaymentGateway     PaymentGateway
 }
 
-func NewService(storageRepository StorageRepository, transactionManager TransactionManager, productService ProductService) *Service {
+func NewService(storageRepository StorageRepository, transactionManager TransactionManager, productService ProductService, inventoryService InventoryService, pricingService PricingService, promotionService PromotionService, taxCalculator TaxCalculator, paymentGateway PaymentGateway) *Service {
 	return &Service{
 		Storage:            storageRepository,
 		TransactionManager: transactionManager,
 		ProductService:     productService,
+		InventoryService:   inventoryService,
+		PricingService:     pricingService,
+		PromotionService:   promotionService,
+		TaxCalculator:      taxCalculator,
+		PaymentGateway:     paymentGateway,
 	}
 }
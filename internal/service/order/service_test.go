@@ -18,9 +18,14 @@ func TestNewService(t *testing.T) {
 	mockTransaction := mocks.NewMockTransactionManager(ctrl)
 	mockStorage := mocks.NewMockStorageRepository(ctrl)
 	mockProductService := mocks.NewMockProductService(ctrl)
+	mockInventoryService := mocks.NewMockInventoryService(ctrl)
+	mockPricingService := mocks.NewMockPricingService(ctrl)
+	mockPromotionService := mocks.NewMockPromotionService(ctrl)
+	mockTaxCalculator := mocks.NewMockTaxCalculator(ctrl)
+	mockPaymentGateway := mocks.NewMockPaymentGateway(ctrl)
 
 	// Act: Call the constructor function that we are testing.
-	service := order.NewService(mockStorage, mockTransaction, mockProductService)
+	service := order.NewService(mockStorage, mockTransaction, mockProductService, mockInventoryService, mockPricingService, mockPromotionService, mockTaxCalculator, mockPaymentGateway)
 
 	// Assert: Verify the outcome.
 	// 1. Ensure the service object was actually created.
@@ -0,0 +1,162 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// settleOrders charges the pending orders and confirms them. Every order is authorised first
+// and captured once all of them are, so a declined card does not leave part of a checkout
+// charged. When a step fails the payments already taken are voided or refunded and the orders
+// are compensated, the payment error is returned: domain.ErrPaymentDeclined or
+// domain.ErrPaymentTimeout from the authorisation, domain.ErrPaymentFailed from a later step.
+func (s *Service) settleOrders(ctx context.Context, orders []domain.Order) error {
+	authorizations := make([]domain.PaymentAuthorization, 0, len(orders))
+	for _, order := range orders {
+		authorization, err := s.PaymentGateway.Authorize(ctx, domain.PaymentRequest{
+			OrderID:    order.ID,
+			CustomerID: *order.CustomerID,
+			Amount:     order.Total,
+		})
+		if err != nil {
+			s.releasePayments(ctx, authorizations, 0)
+			return s.compensateOrders(ctx, orders, err)
+		}
+		authorizations = append(authorizations, authorization)
+	}
+
+	for i, authorization := range authorizations {
+		if err := s.PaymentGateway.Capture(ctx, authorization.ID, authorization.Amount); err != nil {
+			s.releasePayments(ctx, authorizations, i)
+			return s.compensateOrders(ctx, orders, fmt.Errorf("%w: %w", domain.ErrPaymentFailed, err))
+		}
+	}
+
+	// the orders are confirmed together, an order compensated by the reaper meanwhile is no
+	// longer pending and fails the confirmation of all of them. The invoice numbers are
+	// allocated here so only the paid orders have one, a rollback gives them back.
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		for i := range orders {
+			number, err := s.Storage.NextInvoiceNumber(txCtx)
+			if err != nil {
+				return err
+			}
+			orders[i].Status = domain.OrderConfirmed
+			orders[i].PaymentID = &authorizations[i].ID
+			orders[i].InvoiceNumber = &number
+			if err := s.Storage.UpdateOrderPayment(txCtx, orders[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.releasePayments(ctx, authorizations, len(authorizations))
+		return s.compensateOrders(ctx, orders, fmt.Errorf("%w: %w", domain.ErrPaymentFailed, err))
+	}
+	return nil
+}
+
+// releasePayments refunds the first captured authorizations and voids the rest. A failure is
+// only logged, the gateway expires the authorisations that were not voided.
+func (s *Service) releasePayments(ctx context.Context, authorizations []domain.PaymentAuthorization, captured int) {
+	for i, authorization := range authorizations {
+		var err error
+		if i < captured {
+			err = s.PaymentGateway.Refund(ctx, authorization.ID, authorization.Amount, authorization.ID)
+		} else {
+			err = s.PaymentGateway.Void(ctx, authorization.ID)
+		}
+		if err != nil {
+			logging.FromContext(ctx).Error("error releasing payment", "payment_id", authorization.ID, "error", err)
+		}
+	}
+}
+
+// compensateOrders undoes the pending orders in a single transaction, see undoOrders. The
+// cause is returned, joined with domain.ErrCompensationFailed when the compensation fails
+// too: the orders are left to the reaper and the failure is the service's, not the payer's.
+func (s *Service) compensateOrders(ctx context.Context, orders []domain.Order, cause error) error {
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		_, err := s.undoOrders(txCtx, orders)
+		return err
+	})
+	if err != nil {
+		logging.FromContext(ctx).Error("error compensating orders after a payment failure", "error", err)
+		return errors.Join(cause, fmt.Errorf("%w: %w", domain.ErrCompensationFailed, err))
+	}
+	return cause
+}
+
+// undoOrders marks the orders payment_failed, then puts their stock back and releases their
+// coupon redemptions. It must run in a transaction. The orders that are no longer pending
+// were already confirmed or compensated, by the reaper or by a concurrent settlement, and are
+// skipped, it returns how many orders were undone. The order rows are all locked before the
+// products, in the same order as the reaper, so the two cannot deadlock.
+func (s *Service) undoOrders(ctx context.Context, orders []domain.Order) (int, error) {
+	undone := make([]domain.Order, 0, len(orders))
+	for i := range orders {
+		orders[i].Status = domain.OrderPaymentFailed
+		orders[i].PaymentID = nil
+		orders[i].InvoiceNumber = nil
+		err := s.Storage.UpdateOrderPayment(ctx, orders[i])
+		if errors.Is(err, domain.ErrOrderNotPending) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		undone = append(undone, orders[i])
+	}
+
+	for _, order := range undone {
+		if err := s.restock(ctx, order.ProductID, order.VariantID, order.Quantity); err != nil {
+			return 0, err
+		}
+		if err := s.PromotionService.ReleasePromotions(ctx, order.Discounts); err != nil {
+			return 0, err
+		}
+	}
+	return len(undone), nil
+}
+
+// restock locks the product, or the variant, of an order and puts quantity units back. The
+// order that took the units raised the stock alert of the product when it crossed the reorder
+// point, the alert is resolved when the units bring the product back over it.
+func (s *Service) restock(ctx context.Context, productID string, variantID *string, quantity int) error {
+	product, err := s.ProductService.GetProductByID(ctx, productID)
+	if err != nil {
+		return err
+	}
+	wasBelowReorderPoint := product.BelowReorderPoint()
+
+	if variantID != nil {
+		variant, err := s.ProductService.GetVariantByID(ctx, *variantID)
+		if err != nil {
+			return err
+		}
+		variant.Stock += quantity
+		for i := range product.Variants {
+			if product.Variants[i].ID == variant.ID {
+				product.Variants[i].Stock = variant.Stock
+			}
+		}
+		if err := s.ProductService.SaveVariant(ctx, variant); err != nil {
+			return err
+		}
+	} else {
+		product.Stock += quantity
+		if err := s.ProductService.SaveProduct(ctx, product); err != nil {
+			return err
+		}
+	}
+
+	if wasBelowReorderPoint && !product.BelowReorderPoint() {
+		_, err := s.InventoryService.EvaluateStock(ctx, product)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package order_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/order"
+	"microservice-products-catalog/internal/service/order/mocks"
+	"testing"
+)
+
+func TestCreateOrder_Payment(t *testing.T) {
+	captureErr := errors.New("card issuer unavailable")
+
+	type testCase struct {
+		testName       string
+		setupGateway   func(gateway *mocks.MockPaymentGateway)
+		expectedStatus domain.OrderStatus
+		expectedStock  int
+		expectedError  error
+	}
+
+	authorize := func(gateway *mocks.MockPaymentGateway) *gomock.Call {
+		return gateway.EXPECT().Authorize(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, request domain.PaymentRequest) (domain.PaymentAuthorization, error) {
+				return domain.PaymentAuthorization{ID: "auth-1", Amount: request.Amount}, nil
+			})
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - the payment is captured and the order confirmed",
+			setupGateway: func(gateway *mocks.MockPaymentGateway) {
+				authorize(gateway).Times(1)
+				gateway.EXPECT().Capture(gomock.Any(), "auth-1", domain.NewMoney(2000, domain.BaseCurrency)).Return(nil).Times(1)
+			},
+			expectedStatus: domain.OrderConfirmed,
+			expectedStock:  8,
+		},
+		{
+			testName: "Failure - declined payment puts the stock and the coupon back",
+			setupGateway: func(gateway *mocks.MockPaymentGateway) {
+				gateway.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(domain.PaymentAuthorization{}, domain.ErrPaymentDeclined).Times(1)
+			},
+			expectedStatus: domain.OrderPaymentFailed,
+			expectedStock:  10,
+			expectedError:  domain.ErrPaymentDeclined,
+		},
+		{
+			testName: "Failure - gateway timeout compensates the order",
+			setupGateway: func(gateway *mocks.MockPaymentGateway) {
+				gateway.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(domain.PaymentAuthorization{}, domain.ErrPaymentTimeout).Times(1)
+			},
+			expectedStatus: domain.OrderPaymentFailed,
+			expectedStock:  10,
+			expectedError:  domain.ErrPaymentTimeout,
+		},
+		{
+			testName: "Failure - capture error voids the authorisation",
+			setupGateway: func(gateway *mocks.MockPaymentGateway) {
+				authorize(gateway).Times(1)
+				gateway.EXPECT().Capture(gomock.Any(), "auth-1", gomock.Any()).Return(captureErr).Times(1)
+				gateway.EXPECT().Void(gomock.Any(), "auth-1").Return(nil).Times(1)
+			},
+			expectedStatus: domain.OrderPaymentFailed,
+			expectedStock:  10,
+			expectedError:  domain.ErrPaymentFailed,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			customerID := "5f3c2b1a-0d9e-4c8b-a7f6-e5d4c3b2a190"
+			promotionID := "8a7b6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"
+			code := "SUMMER10"
+			product := &domain.Product{ID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Price: domain.NewMoney(1500, domain.BaseCurrency), Stock: 10}
+			discounts := []domain.OrderDiscount{{PromotionID: &promotionID, Name: "Summer coupon", CouponCode: &code, Amount: domain.NewMoney(1000, domain.BaseCurrency)}}
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockStorage.EXPECT().NextInvoiceNumber(gomock.Any()).Return(int64(1), nil).AnyTimes()
+			mockProductService := mocks.NewMockProductService(ctrl)
+			mockTxManager := mocks.NewMockTransactionManager(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
+			mockPricing := mocks.NewMockPricingService(ctrl)
+			mockPricing.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).AnyTimes()
+			mockPromotion := mocks.NewMockPromotionService(ctrl)
+			mockPromotion.EXPECT().ApplyPromotions(gomock.Any(), gomock.Any()).Return(discounts, nil).Times(1)
+			mockTax := mocks.NewMockTaxCalculator(ctrl)
+			mockTax.EXPECT().Calculate(gomock.Any(), gomock.Any()).DoAndReturn(noTax).AnyTimes()
+			mockGateway := mocks.NewMockPaymentGateway(ctrl)
+			tc.setupGateway(mockGateway)
+
+			mockTxManager.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(ctx)
+				}).AnyTimes()
+			mockStorage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).Times(1)
+			mockProductService.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(product, nil).AnyTimes()
+			mockProductService.EXPECT().SaveProduct(gomock.Any(), product).Return(nil).AnyTimes()
+
+			var placed domain.Order
+			mockStorage.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, order domain.Order) error {
+				placed = order
+				return nil
+			}).Times(1)
+
+			var updated domain.Order
+			mockStorage.EXPECT().UpdateOrderPayment(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, order domain.Order) error {
+				updated = order
+				return nil
+			}).Times(1)
+			if tc.expectedError != nil {
+				mockPromotion.EXPECT().ReleasePromotions(gomock.Any(), discounts).Return(nil).Times(1)
+			}
+
+			service := order.NewService(mockStorage, mockTxManager, mockProductService, mockInventory, mockPricing, mockPromotion, mockTax, mockGateway)
+
+			// Act
+			err := service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: product.ID, Quantity: 2, CouponCode: code})
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				if tc.expectedError == domain.ErrPaymentFailed {
+					assert.ErrorIs(t, err, captureErr)
+				}
+				assert.Nil(t, updated.PaymentID)
+				assert.Nil(t, updated.InvoiceNumber)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "auth-1", *updated.PaymentID)
+				assert.Equal(t, int64(1), *updated.InvoiceNumber)
+			}
+			assert.Equal(t, domain.OrderPending, placed.Status)
+			assert.Nil(t, placed.InvoiceNumber)
+			assert.Equal(t, product.Price, placed.UnitPrice)
+			assert.Equal(t, placed.ID, updated.ID)
+			assert.Equal(t, tc.expectedStatus, updated.Status)
+			assert.Equal(t, tc.expectedStock, product.Stock)
+		})
+	}
+}
+
+func TestCreateOrder_CompensationFailed(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	customerID := "5f3c2b1a-0d9e-4c8b-a7f6-e5d4c3b2a190"
+	product := &domain.Product{ID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 10}
+	storageErr := errors.New("Error 1213: Deadlock found when trying to get lock")
+
+	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockStorage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).Times(1)
+	mockStorage.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockStorage.EXPECT().UpdateOrderPayment(gomock.Any(), gomock.Any()).Return(storageErr).Times(1)
+	mockProductService := mocks.NewMockProductService(ctrl)
+	mockProductService.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(product, nil).Times(1)
+	mockProductService.EXPECT().SaveProduct(gomock.Any(), product).Return(nil).Times(1)
+	mockTxManager := mocks.NewMockTransactionManager(ctrl)
+	mockTxManager.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).Times(2)
+	mockPricing := mocks.NewMockPricingService(ctrl)
+	mockPricing.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).Times(1)
+	mockPromotion := mocks.NewMockPromotionService(ctrl)
+	mockPromotion.EXPECT().ApplyPromotions(gomock.Any(), gomock.Any()).Return([]domain.OrderDiscount{}, nil).Times(1)
+	mockTax := mocks.NewMockTaxCalculator(ctrl)
+	mockTax.EXPECT().Calculate(gomock.Any(), gomock.Any()).DoAndReturn(noTax).Times(1)
+	mockGateway := mocks.NewMockPaymentGateway(ctrl)
+	mockGateway.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(domain.PaymentAuthorization{}, domain.ErrPaymentDeclined).Times(1)
+
+	service := order.NewService(mockStorage, mockTxManager, mockProductService, mocks.NewMockInventoryService(ctrl), mockPricing, mockPromotion, mockTax, mockGateway)
+
+	// Act
+	err := service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: product.ID, Quantity: 2})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrPaymentDeclined)
+	assert.ErrorIs(t, err, domain.ErrCompensationFailed)
+	assert.ErrorIs(t, err, storageErr)
+	// the order still holds the stock, it is left to the reaper
+	assert.Equal(t, 8, product.Stock)
+}
+
+func TestCreateOrder_PaymentFailureResolvesStockAlert(t *testing.T) {
+	// Arrange
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	customerID := "5f3c2b1a-0d9e-4c8b-a7f6-e5d4c3b2a190"
+	product := &domain.Product{ID: "076e76d6-fc3e-4f95-a024-1b4984e76060", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 6, ReorderPoint: 5}
+
+	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockStorage.EXPECT().GetCustomerByID(gomock.Any(), customerID).Return(&domain.Customer{ID: customerID}, nil).Times(1)
+	mockStorage.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockStorage.EXPECT().UpdateOrderPayment(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockProductService := mocks.NewMockProductService(ctrl)
+	mockProductService.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(product, nil).Times(2)
+	mockProductService.EXPECT().SaveProduct(gomock.Any(), product).Return(nil).Times(2)
+	mockTxManager := mocks.NewMockTransactionManager(ctrl)
+	mockTxManager.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).Times(2)
+	mockInventory := mocks.NewMockInventoryService(ctrl)
+	// the order raises the alert, the compensation resolves it and nothing is notified
+	gomock.InOrder(
+		mockInventory.EXPECT().EvaluateStock(gomock.Any(), product).DoAndReturn(func(_ context.Context, p *domain.Product) (*domain.StockAlert, error) {
+			assert.True(t, p.BelowReorderPoint())
+			return &domain.StockAlert{ID: "alert-1", ProductID: p.ID}, nil
+		}).Times(1),
+		mockInventory.EXPECT().EvaluateStock(gomock.Any(), product).DoAndReturn(func(_ context.Context, p *domain.Product) (*domain.StockAlert, error) {
+			assert.False(t, p.BelowReorderPoint())
+			return nil, nil
+		}).Times(1),
+	)
+	mockPricing := mocks.NewMockPricingService(ctrl)
+	mockPricing.EXPECT().Quoter(gomock.Any(), "").Return(domain.Quoter{}).Times(1)
+	mockPromotion := mocks.NewMockPromotionService(ctrl)
+	mockPromotion.EXPECT().ApplyPromotions(gomock.Any(), gomock.Any()).Return([]domain.OrderDiscount{}, nil).Times(1)
+	mockPromotion.EXPECT().ReleasePromotions(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockTax := mocks.NewMockTaxCalculator(ctrl)
+	mockTax.EXPECT().Calculate(gomock.Any(), gomock.Any()).DoAndReturn(noTax).Times(1)
+	mockGateway := mocks.NewMockPaymentGateway(ctrl)
+	mockGateway.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(domain.PaymentAuthorization{}, domain.ErrPaymentDeclined).Times(1)
+
+	service := order.NewService(mockStorage, mockTxManager, mockProductService, mockInventory, mockPricing, mockPromotion, mockTax, mockGateway)
+
+	// Act
+	err := service.CreateOrder(context.Background(), domain.OrderRequest{CustomerID: customerID, ProductID: product.ID, Quantity: 2})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrPaymentDeclined)
+	assert.Equal(t, 6, product.Stock)
+}
@@ -0,0 +1,33 @@
+package pricing
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// ConvertProducts rewrites the prices of the products, and the price overrides of their
+// variants, in currency. The exchange rate is resolved once for all of them.
+func (s *Service) ConvertProducts(ctx context.Context, products []domain.Product, currency string) error {
+	quoter := s.Quoter(ctx, currency)
+	for i := range products {
+		product := products[i]
+
+		quote, err := quoter.Quote(product, nil)
+		if err != nil {
+			return err
+		}
+		products[i].Price = quote.Price
+
+		for j, variant := range product.Variants {
+			if variant.Price == nil {
+				continue
+			}
+			quote, err := quoter.Quote(product, &variant)
+			if err != nil {
+				return err
+			}
+			products[i].Variants[j].Price = &quote.Price
+		}
+	}
+	return nil
+}
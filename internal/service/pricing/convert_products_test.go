@@ -0,0 +1,47 @@
+package pricing_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/pricing"
+	"microservice-products-catalog/internal/service/pricing/mocks"
+	"testing"
+)
+
+func TestConvertProducts(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	variantPrice := domain.NewMoney(3000, "USD")
+	products := []domain.Product{
+		{
+			ID:     "shirt",
+			Price:  domain.NewMoney(2000, "USD"),
+			Prices: []domain.Money{domain.NewMoney(1500, "EUR")},
+			Variants: []domain.Variant{
+				{ID: "shirt-m"},
+				{ID: "shirt-xl", Price: &variantPrice},
+			},
+		},
+		{ID: "mug", Price: domain.NewMoney(1000, "USD")},
+	}
+
+	mockProvider := mocks.NewMockExchangeRateProvider(ctrl)
+	mockProvider.EXPECT().GetRate(gomock.Any(), "USD", "EUR").Return(0.9, nil).Times(1)
+
+	service := pricing.NewService(mockProvider)
+
+	// Act
+	err := service.ConvertProducts(context.Background(), products, "EUR")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, domain.NewMoney(1500, "EUR"), products[0].Price, "the list price is used")
+	assert.Nil(t, products[0].Variants[0].Price, "variants without override keep using the product price")
+	assert.Equal(t, domain.NewMoney(2700, "EUR"), *products[0].Variants[1].Price)
+	assert.Equal(t, domain.NewMoney(900, "EUR"), products[1].Price)
+}
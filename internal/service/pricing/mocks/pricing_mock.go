@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockExchangeRateProvider is a mock of ExchangeRateProvider interface.
+type MockExchangeRateProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateProviderMockRecorder
+}
+
+// MockExchangeRateProviderMockRecorder is the mock recorder for MockExchangeRateProvider.
+type MockExchangeRateProviderMockRecorder struct {
+	mock *MockExchangeRateProvider
+}
+
+// NewMockExchangeRateProvider creates a new mock instance.
+func NewMockExchangeRateProvider(ctrl *gomock.Controller) *MockExchangeRateProvider {
+	mock := &MockExchangeRateProvider{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateProvider) EXPECT() *MockExchangeRateProviderMockRecorder {
+	return m.recorder
+}
+
+// GetRate mocks base method.
+func (m *MockExchangeRateProvider) GetRate(ctx context.Context, from, to string) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", ctx, from, to)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockExchangeRateProviderMockRecorder) GetRate(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockExchangeRateProvider)(nil).GetRate), ctx, from, to)
+}
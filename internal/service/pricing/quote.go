@@ -0,0 +1,32 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+	"strings"
+)
+
+// Quoter resolves the exchange rate from the base currency to currency, the quotes of the
+// returned quoter make no call to the provider. The orders resolve it before their transaction
+// locks any row. A failed rate is kept in the quoter, the list prices can still be quoted.
+func (s *Service) Quoter(ctx context.Context, currency string) domain.Quoter {
+	currency = strings.ToUpper(currency)
+	quoter := domain.Quoter{Currency: currency, Rate: 1}
+	if currency == "" || currency == domain.BaseCurrency {
+		return quoter
+	}
+
+	rate, err := s.ExchangeRateProvider.GetRate(ctx, domain.BaseCurrency, currency)
+	if err != nil {
+		quoter.RateErr = fmt.Errorf("error converting %s to %s: %w", domain.BaseCurrency, currency, err)
+		return quoter
+	}
+	quoter.Rate = rate
+	return quoter
+}
+
+// Quote prices the product, or its variant when it is not nil, in currency, see domain.Quoter.
+func (s *Service) Quote(ctx context.Context, product domain.Product, variant *domain.Variant, currency string) (domain.PriceQuote, error) {
+	return s.Quoter(ctx, currency).Quote(product, variant)
+}
@@ -0,0 +1,118 @@
+package pricing_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/pricing"
+	"microservice-products-catalog/internal/service/pricing/mocks"
+	"testing"
+)
+
+func TestQuote(t *testing.T) {
+	shirt := domain.Product{
+		ID:     "5f8c3e1a-2b4d-4c6e-8a0f-1d3b5e7f9a21",
+		Price:  domain.NewMoney(2000, "USD"),
+		Prices: []domain.Money{domain.NewMoney(2500000, "ARS")},
+	}
+	variantPrice := domain.NewMoney(2599, "USD")
+	overridden := domain.Variant{ID: "variant-xl", ProductID: shirt.ID, Price: &variantPrice}
+	rate := 0.92
+
+	type testCase struct {
+		testName      string
+		variant       *domain.Variant
+		currency      string
+		setupMock     func(provider *mocks.MockExchangeRateProvider)
+		expectedQuote domain.PriceQuote
+		expectedError string
+	}
+
+	testCases := []testCase{
+		{
+			testName:      "Success - Base currency is not converted",
+			currency:      "usd",
+			setupMock:     func(provider *mocks.MockExchangeRateProvider) {},
+			expectedQuote: domain.PriceQuote{Price: domain.NewMoney(2000, "USD")},
+		},
+		{
+			testName: "Success - List price wins over the conversion",
+			currency: "ARS",
+			setupMock: func(provider *mocks.MockExchangeRateProvider) {
+				provider.EXPECT().GetRate(gomock.Any(), "USD", "ARS").Return(1000.0, nil).Times(1)
+			},
+			expectedQuote: domain.PriceQuote{Price: domain.NewMoney(2500000, "ARS")},
+		},
+		{
+			testName: "Success - List price is quoted while the provider is down",
+			currency: "ARS",
+			setupMock: func(provider *mocks.MockExchangeRateProvider) {
+				provider.EXPECT().GetRate(gomock.Any(), "USD", "ARS").Return(0.0, errors.New("timeout")).Times(1)
+			},
+			expectedQuote: domain.PriceQuote{Price: domain.NewMoney(2500000, "ARS")},
+		},
+		{
+			testName: "Success - Converted with the exchange rate",
+			currency: "EUR",
+			setupMock: func(provider *mocks.MockExchangeRateProvider) {
+				provider.EXPECT().GetRate(gomock.Any(), "USD", "EUR").Return(rate, nil).Times(1)
+			},
+			expectedQuote: domain.PriceQuote{Price: domain.NewMoney(1840, "EUR"), ExchangeRate: &rate},
+		},
+		{
+			testName: "Success - Variant override is converted and rounded half away from zero",
+			variant:  &overridden,
+			currency: "ARS",
+			setupMock: func(provider *mocks.MockExchangeRateProvider) {
+				provider.EXPECT().GetRate(gomock.Any(), "USD", "ARS").Return(1050.5, nil).Times(1)
+			},
+			// 25.99 * 1050.5 = 27302.495
+			expectedQuote: domain.PriceQuote{Price: domain.NewMoney(2730250, "ARS"), ExchangeRate: func() *float64 { r := 1050.5; return &r }()},
+		},
+		{
+			testName: "Failure - Unknown currency",
+			currency: "BRL",
+			setupMock: func(provider *mocks.MockExchangeRateProvider) {
+				provider.EXPECT().GetRate(gomock.Any(), "USD", "BRL").Return(0.0, domain.ErrExchangeRateNotFound).Times(1)
+			},
+			expectedError: "error converting USD to BRL: exchange rate not found",
+		},
+		{
+			testName: "Failure - Provider is down",
+			currency: "EUR",
+			setupMock: func(provider *mocks.MockExchangeRateProvider) {
+				provider.EXPECT().GetRate(gomock.Any(), "USD", "EUR").Return(0.0, errors.New("timeout")).Times(1)
+			},
+			expectedError: "error converting USD to EUR: timeout",
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockProvider := mocks.NewMockExchangeRateProvider(ctrl)
+			tc.setupMock(mockProvider)
+
+			service := pricing.NewService(mockProvider)
+
+			// Act
+			quote, err := service.Quote(context.Background(), shirt, tc.variant, tc.currency)
+
+			// Assert
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedQuote, quote)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package pricing
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=service.go -destination=././mocks/pricing_mock.go -package=mocks
+
+// ExchangeRateProvider returns the units of the currency to per unit of the currency from.
+type ExchangeRateProvider interface {
+	GetRate(ctx context.Context, from string, to string) (float64, error)
+}
+
+type Service struct {
+	ExchangeRateProvider ExchangeRateProvider
+}
+
+func NewService(exchangeRateProvider ExchangeRateProvider) *Service {
+	return &Service{
+		ExchangeRateProvider: exchangeRateProvider,
+	}
+}
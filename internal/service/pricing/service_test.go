@@ -0,0 +1,22 @@
+package pricing_test
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/service/pricing"
+	"microservice-products-catalog/internal/service/pricing/mocks"
+	"testing"
+)
+
+// TestNewService verifies that the service constructor correctly initializes
+// the service with its dependencies.
+func TestNewService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mockProvider := mocks.NewMockExchangeRateProvider(ctrl)
+
+	service := pricing.NewService(mockProvider)
+
+	assert.NotNil(t, service)
+	assert.Equal(t, mockProvider, service.ExchangeRateProvider, "ExchangeRateProvider should be the provided mock instance")
+}
@@ -0,0 +1,132 @@
+package product
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+	"time"
+)
+
+// scheduledPricesBatch bounds the schedules processed by a single run of the scheduler.
+const scheduledPricesBatch = 100
+
+// RunPriceScheduler applies the due scheduled prices every interval until the context is
+// cancelled. Every replica can run it: the state lives in the scheduled_prices rows, so a
+// restart resumes where it stopped, and every schedule moves forward in its own transaction
+// holding the lock of its row, so no schedule is applied or ended twice.
+func (s *Service) RunPriceScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.ApplyScheduledPrices(ctx); err != nil {
+			logging.FromContext(ctx).Error("error applying scheduled prices", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ApplyScheduledPrices moves forward the scheduled prices due now and returns how many changed.
+// A failure on one schedule is logged and the others are still processed, the failed one is
+// retried by the next run.
+func (s *Service) ApplyScheduledPrices(ctx context.Context) (int, error) {
+	now := s.Now()
+	due, err := s.Storage.GetDueScheduledPrices(ctx, now, scheduledPricesBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for _, scheduledPrice := range due {
+		changed, err := s.applyScheduledPrice(ctx, scheduledPrice.ID, now)
+		if err != nil {
+			logging.FromContext(ctx).Error("error applying scheduled price", "scheduled_price_id", scheduledPrice.ID, "error", err)
+			continue
+		}
+		if changed {
+			processed++
+		}
+	}
+	return processed, nil
+}
+
+// applyScheduledPrice locks the schedule and checks it again, another replica may have
+// processed it since it was listed.
+func (s *Service) applyScheduledPrice(ctx context.Context, id string, now time.Time) (bool, error) {
+	changed := false
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		scheduledPrice, err := s.Storage.GetScheduledPriceByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if !scheduledPrice.DueAt(now) {
+			return nil
+		}
+		changed = true
+
+		if scheduledPrice.Status == domain.ScheduledPriceActive {
+			return s.endScheduledPrice(txCtx, scheduledPrice, now)
+		}
+		return s.startScheduledPrice(txCtx, scheduledPrice, now)
+	})
+	return changed, err
+}
+
+func (s *Service) startScheduledPrice(ctx context.Context, scheduledPrice *domain.ScheduledPrice, now time.Time) error {
+	scheduledPrice.AppliedAt = &now
+
+	// the whole window passed while no scheduler was running, the price is left alone
+	if scheduledPrice.EndsAt != nil && !scheduledPrice.EndsAt.After(now) {
+		scheduledPrice.Status = domain.ScheduledPriceCompleted
+		scheduledPrice.EndedAt = &now
+		return s.Storage.UpdateScheduledPrice(ctx, scheduledPrice)
+	}
+
+	product, err := s.Storage.GetProductByID(ctx, scheduledPrice.ProductID)
+	if err != nil {
+		return err
+	}
+	previous := product.Price
+
+	if err := s.setBasePrice(ctx, product, scheduledPrice.Price, domain.PriceChangeScheduled, scheduledPrice.ID); err != nil {
+		return err
+	}
+
+	scheduledPrice.PreviousPrice = &previous
+	scheduledPrice.Status = domain.ScheduledPriceActive
+	if scheduledPrice.EndsAt == nil {
+		scheduledPrice.Status = domain.ScheduledPriceCompleted
+	}
+	return s.Storage.UpdateScheduledPrice(ctx, scheduledPrice)
+}
+
+// endScheduledPrice restores the price the product had before the schedule. When the price was
+// changed by hand during the window the manual price is kept.
+func (s *Service) endScheduledPrice(ctx context.Context, scheduledPrice *domain.ScheduledPrice, now time.Time) error {
+	product, err := s.Storage.GetProductByID(ctx, scheduledPrice.ProductID)
+	if err != nil {
+		return err
+	}
+
+	if scheduledPrice.PreviousPrice != nil && product.Price.Amount == scheduledPrice.Price.Amount {
+		if err := s.setBasePrice(ctx, product, *scheduledPrice.PreviousPrice, domain.PriceChangeScheduleEnded, scheduledPrice.ID); err != nil {
+			return err
+		}
+	}
+
+	scheduledPrice.Status = domain.ScheduledPriceCompleted
+	scheduledPrice.EndedAt = &now
+	return s.Storage.UpdateScheduledPrice(ctx, scheduledPrice)
+}
+
+func (s *Service) setBasePrice(ctx context.Context, product *domain.Product, price domain.Money, source domain.PriceChangeSource, scheduledPriceID string) error {
+	if err := s.Storage.UpdateProduct(ctx, &domain.Product{ID: product.ID, Price: price}); err != nil {
+		return err
+	}
+	return s.recordPriceChange(ctx, product.ID, product.Price, price, source, &scheduledPriceID)
+}
@@ -0,0 +1,173 @@
+package product_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/product"
+	"microservice-products-catalog/internal/service/product/mocks"
+	"testing"
+	"time"
+)
+
+func TestApplyScheduledPrices(t *testing.T) {
+	now := time.Date(2026, time.November, 27, 0, 0, 30, 0, time.UTC)
+	effectiveAt := time.Date(2026, time.November, 27, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2026, time.November, 30, 0, 0, 0, 0, time.UTC)
+	productID := uuid.New().String()
+
+	regular := domain.NewMoney(1000, domain.BaseCurrency)
+	sale := domain.NewMoney(799, domain.BaseCurrency)
+	manual := domain.NewMoney(899, domain.BaseCurrency)
+
+	pending := domain.ScheduledPrice{ID: uuid.New().String(), ProductID: productID, Price: sale, EffectiveAt: effectiveAt, EndsAt: &endsAt, Status: domain.ScheduledPricePending}
+	ended := domain.ScheduledPrice{ID: uuid.New().String(), ProductID: productID, Price: sale, EffectiveAt: effectiveAt.AddDate(0, 0, -7), EndsAt: &effectiveAt, Status: domain.ScheduledPriceActive, PreviousPrice: &regular}
+
+	type testCase struct {
+		testName          string
+		due               domain.ScheduledPrice
+		locked            domain.ScheduledPrice
+		setupMock         func(storage *mocks.MockStorageRepository)
+		expectedStatus    domain.ScheduledPriceStatus
+		expectedProcessed int
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Pending window starts and keeps the previous price",
+			due:      pending,
+			locked:   pending,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(&domain.Product{ID: productID, Price: regular}, nil).Times(1)
+				storage.EXPECT().UpdateProduct(gomock.Any(), &domain.Product{ID: productID, Price: sale}).Return(nil).Times(1)
+				storage.EXPECT().
+					CreatePriceChange(gomock.Any(), domain.PriceChange{ProductID: productID, OldPrice: regular, NewPrice: sale, Source: domain.PriceChangeScheduled, ScheduledPriceID: &pending.ID, ChangedAt: now}).
+					Return(nil).Times(1)
+			},
+			expectedStatus:    domain.ScheduledPriceActive,
+			expectedProcessed: 1,
+		},
+		{
+			testName: "Success - Ended window restores the previous price",
+			due:      ended,
+			locked:   ended,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(&domain.Product{ID: productID, Price: sale}, nil).Times(1)
+				storage.EXPECT().UpdateProduct(gomock.Any(), &domain.Product{ID: productID, Price: regular}).Return(nil).Times(1)
+				storage.EXPECT().
+					CreatePriceChange(gomock.Any(), domain.PriceChange{ProductID: productID, OldPrice: sale, NewPrice: regular, Source: domain.PriceChangeScheduleEnded, ScheduledPriceID: &ended.ID, ChangedAt: now}).
+					Return(nil).Times(1)
+			},
+			expectedStatus:    domain.ScheduledPriceCompleted,
+			expectedProcessed: 1,
+		},
+		{
+			testName: "Success - Ended window keeps a price changed by hand",
+			due:      ended,
+			locked:   ended,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(&domain.Product{ID: productID, Price: manual}, nil).Times(1)
+			},
+			expectedStatus:    domain.ScheduledPriceCompleted,
+			expectedProcessed: 1,
+		},
+		{
+			testName:          "Success - Window missed while no scheduler ran is skipped",
+			due:               domain.ScheduledPrice{ID: pending.ID, ProductID: productID, Price: sale, EffectiveAt: effectiveAt.AddDate(0, 0, -7), EndsAt: &effectiveAt, Status: domain.ScheduledPricePending},
+			locked:            domain.ScheduledPrice{ID: pending.ID, ProductID: productID, Price: sale, EffectiveAt: effectiveAt.AddDate(0, 0, -7), EndsAt: &effectiveAt, Status: domain.ScheduledPricePending},
+			setupMock:         func(storage *mocks.MockStorageRepository) {},
+			expectedStatus:    domain.ScheduledPriceCompleted,
+			expectedProcessed: 1,
+		},
+		{
+			testName:          "Success - Schedule already applied by another replica is not applied twice",
+			due:               pending,
+			locked:            domain.ScheduledPrice{ID: pending.ID, ProductID: productID, Price: sale, EffectiveAt: effectiveAt, EndsAt: &endsAt, Status: domain.ScheduledPriceActive},
+			setupMock:         func(storage *mocks.MockStorageRepository) {},
+			expectedProcessed: 0,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockTransaction.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			locked := tc.locked
+			mockStorage.EXPECT().GetDueScheduledPrices(gomock.Any(), now, gomock.Any()).Return([]domain.ScheduledPrice{tc.due}, nil).Times(1)
+			mockStorage.EXPECT().GetScheduledPriceByID(gomock.Any(), tc.due.ID).Return(&locked, nil).Times(1)
+			tc.setupMock(mockStorage)
+			if tc.expectedStatus != "" {
+				mockStorage.EXPECT().
+					UpdateScheduledPrice(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, scheduledPrice *domain.ScheduledPrice) error {
+						assert.Equal(t, tc.expectedStatus, scheduledPrice.Status)
+						return nil
+					}).Times(1)
+			}
+
+			service := product.NewService(mockStorage, mockTransaction, mocks.NewMockInventoryService(ctrl))
+			service.Now = func() time.Time { return now }
+
+			// Act
+			processed, err := service.ApplyScheduledPrices(context.Background())
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedProcessed, processed)
+			if tc.expectedStatus == domain.ScheduledPriceActive {
+				assert.Equal(t, &regular, locked.PreviousPrice)
+			}
+		})
+	}
+}
+
+func TestApplyScheduledPrices_FailureDoesNotStopTheBatch(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2026, time.November, 27, 0, 0, 30, 0, time.UTC)
+	first := domain.ScheduledPrice{ID: uuid.New().String(), ProductID: uuid.New().String(), Price: domain.NewMoney(799, domain.BaseCurrency), EffectiveAt: now.Add(-time.Minute), Status: domain.ScheduledPricePending}
+	second := domain.ScheduledPrice{ID: uuid.New().String(), ProductID: uuid.New().String(), Price: domain.NewMoney(799, domain.BaseCurrency), EffectiveAt: now.Add(-time.Minute), Status: domain.ScheduledPricePending}
+
+	mockTransaction := mocks.NewMockTransactionManager(ctrl)
+	mockTransaction.EXPECT().
+		WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Times(2)
+	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockStorage.EXPECT().GetDueScheduledPrices(gomock.Any(), now, gomock.Any()).Return([]domain.ScheduledPrice{first, second}, nil).Times(1)
+	mockStorage.EXPECT().GetScheduledPriceByID(gomock.Any(), first.ID).Return(nil, errors.New("lock wait timeout exceeded")).Times(1)
+	mockStorage.EXPECT().GetScheduledPriceByID(gomock.Any(), second.ID).Return(&second, nil).Times(1)
+	mockStorage.EXPECT().GetProductByID(gomock.Any(), second.ProductID).Return(&domain.Product{ID: second.ProductID, Price: domain.NewMoney(1000, domain.BaseCurrency)}, nil).Times(1)
+	mockStorage.EXPECT().UpdateProduct(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockStorage.EXPECT().CreatePriceChange(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockStorage.EXPECT().UpdateScheduledPrice(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	service := product.NewService(mockStorage, mockTransaction, mocks.NewMockInventoryService(ctrl))
+	service.Now = func() time.Time { return now }
+
+	// Act
+	processed, err := service.ApplyScheduledPrices(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, domain.ScheduledPriceCompleted, second.Status)
+}
@@ -17,7 +17,7 @@ func TestCreate(t *testing.T) {
 		ID:          uuid.New().String(),
 		Name:        "Gopher",
 		Description: "Realistic replic for the Gopher animal",
-		Price:       65.42,
+		Price:       domain.NewMoney(6542, domain.BaseCurrency),
 		Stock:       50,
 	}
 
@@ -77,11 +77,12 @@ func TestCreate(t *testing.T) {
 
 			mockTransaction := mocks.NewMockTransactionManager(ctrl)
 			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
 			if tc.setupMock != nil {
 				tc.setupMock(mockStorage, mockTransaction)
 			}
 
-			service := product.NewService(mockStorage, mockTransaction)
+			service := product.NewService(mockStorage, mockTransaction, mockInventory)
 
 			// Act
 			err := service.CreateProduct(context.Background(), tc.input)
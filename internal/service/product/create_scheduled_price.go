@@ -0,0 +1,52 @@
+package product
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"microservice-products-catalog/internal/domain"
+)
+
+// CreateScheduledPrice schedules a change of the base price of the product. The product row
+// is locked while the overlap with its other pending or active schedules is checked, so two
+// concurrent requests cannot both book the same window.
+func (s *Service) CreateScheduledPrice(ctx context.Context, scheduledPrice domain.ScheduledPrice) (domain.ScheduledPrice, error) {
+	now := s.Now()
+	if err := scheduledPrice.Validate(now); err != nil {
+		return domain.ScheduledPrice{}, err
+	}
+
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		product, err := s.Storage.GetProductByID(txCtx, scheduledPrice.ProductID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := s.Storage.GetScheduledPrices(txCtx, product.ID)
+		if err != nil {
+			return err
+		}
+		for _, other := range existing {
+			if other.Status != domain.ScheduledPriceCompleted && other.Overlaps(scheduledPrice) {
+				return domain.ErrScheduledPriceConflict
+			}
+		}
+
+		scheduledPrice.ID = uuid.New().String()
+		scheduledPrice.Price = domain.NewMoney(scheduledPrice.Price.Amount, product.Price.Currency)
+		scheduledPrice.Status = domain.ScheduledPricePending
+		scheduledPrice.CreatedAt = now
+		return s.Storage.CreateScheduledPrice(txCtx, scheduledPrice)
+	})
+	if err != nil {
+		return domain.ScheduledPrice{}, err
+	}
+	return scheduledPrice, nil
+}
+
+// GetScheduledPrices returns every scheduled price of the product, including the completed ones.
+func (s *Service) GetScheduledPrices(ctx context.Context, productID string) ([]domain.ScheduledPrice, error) {
+	if _, err := s.Storage.GetProductByID(ctx, productID); err != nil {
+		return nil, err
+	}
+	return s.Storage.GetScheduledPrices(ctx, productID)
+}
@@ -0,0 +1,133 @@
+package product_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/product"
+	"microservice-products-catalog/internal/service/product/mocks"
+	"testing"
+	"time"
+)
+
+func TestCreateScheduledPrice(t *testing.T) {
+	now := time.Date(2026, time.November, 20, 12, 0, 0, 0, time.UTC)
+	productID := uuid.New().String()
+	existing := &domain.Product{ID: productID, Price: domain.NewMoney(1000, domain.BaseCurrency)}
+
+	at := func(day int) *time.Time {
+		value := time.Date(2026, time.November, day, 0, 0, 0, 0, time.UTC)
+		return &value
+	}
+	blackFriday := domain.ScheduledPrice{ID: uuid.New().String(), ProductID: productID, EffectiveAt: *at(27), EndsAt: at(30), Status: domain.ScheduledPricePending}
+
+	type testCase struct {
+		testName      string
+		input         domain.ScheduledPrice
+		setupMock     func(storage *mocks.MockStorageRepository)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Window after the other schedules",
+			input:    domain.ScheduledPrice{ProductID: productID, Price: domain.NewMoney(799, domain.BaseCurrency), EffectiveAt: *at(30), EndsAt: at(31)},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(existing, nil).Times(1)
+				storage.EXPECT().GetScheduledPrices(gomock.Any(), productID).Return([]domain.ScheduledPrice{blackFriday}, nil).Times(1)
+				storage.EXPECT().
+					CreateScheduledPrice(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, scheduledPrice domain.ScheduledPrice) error {
+						assert.NotEmpty(t, scheduledPrice.ID)
+						assert.Equal(t, domain.ScheduledPricePending, scheduledPrice.Status)
+						assert.Equal(t, now, scheduledPrice.CreatedAt)
+						return nil
+					}).Times(1)
+			},
+		},
+		{
+			testName: "Success - Completed schedules do not conflict",
+			input:    domain.ScheduledPrice{ProductID: productID, Price: domain.NewMoney(799, domain.BaseCurrency), EffectiveAt: *at(28)},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				completed := blackFriday
+				completed.Status = domain.ScheduledPriceCompleted
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(existing, nil).Times(1)
+				storage.EXPECT().GetScheduledPrices(gomock.Any(), productID).Return([]domain.ScheduledPrice{completed}, nil).Times(1)
+				storage.EXPECT().CreateScheduledPrice(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Failure - Permanent change inside another window",
+			input:    domain.ScheduledPrice{ProductID: productID, Price: domain.NewMoney(799, domain.BaseCurrency), EffectiveAt: *at(28)},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(existing, nil).Times(1)
+				storage.EXPECT().GetScheduledPrices(gomock.Any(), productID).Return([]domain.ScheduledPrice{blackFriday}, nil).Times(1)
+			},
+			expectedError: domain.ErrScheduledPriceConflict,
+		},
+		{
+			testName: "Failure - Overlapping window",
+			input:    domain.ScheduledPrice{ProductID: productID, Price: domain.NewMoney(799, domain.BaseCurrency), EffectiveAt: *at(25), EndsAt: at(28)},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(existing, nil).Times(1)
+				storage.EXPECT().GetScheduledPrices(gomock.Any(), productID).Return([]domain.ScheduledPrice{blackFriday}, nil).Times(1)
+			},
+			expectedError: domain.ErrScheduledPriceConflict,
+		},
+		{
+			testName:      "Failure - Ends before it starts",
+			input:         domain.ScheduledPrice{ProductID: productID, Price: domain.NewMoney(799, domain.BaseCurrency), EffectiveAt: *at(28), EndsAt: at(27)},
+			expectedError: domain.ErrInvalidScheduledPrice,
+		},
+		{
+			testName:      "Failure - Window already over",
+			input:         domain.ScheduledPrice{ProductID: productID, Price: domain.NewMoney(799, domain.BaseCurrency), EffectiveAt: *at(1), EndsAt: at(2)},
+			expectedError: domain.ErrInvalidScheduledPrice,
+		},
+		{
+			testName: "Failure - Product not found",
+			input:    domain.ScheduledPrice{ProductID: productID, Price: domain.NewMoney(799, domain.BaseCurrency), EffectiveAt: *at(28)},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(nil, domain.ErrProductNotFound).Times(1)
+			},
+			expectedError: domain.ErrProductNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockTransaction.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).AnyTimes()
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(mockStorage)
+			}
+
+			service := product.NewService(mockStorage, mockTransaction, mocks.NewMockInventoryService(ctrl))
+			service.Now = func() time.Time { return now }
+
+			// Act
+			_, err := service.CreateScheduledPrice(context.Background(), tc.input)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
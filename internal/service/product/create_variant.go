@@ -0,0 +1,15 @@
+package product
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) CreateVariant(ctx context.Context, variant domain.Variant) error {
+	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := s.Storage.GetProductByID(txCtx, variant.ProductID); err != nil {
+			return err
+		}
+		return s.Storage.CreateVariant(txCtx, variant)
+	})
+}
@@ -0,0 +1,78 @@
+package product_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/product"
+	"microservice-products-catalog/internal/service/product/mocks"
+	"testing"
+)
+
+func TestCreateVariant(t *testing.T) {
+	variant := domain.Variant{
+		ID:        uuid.New().String(),
+		ProductID: uuid.New().String(),
+		SKU:       "SHIRT-M-BLUE",
+		Options:   domain.VariantOptions{"size": "M", "colour": "blue"},
+		Stock:     10,
+	}
+
+	type testCase struct {
+		testName      string
+		setupMock     func(storage *mocks.MockStorageRepository)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Create variant",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetProductByID(gomock.Any(), variant.ProductID).Return(&domain.Product{ID: variant.ProductID}, nil).Times(1)
+				storage.EXPECT().CreateVariant(gomock.Any(), variant).Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Failure - Product not found",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetProductByID(gomock.Any(), variant.ProductID).Return(nil, domain.ErrProductNotFound).Times(1)
+			},
+			expectedError: domain.ErrProductNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
+			mockTransaction.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			tc.setupMock(mockStorage)
+
+			service := product.NewService(mockStorage, mockTransaction, mockInventory)
+
+			// Act
+			err := service.CreateVariant(context.Background(), variant)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package product
+
+import (
+	"context"
+	"strings"
+)
+
+func (s *Service) DeleteProductPrice(ctx context.Context, productID string, currency string) error {
+	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := s.Storage.GetProductByID(txCtx, productID); err != nil {
+			return err
+		}
+		return s.Storage.DeleteProductPrice(txCtx, productID, strings.ToUpper(currency))
+	})
+}
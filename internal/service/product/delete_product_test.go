@@ -61,11 +61,12 @@ func TestDeleteProduct(t *testing.T) {
 
 			mockTransaction := mocks.NewMockTransactionManager(ctrl)
 			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
 			if tc.setupMock != nil {
 				tc.setupMock(mockStorage, mockTransaction)
 			}
 
-			service := product.NewService(mockStorage, mockTransaction)
+			service := product.NewService(mockStorage, mockTransaction, mockInventory)
 
 			// Act
 			err := service.DeleteProduct(context.Background(), tc.input)
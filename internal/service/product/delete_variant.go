@@ -0,0 +1,14 @@
+package product
+
+import (
+	"context"
+)
+
+func (s *Service) DeleteVariant(ctx context.Context, productID string, variantID string) error {
+	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if _, err := s.getProductVariant(txCtx, productID, variantID); err != nil {
+			return err
+		}
+		return s.Storage.DeleteVariant(txCtx, variantID)
+	})
+}
@@ -0,0 +1,16 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+)
+
+// ExportProducts calls fn for every product matching the filter while they are read
+// from the storage, so the catalog is never loaded in memory.
+func (s *Service) ExportProducts(ctx context.Context, filter domain.ProductFilter, fn func(product domain.Product) error) error {
+	if err := s.Storage.StreamProducts(ctx, filter, fn); err != nil {
+		return fmt.Errorf("error exporting products: %w", err)
+	}
+	return nil
+}
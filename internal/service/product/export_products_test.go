@@ -0,0 +1,90 @@
+package product_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/product"
+	"microservice-products-catalog/internal/service/product/mocks"
+	"testing"
+)
+
+func TestExportProducts(t *testing.T) {
+	mockProducts := []domain.Product{
+		{ID: uuid.New().String(), Name: "Gopher", Description: "Realistic replic for the Gopher animal", Price: domain.NewMoney(3223, domain.BaseCurrency), Stock: 50},
+		{ID: uuid.New().String(), Name: "Rusty", Description: "Realistic replic for the Rusty animal", Price: domain.NewMoney(2190, domain.BaseCurrency), Stock: 10},
+	}
+	filter := domain.ProductFilter{Limit: 2}
+	dbError := errors.New("my sql connection failed")
+
+	type testCase struct {
+		testName         string
+		setupMock        func(storage *mocks.MockStorageRepository)
+		expectedProducts []domain.Product
+		expectedError    error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - every streamed product reaches the callback",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().
+					StreamProducts(gomock.Any(), filter, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, filter domain.ProductFilter, fn func(domain.Product) error) error {
+						for _, p := range mockProducts {
+							if err := fn(p); err != nil {
+								return err
+							}
+						}
+						return nil
+					}).Times(1)
+			},
+			expectedProducts: mockProducts,
+		},
+		{
+			testName: "Failure - Database fails while streaming",
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().StreamProducts(gomock.Any(), filter, gomock.Any()).Return(dbError).Times(1)
+			},
+			expectedError: dbError,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(mockStorage)
+			}
+
+			service := product.NewService(mockStorage, mockTransaction, mockInventory)
+
+			// Act
+			var exported []domain.Product
+			err := service.ExportProducts(context.Background(), filter, func(p domain.Product) error {
+				exported = append(exported, p)
+				return nil
+			})
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedProducts, exported)
+		})
+	}
+}
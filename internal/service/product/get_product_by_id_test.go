@@ -13,7 +13,7 @@ import (
 
 func TestGetProductByID(t *testing.T) {
 	mockProduct := &domain.Product{
-		ID: uuid.New().String(), Name: "Gopher", Description: "Realistic replic for the Gopher animal", Price: 32.23, Stock: 50,
+		ID: uuid.New().String(), Name: "Gopher", Description: "Realistic replic for the Gopher animal", Price: domain.NewMoney(3223, domain.BaseCurrency), Stock: 50,
 	}
 	notFoundID := uuid.New().String()
 
@@ -57,11 +57,12 @@ func TestGetProductByID(t *testing.T) {
 
 			mockTransaction := mocks.NewMockTransactionManager(ctrl)
 			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
 			if tc.setupMock != nil {
 				tc.setupMock(mockStorage)
 			}
 
-			service := product.NewService(mockStorage, mockTransaction)
+			service := product.NewService(mockStorage, mockTransaction, mockInventory)
 
 			// Act
 			_, err := service.GetProductByID(context.Background(), tc.input)
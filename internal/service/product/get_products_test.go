@@ -15,8 +15,8 @@ import (
 func TestGetProducts(t *testing.T) {
 
 	mocksProducts := []domain.Product{
-		{ID: uuid.New().String(), Name: "Gopher", Description: "Realistic replic for the Gopher animal", Price: 32.23, Stock: 50},
-		{ID: uuid.New().String(), Name: "Rusty", Description: "Realistic replic for the Rusty animal", Price: 21.90, Stock: 10},
+		{ID: uuid.New().String(), Name: "Gopher", Description: "Realistic replic for the Gopher animal", Price: domain.NewMoney(3223, domain.BaseCurrency), Stock: 50},
+		{ID: uuid.New().String(), Name: "Rusty", Description: "Realistic replic for the Rusty animal", Price: domain.NewMoney(2190, domain.BaseCurrency), Stock: 10},
 	}
 	limit := 10
 
@@ -67,11 +67,12 @@ func TestGetProducts(t *testing.T) {
 
 			mockTransaction := mocks.NewMockTransactionManager(ctrl)
 			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
 			if tc.setupMock != nil {
 				tc.setupMock(mockStorage)
 			}
 
-			service := product.NewService(mockStorage, mockTransaction)
+			service := product.NewService(mockStorage, mockTransaction, mockInventory)
 
 			// Act
 			_, err := service.GetProducts(context.Background(), limit)
@@ -0,0 +1,10 @@
+package product
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) GetVariantByID(ctx context.Context, id string) (*domain.Variant, error) {
+	return s.Storage.GetVariantByID(ctx, id)
+}
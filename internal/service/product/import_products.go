@@ -0,0 +1,233 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+const defaultImportBatchSize = 100
+
+// ImportProducts upserts the rows by external SKU or, when it is not given or not found,
+// by name. The rows are consumed as they are produced so the whole file is never held
+// in memory, and they are written in transactions of options.BatchSize rows: when a
+// row fails the rest of its batch is rolled back and reported as failed as well. A
+// storage error is not a failure of the row, it aborts the import and is returned. With
+// options.DryRun nothing is written, the report tells what would have happened.
+func (s *Service) ImportProducts(
+	ctx context.Context,
+	rows domain.ProductImportRows,
+	options domain.ProductImportOptions,
+) (domain.ProductImportReport, error) {
+
+	if options.BatchSize <= 0 {
+		options.BatchSize = defaultImportBatchSize
+	}
+
+	report := domain.ProductImportReport{
+		DryRun: options.DryRun,
+		Rows:   []domain.ProductImportRowResult{},
+	}
+	batch := make([]domain.ProductImportRow, 0, options.BatchSize)
+
+	for row := range rows {
+		if row.Error != "" {
+			report.Add(importRowResult(row, domain.ImportRowFailed, row.Error))
+			continue
+		}
+
+		batch = append(batch, row)
+		if len(batch) < options.BatchSize {
+			continue
+		}
+
+		if err := s.importBatch(ctx, batch, options.DryRun, &report); err != nil {
+			return report, err
+		}
+		batch = batch[:0]
+	}
+
+	if len(batch) > 0 {
+		if err := s.importBatch(ctx, batch, options.DryRun, &report); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// importRowFailures are the errors a row is reported as failed with, any other error
+// comes from the storage and aborts the import.
+var importRowFailures = []error{
+	domain.ErrProductAlreadyExists,
+	domain.ErrProductNotFound,
+	domain.ErrInvalidMoney,
+	domain.ErrInvalidCurrency,
+}
+
+// importRowError keeps track of the row that aborted the batch transaction.
+type importRowError struct {
+	line int
+	err  error
+}
+
+func (e *importRowError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.line, e.err)
+}
+
+func (e *importRowError) Unwrap() error {
+	return e.err
+}
+
+// reason is the text the row is reported with, the domain error alone so nothing the
+// storage wrapped it with ends up in the report. It is empty when the row did not fail
+// with one of importRowFailures.
+func (e *importRowError) reason() string {
+	for _, failure := range importRowFailures {
+		if errors.Is(e.err, failure) {
+			return failure.Error()
+		}
+	}
+	return ""
+}
+
+func (s *Service) importBatch(
+	ctx context.Context,
+	batch []domain.ProductImportRow,
+	dryRun bool,
+	report *domain.ProductImportReport,
+) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if dryRun {
+		for _, row := range batch {
+			existing, err := s.findImportTarget(ctx, row.Product)
+			if err != nil {
+				return err
+			}
+			if existing == nil {
+				report.Add(importRowResult(row, domain.ImportRowCreated, ""))
+				continue
+			}
+			row.Product.ID = existing.ID
+			report.Add(importRowResult(row, domain.ImportRowUpdated, ""))
+		}
+		return nil
+	}
+
+	var results []domain.ProductImportRowResult
+	var alerts []domain.StockAlert
+
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		results = make([]domain.ProductImportRowResult, 0, len(batch))
+		alerts = nil
+
+		for _, row := range batch {
+			status, err := s.importRow(txCtx, &row.Product)
+			if err != nil {
+				return &importRowError{line: row.Line, err: err}
+			}
+
+			alert, err := s.InventoryService.EvaluateStock(txCtx, &row.Product)
+			if err != nil {
+				return &importRowError{line: row.Line, err: err}
+			}
+			if alert != nil {
+				alerts = append(alerts, *alert)
+			}
+			results = append(results, importRowResult(row, status, ""))
+		}
+		return nil
+	})
+
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		var rowErr *importRowError
+		if !errors.As(err, &rowErr) || rowErr.reason() == "" {
+			return err
+		}
+		for _, row := range batch {
+			reason := fmt.Sprintf("batch rolled back: line %d: %s", rowErr.line, rowErr.reason())
+			if row.Line == rowErr.line {
+				reason = rowErr.reason()
+			}
+			report.Add(importRowResult(row, domain.ImportRowFailed, reason))
+		}
+		return nil
+	}
+
+	for _, result := range results {
+		report.Add(result)
+	}
+	for _, alert := range alerts {
+		if err := s.InventoryService.NotifyStockAlert(ctx, alert); err != nil {
+			logging.FromContext(ctx).Error("error notifying stock alert", "error", err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) importRow(ctx context.Context, product *domain.Product) (string, error) {
+	existing, err := s.findImportTarget(ctx, *product)
+	if err != nil {
+		return "", err
+	}
+
+	if existing == nil {
+		product.ID = uuid.New().String()
+		if err := s.Storage.SaveProduct(ctx, product); err != nil {
+			return "", err
+		}
+		return domain.ImportRowCreated, nil
+	}
+
+	product.ID = existing.ID
+	if err := s.Storage.ReplaceProduct(ctx, product); err != nil {
+		return "", err
+	}
+	if err := s.recordPriceChange(ctx, product.ID, existing.Price, product.Price, domain.PriceChangeImport, nil); err != nil {
+		return "", err
+	}
+	return domain.ImportRowUpdated, nil
+}
+
+// findImportTarget returns the stored product the row refers to, or nil if it is a new one.
+func (s *Service) findImportTarget(ctx context.Context, product domain.Product) (*domain.Product, error) {
+	if product.ExternalSKU != nil && *product.ExternalSKU != "" {
+		existing, err := s.Storage.GetProductByExternalSKU(ctx, *product.ExternalSKU)
+		if err == nil {
+			return existing, nil
+		}
+		if !errors.Is(err, domain.ErrProductNotFound) {
+			return nil, err
+		}
+	}
+
+	existing, err := s.Storage.GetProductByName(ctx, product.Name)
+	if errors.Is(err, domain.ErrProductNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func importRowResult(row domain.ProductImportRow, status string, reason string) domain.ProductImportRowResult {
+	return domain.ProductImportRowResult{
+		Line:        row.Line,
+		Status:      status,
+		ProductID:   row.Product.ID,
+		Name:        row.Product.Name,
+		ExternalSKU: row.Product.ExternalSKU,
+		Reason:      reason,
+	}
+}
@@ -0,0 +1,168 @@
+package product_test
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/product"
+	"microservice-products-catalog/internal/service/product/mocks"
+	"slices"
+	"testing"
+)
+
+func TestImportProducts(t *testing.T) {
+	sku := "GOPH-001"
+	existing := &domain.Product{ID: uuid.New().String(), Name: "Gopher", Description: "Old description", Price: domain.NewMoney(1000, domain.BaseCurrency), Stock: 5}
+
+	rows := []domain.ProductImportRow{
+		{Line: 2, Product: domain.Product{Name: "Gopher", Description: "Realistic replic for the Gopher animal", Price: domain.NewMoney(6542, domain.BaseCurrency), Stock: 50, ExternalSKU: &sku}},
+		{Line: 3, Product: domain.Product{Name: "Rusty", Description: "Realistic replic for the Rusty animal", Price: domain.NewMoney(2190, domain.BaseCurrency), Stock: 10}},
+		{Line: 4, Error: "Key: 'CreateProductRequest.Price' Error:Field validation for 'Price' failed on the 'required' tag"},
+	}
+	dbError := errors.New("database constraint violation")
+
+	withTransaction := func(txManager *mocks.MockTransactionManager, times int) {
+		txManager.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			}).Times(times)
+	}
+
+	type testCase struct {
+		testName        string
+		options         domain.ProductImportOptions
+		setupMock       func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager, inventory *mocks.MockInventoryService)
+		expectedStatus  []string
+		expectedCreated int
+		expectedUpdated int
+		expectedFailed  int
+		expectedReason  string
+		expectedErr     error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - create and update rows in a single batch",
+			options:  domain.ProductImportOptions{BatchSize: 10},
+			setupMock: func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager, inventory *mocks.MockInventoryService) {
+				withTransaction(txManager, 1)
+				storage.EXPECT().GetProductByExternalSKU(gomock.Any(), sku).Return(nil, domain.ErrProductNotFound).Times(1)
+				storage.EXPECT().GetProductByName(gomock.Any(), "Gopher").Return(existing, nil).Times(1)
+				storage.EXPECT().
+					ReplaceProduct(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, p *domain.Product) error {
+						assert.Equal(t, existing.ID, p.ID)
+						assert.Equal(t, 50, p.Stock)
+						return nil
+					}).Times(1)
+				storage.EXPECT().
+					CreatePriceChange(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, change domain.PriceChange) error {
+						assert.Equal(t, existing.Price, change.OldPrice)
+						assert.Equal(t, domain.PriceChangeImport, change.Source)
+						return nil
+					}).Times(1)
+				storage.EXPECT().GetProductByName(gomock.Any(), "Rusty").Return(nil, domain.ErrProductNotFound).Times(1)
+				storage.EXPECT().SaveProduct(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				inventory.EXPECT().EvaluateStock(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+			},
+			expectedStatus:  []string{domain.ImportRowUpdated, domain.ImportRowCreated, domain.ImportRowFailed},
+			expectedCreated: 1,
+			expectedUpdated: 1,
+			expectedFailed:  1,
+		},
+		{
+			testName: "Success - dry run does not write",
+			options:  domain.ProductImportOptions{DryRun: true, BatchSize: 10},
+			setupMock: func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager, inventory *mocks.MockInventoryService) {
+				storage.EXPECT().GetProductByExternalSKU(gomock.Any(), sku).Return(existing, nil).Times(1)
+				storage.EXPECT().GetProductByName(gomock.Any(), "Rusty").Return(nil, domain.ErrProductNotFound).Times(1)
+			},
+			expectedStatus:  []string{domain.ImportRowUpdated, domain.ImportRowCreated, domain.ImportRowFailed},
+			expectedCreated: 1,
+			expectedUpdated: 1,
+			expectedFailed:  1,
+		},
+		{
+			testName: "Failure - a failing row rolls back its batch only",
+			options:  domain.ProductImportOptions{BatchSize: 1},
+			setupMock: func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager, inventory *mocks.MockInventoryService) {
+				withTransaction(txManager, 2)
+				storage.EXPECT().GetProductByExternalSKU(gomock.Any(), sku).Return(existing, nil).Times(1)
+				storage.EXPECT().ReplaceProduct(gomock.Any(), gomock.Any()).Return(domain.ErrProductAlreadyExists).Times(1)
+				storage.EXPECT().GetProductByName(gomock.Any(), "Rusty").Return(nil, domain.ErrProductNotFound).Times(1)
+				storage.EXPECT().SaveProduct(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				inventory.EXPECT().EvaluateStock(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+			},
+			expectedStatus:  []string{domain.ImportRowFailed, domain.ImportRowCreated, domain.ImportRowFailed},
+			expectedCreated: 1,
+			expectedFailed:  2,
+			expectedReason:  domain.ErrProductAlreadyExists.Error(),
+		},
+		{
+			testName: "Failure - a storage error aborts the import",
+			options:  domain.ProductImportOptions{BatchSize: 1},
+			setupMock: func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager, inventory *mocks.MockInventoryService) {
+				withTransaction(txManager, 1)
+				storage.EXPECT().GetProductByExternalSKU(gomock.Any(), sku).Return(existing, nil).Times(1)
+				storage.EXPECT().ReplaceProduct(gomock.Any(), gomock.Any()).Return(dbError).Times(1)
+			},
+			expectedStatus: []string{},
+			expectedErr:    dbError,
+		},
+		{
+			testName: "Failure - a storage error aborts the dry run",
+			options:  domain.ProductImportOptions{DryRun: true, BatchSize: 10},
+			setupMock: func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager, inventory *mocks.MockInventoryService) {
+				storage.EXPECT().GetProductByExternalSKU(gomock.Any(), sku).Return(nil, dbError).Times(1)
+			},
+			expectedStatus: []string{domain.ImportRowFailed},
+			expectedFailed: 1,
+			expectedErr:    dbError,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(mockStorage, mockTransaction, mockInventory)
+			}
+
+			service := product.NewService(mockStorage, mockTransaction, mockInventory)
+
+			// Act
+			report, err := service.ImportProducts(context.Background(), slices.Values(rows), tc.options)
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.options.DryRun, report.DryRun)
+			assert.Equal(t, tc.expectedCreated, report.Created)
+			assert.Equal(t, tc.expectedUpdated, report.Updated)
+			assert.Equal(t, tc.expectedFailed, report.Failed)
+
+			statuses := make([]string, 0, len(report.Rows))
+			for _, row := range report.Rows {
+				statuses = append(statuses, row.Status)
+				assert.NotContains(t, row.Reason, dbError.Error())
+				if row.Status == domain.ImportRowFailed && row.Line != 4 && tc.expectedReason != "" {
+					assert.Contains(t, row.Reason, tc.expectedReason)
+				}
+			}
+			assert.ElementsMatch(t, tc.expectedStatus, statuses)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package product
+
+import (
+	"context"
+	"go.opentelemetry.io/otel/trace"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/tracing"
+)
+
+// InstrumentedService decorates the Service with a span per method. RunPriceScheduler runs for
+// the life of the service on the Service itself, so the scheduler runs are not traced.
+type InstrumentedService struct {
+	*Service
+	Tracer trace.Tracer
+}
+
+func NewInstrumentedService(service *Service, tracer trace.Tracer) *InstrumentedService {
+	return &InstrumentedService{
+		Service: service,
+		Tracer:  tracer,
+	}
+}
+
+func (s *InstrumentedService) ApplyScheduledPrices(ctx context.Context) (int, error) {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.ApplyScheduledPrices")
+	count, err := s.Service.ApplyScheduledPrices(ctx)
+	tracing.End(span, err)
+	return count, err
+}
+
+func (s *InstrumentedService) CreateProduct(ctx context.Context, product domain.Product) error {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.CreateProduct")
+	err := s.Service.CreateProduct(ctx, product)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *InstrumentedService) CreateScheduledPrice(ctx context.Context, scheduledPrice domain.ScheduledPrice) (domain.ScheduledPrice, error) {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.CreateScheduledPrice")
+	created, err := s.Service.CreateScheduledPrice(ctx, scheduledPrice)
+	tracing.End(span, err)
+	return created, err
+}
+
+func (s *InstrumentedService) GetScheduledPrices(ctx context.Context, productID string) ([]domain.ScheduledPrice, error) {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.GetScheduledPrices")
+	scheduledPrices, err := s.Service.GetScheduledPrices(ctx, productID)
+	tracing.End(span, err)
+	return scheduledPrices, err
+}
+
+func (s *InstrumentedService) CreateVariant(ctx context.Context, variant domain.Variant) error {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.CreateVariant")
+	err := s.Service.CreateVariant(ctx, variant)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *InstrumentedService) DeleteProduct(ctx context.Context, id string) error {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.DeleteProduct")
+	err := s.Service.DeleteProduct(ctx, id)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *InstrumentedService) DeleteProductPrice(ctx context.Context, productID string, currency string) error {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.DeleteProductPrice")
+	err := s.Service.DeleteProductPrice(ctx, productID, currency)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *InstrumentedService) DeleteVariant(ctx context.Context, productID string, variantID string) error {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.DeleteVariant")
+	err := s.Service.DeleteVariant(ctx, productID, variantID)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *InstrumentedService) ExportProducts(ctx context.Context, filter domain.ProductFilter, fn func(product domain.Product) error) error {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.ExportProducts")
+	err := s.Service.ExportProducts(ctx, filter, fn)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *InstrumentedService) GetProductByID(ctx context.Context, id string) (*domain.Product, error) {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.GetProductByID")
+	product, err := s.Service.GetProductByID(ctx, id)
+	tracing.End(span, err)
+	return product, err
+}
+
+func (s *InstrumentedService) GetProducts(ctx context.Context, limit int) ([]domain.Product, error) {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.GetProducts")
+	products, err := s.Service.GetProducts(ctx, limit)
+	tracing.End(span, err)
+	return products, err
+}
+
+func (s *InstrumentedService) GetVariantByID(ctx context.Context, id string) (*domain.Variant, error) {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.GetVariantByID")
+	variant, err := s.Service.GetVariantByID(ctx, id)
+	tracing.End(span, err)
+	return variant, err
+}
+
+func (s *InstrumentedService) ImportProducts(ctx context.Context, rows domain.ProductImportRows, options domain.ProductImportOptions) (domain.ProductImportReport, error) {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.ImportProducts")
+	report, err := s.Service.ImportProducts(ctx, rows, options)
+	tracing.End(span, err)
+	return report, err
+}
+
+func (s *InstrumentedService) GetPriceHistory(ctx context.Context, productID string) ([]domain.PriceChange, error) {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.GetPriceHistory")
+	changes, err := s.Service.GetPriceHistory(ctx, productID)
+	tracing.End(span, err)
+	return changes, err
+}
+
+func (s *InstrumentedService) SaveProduct(ctx context.Context, product *domain.Product) error {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.SaveProduct")
+	err := s.Service.SaveProduct(ctx, product)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *InstrumentedService) SaveVariant(ctx context.Context, variant *domain.Variant) error {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.SaveVariant")
+	err := s.Service.SaveVariant(ctx, variant)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *InstrumentedService) SetProductPrice(ctx context.Context, productID string, price domain.Money) error {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.SetProductPrice")
+	err := s.Service.SetProductPrice(ctx, productID, price)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *InstrumentedService) UpdateProduct(ctx context.Context, product *domain.Product) error {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.UpdateProduct")
+	err := s.Service.UpdateProduct(ctx, product)
+	tracing.End(span, err)
+	return err
+}
+
+func (s *InstrumentedService) UpdateVariant(ctx context.Context, productID string, variantID string, update domain.VariantUpdate) error {
+	ctx, span := s.Tracer.Start(ctx, "product.Service.UpdateVariant")
+	err := s.Service.UpdateVariant(ctx, productID, variantID, update)
+	tracing.End(span, err)
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	domain "microservice-products-catalog/internal/domain"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,6 +36,48 @@ func (m *MockStorageRepository) EXPECT() *MockStorageRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CreatePriceChange mocks base method.
+func (m *MockStorageRepository) CreatePriceChange(ctx context.Context, change domain.PriceChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePriceChange", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePriceChange indicates an expected call of CreatePriceChange.
+func (mr *MockStorageRepositoryMockRecorder) CreatePriceChange(ctx, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePriceChange", reflect.TypeOf((*MockStorageRepository)(nil).CreatePriceChange), ctx, change)
+}
+
+// CreateScheduledPrice mocks base method.
+func (m *MockStorageRepository) CreateScheduledPrice(ctx context.Context, scheduledPrice domain.ScheduledPrice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledPrice", ctx, scheduledPrice)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateScheduledPrice indicates an expected call of CreateScheduledPrice.
+func (mr *MockStorageRepositoryMockRecorder) CreateScheduledPrice(ctx, scheduledPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledPrice", reflect.TypeOf((*MockStorageRepository)(nil).CreateScheduledPrice), ctx, scheduledPrice)
+}
+
+// CreateVariant mocks base method.
+func (m *MockStorageRepository) CreateVariant(ctx context.Context, variant domain.Variant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVariant", ctx, variant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateVariant indicates an expected call of CreateVariant.
+func (mr *MockStorageRepositoryMockRecorder) CreateVariant(ctx, variant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVariant", reflect.TypeOf((*MockStorageRepository)(nil).CreateVariant), ctx, variant)
+}
+
 // DeleteProduct mocks base method.
 func (m *MockStorageRepository) DeleteProduct(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -49,6 +92,79 @@ func (mr *MockStorageRepositoryMockRecorder) DeleteProduct(ctx, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockStorageRepository)(nil).DeleteProduct), ctx, id)
 }
 
+// DeleteProductPrice mocks base method.
+func (m *MockStorageRepository) DeleteProductPrice(ctx context.Context, productID, currency string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductPrice", ctx, productID, currency)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductPrice indicates an expected call of DeleteProductPrice.
+func (mr *MockStorageRepositoryMockRecorder) DeleteProductPrice(ctx, productID, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductPrice", reflect.TypeOf((*MockStorageRepository)(nil).DeleteProductPrice), ctx, productID, currency)
+}
+
+// DeleteVariant mocks base method.
+func (m *MockStorageRepository) DeleteVariant(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVariant", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVariant indicates an expected call of DeleteVariant.
+func (mr *MockStorageRepositoryMockRecorder) DeleteVariant(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVariant", reflect.TypeOf((*MockStorageRepository)(nil).DeleteVariant), ctx, id)
+}
+
+// GetDueScheduledPrices mocks base method.
+func (m *MockStorageRepository) GetDueScheduledPrices(ctx context.Context, at time.Time, limit int) ([]domain.ScheduledPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueScheduledPrices", ctx, at, limit)
+	ret0, _ := ret[0].([]domain.ScheduledPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueScheduledPrices indicates an expected call of GetDueScheduledPrices.
+func (mr *MockStorageRepositoryMockRecorder) GetDueScheduledPrices(ctx, at, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueScheduledPrices", reflect.TypeOf((*MockStorageRepository)(nil).GetDueScheduledPrices), ctx, at, limit)
+}
+
+// GetPriceHistory mocks base method.
+func (m *MockStorageRepository) GetPriceHistory(ctx context.Context, productID string) ([]domain.PriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceHistory", ctx, productID)
+	ret0, _ := ret[0].([]domain.PriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceHistory indicates an expected call of GetPriceHistory.
+func (mr *MockStorageRepositoryMockRecorder) GetPriceHistory(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceHistory", reflect.TypeOf((*MockStorageRepository)(nil).GetPriceHistory), ctx, productID)
+}
+
+// GetProductByExternalSKU mocks base method.
+func (m *MockStorageRepository) GetProductByExternalSKU(ctx context.Context, externalSKU string) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductByExternalSKU", ctx, externalSKU)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductByExternalSKU indicates an expected call of GetProductByExternalSKU.
+func (mr *MockStorageRepositoryMockRecorder) GetProductByExternalSKU(ctx, externalSKU interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByExternalSKU", reflect.TypeOf((*MockStorageRepository)(nil).GetProductByExternalSKU), ctx, externalSKU)
+}
+
 // GetProductByID mocks base method.
 func (m *MockStorageRepository) GetProductByID(ctx context.Context, id string) (*domain.Product, error) {
 	m.ctrl.T.Helper()
@@ -64,6 +180,21 @@ func (mr *MockStorageRepositoryMockRecorder) GetProductByID(ctx, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByID", reflect.TypeOf((*MockStorageRepository)(nil).GetProductByID), ctx, id)
 }
 
+// GetProductByName mocks base method.
+func (m *MockStorageRepository) GetProductByName(ctx context.Context, name string) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductByName", ctx, name)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductByName indicates an expected call of GetProductByName.
+func (mr *MockStorageRepositoryMockRecorder) GetProductByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByName", reflect.TypeOf((*MockStorageRepository)(nil).GetProductByName), ctx, name)
+}
+
 // GetProducts mocks base method.
 func (m *MockStorageRepository) GetProducts(ctx context.Context, limit int) ([]domain.Product, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +210,65 @@ func (mr *MockStorageRepositoryMockRecorder) GetProducts(ctx, limit interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockStorageRepository)(nil).GetProducts), ctx, limit)
 }
 
+// GetScheduledPriceByID mocks base method.
+func (m *MockStorageRepository) GetScheduledPriceByID(ctx context.Context, id string) (*domain.ScheduledPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledPriceByID", ctx, id)
+	ret0, _ := ret[0].(*domain.ScheduledPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledPriceByID indicates an expected call of GetScheduledPriceByID.
+func (mr *MockStorageRepositoryMockRecorder) GetScheduledPriceByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledPriceByID", reflect.TypeOf((*MockStorageRepository)(nil).GetScheduledPriceByID), ctx, id)
+}
+
+// GetScheduledPrices mocks base method.
+func (m *MockStorageRepository) GetScheduledPrices(ctx context.Context, productID string) ([]domain.ScheduledPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledPrices", ctx, productID)
+	ret0, _ := ret[0].([]domain.ScheduledPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledPrices indicates an expected call of GetScheduledPrices.
+func (mr *MockStorageRepositoryMockRecorder) GetScheduledPrices(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledPrices", reflect.TypeOf((*MockStorageRepository)(nil).GetScheduledPrices), ctx, productID)
+}
+
+// GetVariantByID mocks base method.
+func (m *MockStorageRepository) GetVariantByID(ctx context.Context, id string) (*domain.Variant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Variant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantByID indicates an expected call of GetVariantByID.
+func (mr *MockStorageRepositoryMockRecorder) GetVariantByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantByID", reflect.TypeOf((*MockStorageRepository)(nil).GetVariantByID), ctx, id)
+}
+
+// ReplaceProduct mocks base method.
+func (m *MockStorageRepository) ReplaceProduct(ctx context.Context, product *domain.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceProduct", ctx, product)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceProduct indicates an expected call of ReplaceProduct.
+func (mr *MockStorageRepositoryMockRecorder) ReplaceProduct(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceProduct", reflect.TypeOf((*MockStorageRepository)(nil).ReplaceProduct), ctx, product)
+}
+
 // SaveProduct mocks base method.
 func (m *MockStorageRepository) SaveProduct(ctx context.Context, product *domain.Product) error {
 	m.ctrl.T.Helper()
@@ -93,6 +283,34 @@ func (mr *MockStorageRepositoryMockRecorder) SaveProduct(ctx, product interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveProduct", reflect.TypeOf((*MockStorageRepository)(nil).SaveProduct), ctx, product)
 }
 
+// SetProductPrice mocks base method.
+func (m *MockStorageRepository) SetProductPrice(ctx context.Context, productID string, price domain.Money) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductPrice", ctx, productID, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProductPrice indicates an expected call of SetProductPrice.
+func (mr *MockStorageRepositoryMockRecorder) SetProductPrice(ctx, productID, price interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductPrice", reflect.TypeOf((*MockStorageRepository)(nil).SetProductPrice), ctx, productID, price)
+}
+
+// StreamProducts mocks base method.
+func (m *MockStorageRepository) StreamProducts(ctx context.Context, filter domain.ProductFilter, fn func(domain.Product) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamProducts", ctx, filter, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamProducts indicates an expected call of StreamProducts.
+func (mr *MockStorageRepositoryMockRecorder) StreamProducts(ctx, filter, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamProducts", reflect.TypeOf((*MockStorageRepository)(nil).StreamProducts), ctx, filter, fn)
+}
+
 // UpdateProduct mocks base method.
 func (m *MockStorageRepository) UpdateProduct(ctx context.Context, product *domain.Product) error {
 	m.ctrl.T.Helper()
@@ -107,6 +325,34 @@ func (mr *MockStorageRepositoryMockRecorder) UpdateProduct(ctx, product interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProduct", reflect.TypeOf((*MockStorageRepository)(nil).UpdateProduct), ctx, product)
 }
 
+// UpdateScheduledPrice mocks base method.
+func (m *MockStorageRepository) UpdateScheduledPrice(ctx context.Context, scheduledPrice *domain.ScheduledPrice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledPrice", ctx, scheduledPrice)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateScheduledPrice indicates an expected call of UpdateScheduledPrice.
+func (mr *MockStorageRepositoryMockRecorder) UpdateScheduledPrice(ctx, scheduledPrice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledPrice", reflect.TypeOf((*MockStorageRepository)(nil).UpdateScheduledPrice), ctx, scheduledPrice)
+}
+
+// UpdateVariant mocks base method.
+func (m *MockStorageRepository) UpdateVariant(ctx context.Context, variant *domain.Variant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", ctx, variant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockStorageRepositoryMockRecorder) UpdateVariant(ctx, variant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockStorageRepository)(nil).UpdateVariant), ctx, variant)
+}
+
 // MockTransactionManager is a mock of TransactionManager interface.
 type MockTransactionManager struct {
 	ctrl     *gomock.Controller
@@ -143,3 +389,55 @@ func (mr *MockTransactionManagerMockRecorder) WithTransaction(ctx, fn interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockTransactionManager)(nil).WithTransaction), ctx, fn)
 }
+
+// MockInventoryService is a mock of InventoryService interface.
+type MockInventoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInventoryServiceMockRecorder
+}
+
+// MockInventoryServiceMockRecorder is the mock recorder for MockInventoryService.
+type MockInventoryServiceMockRecorder struct {
+	mock *MockInventoryService
+}
+
+// NewMockInventoryService creates a new mock instance.
+func NewMockInventoryService(ctrl *gomock.Controller) *MockInventoryService {
+	mock := &MockInventoryService{ctrl: ctrl}
+	mock.recorder = &MockInventoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInventoryService) EXPECT() *MockInventoryServiceMockRecorder {
+	return m.recorder
+}
+
+// EvaluateStock mocks base method.
+func (m *MockInventoryService) EvaluateStock(ctx context.Context, product *domain.Product) (*domain.StockAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluateStock", ctx, product)
+	ret0, _ := ret[0].(*domain.StockAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluateStock indicates an expected call of EvaluateStock.
+func (mr *MockInventoryServiceMockRecorder) EvaluateStock(ctx, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluateStock", reflect.TypeOf((*MockInventoryService)(nil).EvaluateStock), ctx, product)
+}
+
+// NotifyStockAlert mocks base method.
+func (m *MockInventoryService) NotifyStockAlert(ctx context.Context, alert domain.StockAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyStockAlert", ctx, alert)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyStockAlert indicates an expected call of NotifyStockAlert.
+func (mr *MockInventoryServiceMockRecorder) NotifyStockAlert(ctx, alert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyStockAlert", reflect.TypeOf((*MockInventoryService)(nil).NotifyStockAlert), ctx, alert)
+}
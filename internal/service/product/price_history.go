@@ -0,0 +1,30 @@
+package product
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// GetPriceHistory returns the changes of the base price of the product, the latest first.
+func (s *Service) GetPriceHistory(ctx context.Context, productID string) ([]domain.PriceChange, error) {
+	if _, err := s.Storage.GetProductByID(ctx, productID); err != nil {
+		return nil, err
+	}
+	return s.Storage.GetPriceHistory(ctx, productID)
+}
+
+// recordPriceChange writes an entry of the price history when the price actually changes, it
+// must run in the transaction that writes the new price.
+func (s *Service) recordPriceChange(ctx context.Context, productID string, oldPrice domain.Money, newPrice domain.Money, source domain.PriceChangeSource, scheduledPriceID *string) error {
+	if oldPrice.Amount == newPrice.Amount {
+		return nil
+	}
+	return s.Storage.CreatePriceChange(ctx, domain.PriceChange{
+		ProductID:        productID,
+		OldPrice:         oldPrice,
+		NewPrice:         newPrice,
+		Source:           source,
+		ScheduledPriceID: scheduledPriceID,
+		ChangedAt:        s.Now(),
+	})
+}
@@ -0,0 +1,10 @@
+package product
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) SaveVariant(ctx context.Context, variant *domain.Variant) error {
+	return s.Storage.UpdateVariant(ctx, variant)
+}
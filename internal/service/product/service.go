@@ -3,6 +3,7 @@ package product
 import (
 	"context"
 	"microservice-products-catalog/internal/domain"
+	"time"
 )
 
 //go:generate mockgen -source=service.go -destination=././mocks/product_repository_mock.go -package=mocks
@@ -12,6 +13,23 @@ type StorageRepository interface {
 	UpdateProduct(ctx context.Context, product *domain.Product) error
 	DeleteProduct(ctx context.Context, id string) error
 	SaveProduct(ctx context.Context, product *domain.Product) error
+	GetProductByName(ctx context.Context, name string) (*domain.Product, error)
+	GetProductByExternalSKU(ctx context.Context, externalSKU string) (*domain.Product, error)
+	ReplaceProduct(ctx context.Context, product *domain.Product) error
+	StreamProducts(ctx context.Context, filter domain.ProductFilter, fn func(product domain.Product) error) error
+	GetVariantByID(ctx context.Context, id string) (*domain.Variant, error)
+	CreateVariant(ctx context.Context, variant domain.Variant) error
+	UpdateVariant(ctx context.Context, variant *domain.Variant) error
+	DeleteVariant(ctx context.Context, id string) error
+	SetProductPrice(ctx context.Context, productID string, price domain.Money) error
+	DeleteProductPrice(ctx context.Context, productID string, currency string) error
+	CreatePriceChange(ctx context.Context, change domain.PriceChange) error
+	GetPriceHistory(ctx context.Context, productID string) ([]domain.PriceChange, error)
+	CreateScheduledPrice(ctx context.Context, scheduledPrice domain.ScheduledPrice) error
+	GetScheduledPrices(ctx context.Context, productID string) ([]domain.ScheduledPrice, error)
+	GetScheduledPriceByID(ctx context.Context, id string) (*domain.ScheduledPrice, error)
+	GetDueScheduledPrices(ctx context.Context, at time.Time, limit int) ([]domain.ScheduledPrice, error)
+	UpdateScheduledPrice(ctx context.Context, scheduledPrice *domain.ScheduledPrice) error
 }
 
 //go:generate mockgen -source=service.go -destination=././mocks/product_repository_mock.go -package=mocks
@@ -20,15 +38,25 @@ type TransactionManager interface {
 	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
+type InventoryService interface {
+	EvaluateStock(ctx context.Context, product *domain.Product) (*domain.StockAlert, error)
+	NotifyStockAlert(ctx context.Context, alert domain.StockAlert) error
+}
+
 // Service depends on the interface, not concrete types.
+// Now is the clock of the price history and of the price scheduler.
 type Service struct {
 	Storage            StorageRepository
 	TransactionManager TransactionManager
+	InventoryService   InventoryService
+	Now                func() time.Time
 }
 
-func NewService(storage StorageRepository, transactionManager TransactionManager) *Service {
+func NewService(storage StorageRepository, transactionManager TransactionManager, inventoryService InventoryService) *Service {
 	return &Service{
 		Storage:            storage,
 		TransactionManager: transactionManager,
+		InventoryService:   inventoryService,
+		Now:                time.Now,
 	}
 }
@@ -16,9 +16,10 @@ func TestNewService(t *testing.T) {
 	// Create mock instances using auto-generated constructors.
 	mockTransaction := mocks.NewMockTransactionManager(ctrl)
 	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockInventory := mocks.NewMockInventoryService(ctrl)
 
 	// Act: Call the constructor function that we are testing.
-	service := NewService(mockStorage, mockTransaction)
+	service := NewService(mockStorage, mockTransaction, mockInventory)
 
 	// Assert: Verify the outcome.
 	// 1. Ensure the service object was actually created.
@@ -0,0 +1,21 @@
+package product
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// SetProductPrice sets the list price of the product in a currency other than the one of
+// its base price, orders in that currency use it instead of converting the base price.
+func (s *Service) SetProductPrice(ctx context.Context, productID string, price domain.Money) error {
+	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		product, err := s.Storage.GetProductByID(txCtx, productID)
+		if err != nil {
+			return err
+		}
+		if price.Currency == product.Price.Currency {
+			return domain.ErrBaseCurrencyPrice
+		}
+		return s.Storage.SetProductPrice(txCtx, productID, price)
+	})
+}
@@ -0,0 +1,84 @@
+package product_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/product"
+	"microservice-products-catalog/internal/service/product/mocks"
+	"testing"
+)
+
+func TestSetProductPrice(t *testing.T) {
+	productID := uuid.New().String()
+	existing := &domain.Product{ID: productID, Price: domain.NewMoney(1000, domain.BaseCurrency)}
+
+	type testCase struct {
+		testName      string
+		price         domain.Money
+		setupMock     func(storage *mocks.MockStorageRepository, price domain.Money)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Set list price",
+			price:    domain.NewMoney(950, "EUR"),
+			setupMock: func(storage *mocks.MockStorageRepository, price domain.Money) {
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(existing, nil).Times(1)
+				storage.EXPECT().SetProductPrice(gomock.Any(), productID, price).Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Failure - Price in the base currency",
+			price:    domain.NewMoney(950, domain.BaseCurrency),
+			setupMock: func(storage *mocks.MockStorageRepository, price domain.Money) {
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(existing, nil).Times(1)
+			},
+			expectedError: domain.ErrBaseCurrencyPrice,
+		},
+		{
+			testName: "Failure - Product not found",
+			price:    domain.NewMoney(950, "EUR"),
+			setupMock: func(storage *mocks.MockStorageRepository, price domain.Money) {
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(nil, domain.ErrProductNotFound).Times(1)
+			},
+			expectedError: domain.ErrProductNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
+			mockTransaction.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			tc.setupMock(mockStorage, tc.price)
+
+			service := product.NewService(mockStorage, mockTransaction, mockInventory)
+
+			// Act
+			err := service.SetProductPrice(context.Background(), productID, tc.price)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -3,15 +3,43 @@ package product
 import (
 	"context"
 	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
 )
 
 func (s *Service) UpdateProduct(ctx context.Context, product *domain.Product) error {
-	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
-		exists, err := s.Storage.GetProductByID(ctx, product.ID)
+	var alert *domain.StockAlert
+
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		existing, err := s.Storage.GetProductByID(txCtx, product.ID)
+		if err != nil {
+			return err
+		}
+		if err := s.Storage.UpdateProduct(txCtx, product); err != nil {
+			return err
+		}
+		// a zero price is not part of the partial update
+		if !product.Price.IsZero() {
+			if err := s.recordPriceChange(txCtx, product.ID, existing.Price, product.Price, domain.PriceChangeManual, nil); err != nil {
+				return err
+			}
+		}
+
+		// the request may be partial, the stock levels are evaluated over the stored row
+		updated, err := s.Storage.GetProductByID(txCtx, product.ID)
 		if err != nil {
 			return err
 		}
-		exists = product
-		return s.Storage.UpdateProduct(ctx, exists)
+		alert, err = s.InventoryService.EvaluateStock(txCtx, updated)
+		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	if alert != nil {
+		if err := s.InventoryService.NotifyStockAlert(ctx, *alert); err != nil {
+			logging.FromContext(ctx).Error("error notifying stock alert", "error", err)
+		}
+	}
+	return nil
 }
@@ -16,14 +16,14 @@ func TestUpdateProduct(t *testing.T) {
 		ID:          uuid.New().String(),
 		Name:        "Gopher",
 		Description: "Realistic replic for the Gopher animal",
-		Price:       65.42,
+		Price:       domain.NewMoney(6542, domain.BaseCurrency),
 		Stock:       50,
 	}
 
 	type testCase struct {
 		testName      string
 		input         *domain.Product
-		setupMock     func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager)
+		setupMock     func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager, inventory *mocks.MockInventoryService)
 		expectedError error
 	}
 
@@ -31,17 +31,79 @@ func TestUpdateProduct(t *testing.T) {
 		{
 			testName: "Success - Update Product Correctly",
 			input:    productInput,
-			setupMock: func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager) {
+			setupMock: func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager, inventory *mocks.MockInventoryService) {
 				txManager.EXPECT().
 					WithTransaction(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
 						return fn(ctx)
 					}).Times(1)
-				storage.EXPECT().GetProductByID(gomock.Any(), productInput.ID).Return(productInput, nil).Times(1)
+				storage.EXPECT().GetProductByID(gomock.Any(), productInput.ID).Return(productInput, nil).Times(2)
 				storage.EXPECT().UpdateProduct(gomock.Any(), productInput).Return(nil).Times(1)
+				inventory.EXPECT().EvaluateStock(gomock.Any(), productInput).Return(nil, nil).Times(1)
 			},
 			expectedError: nil,
 		},
+		{
+			testName: "Success - Update Product below the reorder point notifies the alert",
+			input:    productInput,
+			setupMock: func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager, inventory *mocks.MockInventoryService) {
+				alert := &domain.StockAlert{ID: uuid.New().String(), ProductID: productInput.ID}
+
+				txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+				storage.EXPECT().GetProductByID(gomock.Any(), productInput.ID).Return(productInput, nil).Times(2)
+				storage.EXPECT().UpdateProduct(gomock.Any(), productInput).Return(nil).Times(1)
+				inventory.EXPECT().EvaluateStock(gomock.Any(), productInput).Return(alert, nil).Times(1)
+				inventory.EXPECT().NotifyStockAlert(gomock.Any(), *alert).Return(nil).Times(1)
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "Success - Price change is written to the price history",
+			input:    productInput,
+			setupMock: func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager, inventory *mocks.MockInventoryService) {
+				stored := *productInput
+				stored.Price = domain.NewMoney(5999, domain.BaseCurrency)
+
+				txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+				gomock.InOrder(
+					storage.EXPECT().GetProductByID(gomock.Any(), productInput.ID).Return(&stored, nil).Times(1),
+					storage.EXPECT().GetProductByID(gomock.Any(), productInput.ID).Return(productInput, nil).Times(1),
+				)
+				storage.EXPECT().UpdateProduct(gomock.Any(), productInput).Return(nil).Times(1)
+				storage.EXPECT().
+					CreatePriceChange(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, change domain.PriceChange) error {
+						assert.Equal(t, productInput.ID, change.ProductID)
+						assert.Equal(t, domain.NewMoney(5999, domain.BaseCurrency), change.OldPrice)
+						assert.Equal(t, productInput.Price, change.NewPrice)
+						assert.Equal(t, domain.PriceChangeManual, change.Source)
+						return nil
+					}).Times(1)
+				inventory.EXPECT().EvaluateStock(gomock.Any(), productInput).Return(nil, nil).Times(1)
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "Failure - Product Not Found",
+			input:    productInput,
+			setupMock: func(storage *mocks.MockStorageRepository, txManager *mocks.MockTransactionManager, inventory *mocks.MockInventoryService) {
+				txManager.EXPECT().
+					WithTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).Times(1)
+				storage.EXPECT().GetProductByID(gomock.Any(), productInput.ID).Return(nil, domain.ErrProductNotFound).Times(1)
+			},
+			expectedError: domain.ErrProductNotFound,
+		},
 	}
 
 	for i := range testCases {
@@ -55,11 +117,12 @@ func TestUpdateProduct(t *testing.T) {
 
 			mockTransaction := mocks.NewMockTransactionManager(ctrl)
 			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
 			if tc.setupMock != nil {
-				tc.setupMock(mockStorage, mockTransaction)
+				tc.setupMock(mockStorage, mockTransaction, mockInventory)
 			}
 
-			service := product.NewService(mockStorage, mockTransaction)
+			service := product.NewService(mockStorage, mockTransaction, mockInventory)
 
 			// Act
 			err := service.UpdateProduct(context.Background(), tc.input)
@@ -0,0 +1,79 @@
+package product
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/infraestructure/logging"
+)
+
+// UpdateVariant changes the given fields of the variant. A stock change is evaluated against
+// the reorder point of the product, which applies to the total stock of its variants.
+func (s *Service) UpdateVariant(ctx context.Context, productID string, variantID string, update domain.VariantUpdate) error {
+	var alert *domain.StockAlert
+
+	err := s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		// the orders lock the product before the variant, a stock change locks them in the same order
+		var product *domain.Product
+		if update.Stock != nil {
+			var err error
+			if product, err = s.Storage.GetProductByID(txCtx, productID); err != nil {
+				return err
+			}
+		}
+
+		variant, err := s.getProductVariant(txCtx, productID, variantID)
+		if err != nil {
+			return err
+		}
+
+		if update.SKU != nil {
+			variant.SKU = *update.SKU
+		}
+		if update.Options != nil {
+			variant.Options = update.Options
+		}
+		if update.Price != nil {
+			variant.Price = update.Price
+		}
+		if update.Stock != nil {
+			variant.Stock = *update.Stock
+		}
+
+		if err := s.Storage.UpdateVariant(txCtx, variant); err != nil {
+			return err
+		}
+		if product == nil {
+			return nil
+		}
+
+		for i := range product.Variants {
+			if product.Variants[i].ID == variant.ID {
+				product.Variants[i].Stock = variant.Stock
+			}
+		}
+		alert, err = s.InventoryService.EvaluateStock(txCtx, product)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if alert != nil {
+		if err := s.InventoryService.NotifyStockAlert(ctx, *alert); err != nil {
+			logging.FromContext(ctx).Error("error notifying stock alert", "error", err)
+		}
+	}
+	return nil
+}
+
+// getProductVariant locks the variant and reports it as not found when it belongs to another product.
+func (s *Service) getProductVariant(ctx context.Context, productID string, variantID string) (*domain.Variant, error) {
+	variant, err := s.Storage.GetVariantByID(ctx, variantID)
+	if err != nil {
+		return nil, err
+	}
+	if variant.ProductID != productID {
+		return nil, domain.ErrVariantNotFound
+	}
+	return variant, nil
+}
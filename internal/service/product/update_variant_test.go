@@ -0,0 +1,127 @@
+package product_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/product"
+	"microservice-products-catalog/internal/service/product/mocks"
+	"testing"
+)
+
+func TestUpdateVariant(t *testing.T) {
+	productID := uuid.New().String()
+	variantID := uuid.New().String()
+	newSKU := "SHIRT-L-BLUE"
+	newStock := 7
+	price := domain.NewMoney(2500, domain.BaseCurrency)
+
+	type testCase struct {
+		testName      string
+		update        domain.VariantUpdate
+		setupMock     func(storage *mocks.MockStorageRepository, inventory *mocks.MockInventoryService)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Update the given fields only",
+			update:   domain.VariantUpdate{SKU: &newSKU, Stock: &newStock},
+			setupMock: func(storage *mocks.MockStorageRepository, inventory *mocks.MockInventoryService) {
+				storage.EXPECT().
+					GetProductByID(gomock.Any(), productID).
+					Return(&domain.Product{ID: productID, ReorderPoint: 5, Variants: []domain.Variant{{ID: variantID, Stock: 2}}}, nil).
+					Times(1)
+				storage.EXPECT().
+					GetVariantByID(gomock.Any(), variantID).
+					Return(&domain.Variant{ID: variantID, ProductID: productID, SKU: "SHIRT-M-BLUE", Options: domain.VariantOptions{"size": "M"}, Price: &price, Stock: 2}, nil).
+					Times(1)
+				storage.EXPECT().
+					UpdateVariant(gomock.Any(), &domain.Variant{ID: variantID, ProductID: productID, SKU: newSKU, Options: domain.VariantOptions{"size": "M"}, Price: &price, Stock: newStock}).
+					Return(nil).Times(1)
+				inventory.EXPECT().
+					EvaluateStock(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, product *domain.Product) (*domain.StockAlert, error) {
+						assert.Equal(t, newStock, product.AvailableStock())
+						return nil, nil
+					}).Times(1)
+			},
+		},
+		{
+			testName: "Success - Alert when the variants reach the reorder point",
+			update:   domain.VariantUpdate{Stock: &newStock},
+			setupMock: func(storage *mocks.MockStorageRepository, inventory *mocks.MockInventoryService) {
+				storage.EXPECT().
+					GetProductByID(gomock.Any(), productID).
+					Return(&domain.Product{ID: productID, ReorderPoint: 10, Variants: []domain.Variant{{ID: variantID, Stock: 20}, {ID: uuid.New().String(), Stock: 1}}}, nil).
+					Times(1)
+				storage.EXPECT().GetVariantByID(gomock.Any(), variantID).Return(&domain.Variant{ID: variantID, ProductID: productID, Stock: 20}, nil).Times(1)
+				storage.EXPECT().UpdateVariant(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				alert := &domain.StockAlert{ProductID: productID, Stock: newStock + 1, ReorderPoint: 10}
+				inventory.EXPECT().
+					EvaluateStock(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, product *domain.Product) (*domain.StockAlert, error) {
+						assert.True(t, product.BelowReorderPoint())
+						return alert, nil
+					}).Times(1)
+				inventory.EXPECT().NotifyStockAlert(gomock.Any(), *alert).Return(nil).Times(1)
+			},
+		},
+		{
+			testName: "Failure - Variant of another product",
+			update:   domain.VariantUpdate{Stock: &newStock},
+			setupMock: func(storage *mocks.MockStorageRepository, inventory *mocks.MockInventoryService) {
+				storage.EXPECT().GetProductByID(gomock.Any(), productID).Return(&domain.Product{ID: productID}, nil).Times(1)
+				storage.EXPECT().
+					GetVariantByID(gomock.Any(), variantID).
+					Return(&domain.Variant{ID: variantID, ProductID: uuid.New().String()}, nil).
+					Times(1)
+			},
+			expectedError: domain.ErrVariantNotFound,
+		},
+		{
+			testName: "Failure - Duplicated SKU",
+			update:   domain.VariantUpdate{SKU: &newSKU},
+			setupMock: func(storage *mocks.MockStorageRepository, inventory *mocks.MockInventoryService) {
+				storage.EXPECT().GetVariantByID(gomock.Any(), variantID).Return(&domain.Variant{ID: variantID, ProductID: productID}, nil).Times(1)
+				storage.EXPECT().UpdateVariant(gomock.Any(), gomock.Any()).Return(domain.ErrVariantSKUAlreadyExists).Times(1)
+			},
+			expectedError: domain.ErrVariantSKUAlreadyExists,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockInventory := mocks.NewMockInventoryService(ctrl)
+			mockTransaction.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			tc.setupMock(mockStorage, mockInventory)
+
+			service := product.NewService(mockStorage, mockTransaction, mockInventory)
+
+			// Act
+			err := service.UpdateVariant(context.Background(), productID, variantID, tc.update)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
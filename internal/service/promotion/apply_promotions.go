@@ -0,0 +1,79 @@
+package promotion
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"strings"
+)
+
+// ApplyPromotions computes the discounts of an order line and redeems its coupon. It must run
+// inside the order transaction: the coupon row stays locked until the order commits, and a
+// rollback also undoes the redemption.
+//
+// The automatic promotions are applied first and the coupon last, each one on what is left
+// of the line, so the discounts never exceed the line total.
+func (s *Service) ApplyPromotions(ctx context.Context, line domain.OrderLine) ([]domain.OrderDiscount, error) {
+	now := s.Now()
+
+	promotions, err := s.Storage.GetAutomaticPromotions(ctx, line.ProductID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if line.CouponCode != "" {
+		coupon, err := s.redeemCoupon(ctx, line)
+		if err != nil {
+			return nil, err
+		}
+		promotions = append(promotions, *coupon)
+	}
+
+	remaining := line.UnitPrice.Mul(line.Quantity)
+	discounts := []domain.OrderDiscount{}
+	for _, promotion := range promotions {
+		discount := promotion.Discount(line.UnitPrice, line.Quantity)
+		if discount.Amount > remaining.Amount {
+			discount = remaining
+		}
+		if discount.IsZero() {
+			continue
+		}
+		remaining = remaining.Sub(discount)
+
+		promotionID := promotion.ID
+		discounts = append(discounts, domain.OrderDiscount{
+			PromotionID: &promotionID,
+			Name:        promotion.Name,
+			CouponCode:  promotion.CouponCode,
+			Amount:      discount,
+		})
+	}
+	return discounts, nil
+}
+
+// redeemCoupon locks the coupon, checks it can be used on the line and counts the redemption.
+func (s *Service) redeemCoupon(ctx context.Context, line domain.OrderLine) (*domain.Promotion, error) {
+	coupon, err := s.Storage.GetPromotionByCouponCode(ctx, strings.ToUpper(strings.TrimSpace(line.CouponCode)))
+	if err != nil {
+		return nil, err
+	}
+
+	if !coupon.ActiveAt(s.Now()) {
+		return nil, domain.ErrCouponNotActive
+	}
+	if coupon.UsageLimit != nil && coupon.RedemptionCount >= *coupon.UsageLimit {
+		return nil, domain.ErrCouponUsageLimitReached
+	}
+	if coupon.ProductID != nil && *coupon.ProductID != line.ProductID {
+		return nil, domain.ErrCouponNotApplicable
+	}
+	if coupon.Type == domain.PromotionFixedAmount && coupon.Amount != nil && coupon.Amount.Currency != line.UnitPrice.Currency {
+		return nil, domain.ErrCouponNotApplicable
+	}
+
+	if err := s.Storage.RedeemPromotion(ctx, coupon.ID); err != nil {
+		return nil, err
+	}
+	coupon.RedemptionCount++
+	return coupon, nil
+}
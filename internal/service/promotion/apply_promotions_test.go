@@ -0,0 +1,207 @@
+package promotion_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/promotion"
+	"microservice-products-catalog/internal/service/promotion/mocks"
+	"testing"
+	"time"
+)
+
+func TestApplyPromotions(t *testing.T) {
+	now := time.Date(2026, 7, 15, 12, 0, 0, 0, time.UTC)
+	yesterday := now.Add(-24 * time.Hour)
+	tomorrow := now.Add(24 * time.Hour)
+
+	productID := uuid.New().String()
+	otherProductID := uuid.New().String()
+	code := "SUMMER10"
+	limit := 5
+	fiveDollars := domain.NewMoney(500, domain.BaseCurrency)
+
+	// 3 units at 9.99 USD, a 29.97 USD line
+	line := domain.OrderLine{ProductID: productID, Quantity: 3, UnitPrice: domain.NewMoney(999, domain.BaseCurrency)}
+	couponLine := line
+	couponLine.CouponCode = "summer10"
+
+	percentage := domain.Promotion{ID: uuid.New().String(), Name: "15% off", Type: domain.PromotionPercentage, Percentage: 15, Active: true}
+	buyTwoGetOne := domain.Promotion{ID: uuid.New().String(), Name: "3x2", Type: domain.PromotionBuyXGetY, BuyQuantity: 2, GetQuantity: 1, Active: true}
+	coupon := func() *domain.Promotion {
+		return &domain.Promotion{ID: uuid.New().String(), Name: "Summer coupon", Type: domain.PromotionFixedAmount, Amount: &fiveDollars, CouponCode: &code, UsageLimit: &limit, RedemptionCount: 2, StartsAt: &yesterday, EndsAt: &tomorrow, Active: true}
+	}
+
+	type testCase struct {
+		testName          string
+		line              domain.OrderLine
+		setupMock         func(storage *mocks.MockStorageRepository)
+		expectedDiscounts []domain.Money
+		expectedError     error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - No promotions",
+			line:     line,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetAutomaticPromotions(gomock.Any(), productID, now).Return(nil, nil).Times(1)
+			},
+			expectedDiscounts: []domain.Money{},
+		},
+		{
+			testName: "Success - Percentage rounds half away from zero",
+			line:     line,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetAutomaticPromotions(gomock.Any(), productID, now).Return([]domain.Promotion{percentage}, nil).Times(1)
+			},
+			// 15% of 29.97 = 4.4955
+			expectedDiscounts: []domain.Money{domain.NewMoney(450, domain.BaseCurrency)},
+		},
+		{
+			testName: "Success - Buy two get one and a coupon stack on the remaining total",
+			line:     couponLine,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				redeemed := coupon()
+				storage.EXPECT().GetAutomaticPromotions(gomock.Any(), productID, now).Return([]domain.Promotion{buyTwoGetOne}, nil).Times(1)
+				storage.EXPECT().GetPromotionByCouponCode(gomock.Any(), code).Return(redeemed, nil).Times(1)
+				storage.EXPECT().RedeemPromotion(gomock.Any(), redeemed.ID).Return(nil).Times(1)
+			},
+			expectedDiscounts: []domain.Money{domain.NewMoney(999, domain.BaseCurrency), domain.NewMoney(500, domain.BaseCurrency)},
+		},
+		{
+			testName: "Success - Discounts never exceed the line",
+			line:     domain.OrderLine{ProductID: productID, Quantity: 1, UnitPrice: domain.NewMoney(300, domain.BaseCurrency), CouponCode: code},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				redeemed := coupon()
+				storage.EXPECT().GetAutomaticPromotions(gomock.Any(), productID, now).Return(nil, nil).Times(1)
+				storage.EXPECT().GetPromotionByCouponCode(gomock.Any(), code).Return(redeemed, nil).Times(1)
+				storage.EXPECT().RedeemPromotion(gomock.Any(), redeemed.ID).Return(nil).Times(1)
+			},
+			expectedDiscounts: []domain.Money{domain.NewMoney(300, domain.BaseCurrency)},
+		},
+		{
+			testName: "Failure - Coupon not found",
+			line:     couponLine,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetAutomaticPromotions(gomock.Any(), productID, now).Return(nil, nil).Times(1)
+				storage.EXPECT().GetPromotionByCouponCode(gomock.Any(), code).Return(nil, domain.ErrCouponNotFound).Times(1)
+			},
+			expectedError: domain.ErrCouponNotFound,
+		},
+		{
+			testName: "Failure - Coupon expired",
+			line:     couponLine,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				expired := coupon()
+				expired.EndsAt = &yesterday
+				expired.StartsAt = nil
+				storage.EXPECT().GetAutomaticPromotions(gomock.Any(), productID, now).Return(nil, nil).Times(1)
+				storage.EXPECT().GetPromotionByCouponCode(gomock.Any(), code).Return(expired, nil).Times(1)
+			},
+			expectedError: domain.ErrCouponNotActive,
+		},
+		{
+			testName: "Failure - Coupon usage limit reached",
+			line:     couponLine,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				exhausted := coupon()
+				exhausted.RedemptionCount = limit
+				storage.EXPECT().GetAutomaticPromotions(gomock.Any(), productID, now).Return(nil, nil).Times(1)
+				storage.EXPECT().GetPromotionByCouponCode(gomock.Any(), code).Return(exhausted, nil).Times(1)
+			},
+			expectedError: domain.ErrCouponUsageLimitReached,
+		},
+		{
+			testName: "Failure - Coupon of another product",
+			line:     couponLine,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				scoped := coupon()
+				scoped.ProductID = &otherProductID
+				storage.EXPECT().GetAutomaticPromotions(gomock.Any(), productID, now).Return(nil, nil).Times(1)
+				storage.EXPECT().GetPromotionByCouponCode(gomock.Any(), code).Return(scoped, nil).Times(1)
+			},
+			expectedError: domain.ErrCouponNotApplicable,
+		},
+		{
+			testName: "Failure - Fixed amount coupon in another currency",
+			line:     domain.OrderLine{ProductID: productID, Quantity: 1, UnitPrice: domain.NewMoney(920, "EUR"), CouponCode: code},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetAutomaticPromotions(gomock.Any(), productID, now).Return(nil, nil).Times(1)
+				storage.EXPECT().GetPromotionByCouponCode(gomock.Any(), code).Return(coupon(), nil).Times(1)
+			},
+			expectedError: domain.ErrCouponNotApplicable,
+		},
+		{
+			testName: "Failure - Concurrent redemption reached the limit",
+			line:     couponLine,
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				redeemed := coupon()
+				storage.EXPECT().GetAutomaticPromotions(gomock.Any(), productID, now).Return(nil, nil).Times(1)
+				storage.EXPECT().GetPromotionByCouponCode(gomock.Any(), code).Return(redeemed, nil).Times(1)
+				storage.EXPECT().RedeemPromotion(gomock.Any(), redeemed.ID).Return(domain.ErrCouponUsageLimitReached).Times(1)
+			},
+			expectedError: domain.ErrCouponUsageLimitReached,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			tc.setupMock(mockStorage)
+
+			service := promotion.NewService(mockStorage, mockTransaction)
+			service.Now = func() time.Time { return now }
+
+			// Act
+			discounts, err := service.ApplyPromotions(context.Background(), tc.line)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+
+			amounts := make([]domain.Money, 0, len(discounts))
+			for _, discount := range discounts {
+				amounts = append(amounts, discount.Amount)
+			}
+			assert.Equal(t, tc.expectedDiscounts, amounts)
+		})
+	}
+}
+
+func TestReleasePromotions(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	code := "SUMMER10"
+	couponID := uuid.New().String()
+	automaticID := uuid.New().String()
+	discounts := []domain.OrderDiscount{
+		{PromotionID: &automaticID, Name: "15% off", Amount: domain.NewMoney(449, domain.BaseCurrency)},
+		{PromotionID: &couponID, Name: "Summer coupon", CouponCode: &code, Amount: domain.NewMoney(500, domain.BaseCurrency)},
+	}
+
+	mockStorage := mocks.NewMockStorageRepository(ctrl)
+	mockStorage.EXPECT().ReleasePromotion(gomock.Any(), couponID).Return(nil).Times(1)
+	service := promotion.NewService(mockStorage, mocks.NewMockTransactionManager(ctrl))
+
+	// Act
+	err := service.ReleasePromotions(context.Background(), discounts)
+
+	// Assert
+	assert.NoError(t, err)
+}
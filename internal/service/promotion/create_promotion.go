@@ -0,0 +1,19 @@
+package promotion
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"strings"
+)
+
+// CreatePromotion stores the coupon codes in upper case, the codes are matched case-insensitively.
+func (s *Service) CreatePromotion(ctx context.Context, promotion domain.Promotion) error {
+	if promotion.CouponCode != nil {
+		code := strings.ToUpper(strings.TrimSpace(*promotion.CouponCode))
+		promotion.CouponCode = &code
+	}
+	if err := promotion.Validate(); err != nil {
+		return err
+	}
+	return s.Storage.CreatePromotion(ctx, promotion)
+}
@@ -0,0 +1,87 @@
+package promotion_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/promotion"
+	"microservice-products-catalog/internal/service/promotion/mocks"
+	"testing"
+)
+
+func TestCreatePromotion(t *testing.T) {
+	code := " summer10 "
+	upperCode := "SUMMER10"
+	limit := 100
+
+	type testCase struct {
+		testName      string
+		input         domain.Promotion
+		setupMock     func(storage *mocks.MockStorageRepository, input domain.Promotion)
+		expectedError error
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Coupon code stored in upper case",
+			input:    domain.Promotion{ID: uuid.New().String(), Name: "Summer", Type: domain.PromotionPercentage, Percentage: 10, CouponCode: &code, UsageLimit: &limit, Active: true},
+			setupMock: func(storage *mocks.MockStorageRepository, input domain.Promotion) {
+				storage.EXPECT().
+					CreatePromotion(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p domain.Promotion) error {
+						assert.Equal(t, upperCode, *p.CouponCode)
+						return nil
+					}).Times(1)
+			},
+		},
+		{
+			testName:      "Failure - Percentage out of range",
+			input:         domain.Promotion{ID: uuid.New().String(), Name: "Too much", Type: domain.PromotionPercentage, Percentage: 120},
+			setupMock:     func(storage *mocks.MockStorageRepository, input domain.Promotion) {},
+			expectedError: domain.ErrInvalidPromotion,
+		},
+		{
+			testName:      "Failure - Usage limit without coupon",
+			input:         domain.Promotion{ID: uuid.New().String(), Name: "3x2", Type: domain.PromotionBuyXGetY, BuyQuantity: 2, GetQuantity: 1, UsageLimit: &limit},
+			setupMock:     func(storage *mocks.MockStorageRepository, input domain.Promotion) {},
+			expectedError: domain.ErrInvalidPromotion,
+		},
+		{
+			testName: "Failure - Coupon code already exists",
+			input:    domain.Promotion{ID: uuid.New().String(), Name: "Summer", Type: domain.PromotionPercentage, Percentage: 10, CouponCode: &upperCode},
+			setupMock: func(storage *mocks.MockStorageRepository, input domain.Promotion) {
+				storage.EXPECT().CreatePromotion(gomock.Any(), input).Return(domain.ErrCouponAlreadyExists).Times(1)
+			},
+			expectedError: domain.ErrCouponAlreadyExists,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			tc.setupMock(mockStorage, tc.input)
+
+			service := promotion.NewService(mockStorage, mockTransaction)
+
+			// Act
+			err := service.CreatePromotion(context.Background(), tc.input)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
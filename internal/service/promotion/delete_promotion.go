@@ -0,0 +1,10 @@
+package promotion
+
+import (
+	"context"
+)
+
+// DeletePromotion keeps the discounts already applied to orders, they keep the promotion name.
+func (s *Service) DeletePromotion(ctx context.Context, id string) error {
+	return s.Storage.DeletePromotion(ctx, id)
+}
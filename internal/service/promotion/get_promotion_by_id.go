@@ -0,0 +1,10 @@
+package promotion
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) GetPromotionByID(ctx context.Context, id string) (*domain.Promotion, error) {
+	return s.Storage.GetPromotionByID(ctx, id)
+}
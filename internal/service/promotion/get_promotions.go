@@ -0,0 +1,15 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"microservice-products-catalog/internal/domain"
+)
+
+func (s *Service) GetPromotions(ctx context.Context) ([]domain.Promotion, error) {
+	promotions, err := s.Storage.GetPromotions(ctx)
+	if err != nil {
+		return []domain.Promotion{}, fmt.Errorf("get promotions error: %w", err)
+	}
+	return promotions, nil
+}
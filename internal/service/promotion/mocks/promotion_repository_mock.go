@@ -0,0 +1,204 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "microservice-products-catalog/internal/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStorageRepository is a mock of StorageRepository interface.
+type MockStorageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageRepositoryMockRecorder
+}
+
+// MockStorageRepositoryMockRecorder is the mock recorder for MockStorageRepository.
+type MockStorageRepositoryMockRecorder struct {
+	mock *MockStorageRepository
+}
+
+// NewMockStorageRepository creates a new mock instance.
+func NewMockStorageRepository(ctrl *gomock.Controller) *MockStorageRepository {
+	mock := &MockStorageRepository{ctrl: ctrl}
+	mock.recorder = &MockStorageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageRepository) EXPECT() *MockStorageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePromotion mocks base method.
+func (m *MockStorageRepository) CreatePromotion(ctx context.Context, promotion domain.Promotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePromotion", ctx, promotion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePromotion indicates an expected call of CreatePromotion.
+func (mr *MockStorageRepositoryMockRecorder) CreatePromotion(ctx, promotion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromotion", reflect.TypeOf((*MockStorageRepository)(nil).CreatePromotion), ctx, promotion)
+}
+
+// DeletePromotion mocks base method.
+func (m *MockStorageRepository) DeletePromotion(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePromotion", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePromotion indicates an expected call of DeletePromotion.
+func (mr *MockStorageRepositoryMockRecorder) DeletePromotion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePromotion", reflect.TypeOf((*MockStorageRepository)(nil).DeletePromotion), ctx, id)
+}
+
+// GetAutomaticPromotions mocks base method.
+func (m *MockStorageRepository) GetAutomaticPromotions(ctx context.Context, productID string, at time.Time) ([]domain.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutomaticPromotions", ctx, productID, at)
+	ret0, _ := ret[0].([]domain.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutomaticPromotions indicates an expected call of GetAutomaticPromotions.
+func (mr *MockStorageRepositoryMockRecorder) GetAutomaticPromotions(ctx, productID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutomaticPromotions", reflect.TypeOf((*MockStorageRepository)(nil).GetAutomaticPromotions), ctx, productID, at)
+}
+
+// GetPromotionByCouponCode mocks base method.
+func (m *MockStorageRepository) GetPromotionByCouponCode(ctx context.Context, code string) (*domain.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotionByCouponCode", ctx, code)
+	ret0, _ := ret[0].(*domain.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotionByCouponCode indicates an expected call of GetPromotionByCouponCode.
+func (mr *MockStorageRepositoryMockRecorder) GetPromotionByCouponCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotionByCouponCode", reflect.TypeOf((*MockStorageRepository)(nil).GetPromotionByCouponCode), ctx, code)
+}
+
+// GetPromotionByID mocks base method.
+func (m *MockStorageRepository) GetPromotionByID(ctx context.Context, id string) (*domain.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotionByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotionByID indicates an expected call of GetPromotionByID.
+func (mr *MockStorageRepositoryMockRecorder) GetPromotionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotionByID", reflect.TypeOf((*MockStorageRepository)(nil).GetPromotionByID), ctx, id)
+}
+
+// GetPromotions mocks base method.
+func (m *MockStorageRepository) GetPromotions(ctx context.Context) ([]domain.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromotions", ctx)
+	ret0, _ := ret[0].([]domain.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromotions indicates an expected call of GetPromotions.
+func (mr *MockStorageRepositoryMockRecorder) GetPromotions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromotions", reflect.TypeOf((*MockStorageRepository)(nil).GetPromotions), ctx)
+}
+
+// RedeemPromotion mocks base method.
+func (m *MockStorageRepository) RedeemPromotion(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeemPromotion", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RedeemPromotion indicates an expected call of RedeemPromotion.
+func (mr *MockStorageRepositoryMockRecorder) RedeemPromotion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemPromotion", reflect.TypeOf((*MockStorageRepository)(nil).RedeemPromotion), ctx, id)
+}
+
+// ReleasePromotion mocks base method.
+func (m *MockStorageRepository) ReleasePromotion(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePromotion", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleasePromotion indicates an expected call of ReleasePromotion.
+func (mr *MockStorageRepositoryMockRecorder) ReleasePromotion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePromotion", reflect.TypeOf((*MockStorageRepository)(nil).ReleasePromotion), ctx, id)
+}
+
+// UpdatePromotion mocks base method.
+func (m *MockStorageRepository) UpdatePromotion(ctx context.Context, promotion *domain.Promotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePromotion", ctx, promotion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePromotion indicates an expected call of UpdatePromotion.
+func (mr *MockStorageRepositoryMockRecorder) UpdatePromotion(ctx, promotion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePromotion", reflect.TypeOf((*MockStorageRepository)(nil).UpdatePromotion), ctx, promotion)
+}
+
+// MockTransactionManager is a mock of TransactionManager interface.
+type MockTransactionManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionManagerMockRecorder
+}
+
+// MockTransactionManagerMockRecorder is the mock recorder for MockTransactionManager.
+type MockTransactionManagerMockRecorder struct {
+	mock *MockTransactionManager
+}
+
+// NewMockTransactionManager creates a new mock instance.
+func NewMockTransactionManager(ctrl *gomock.Controller) *MockTransactionManager {
+	mock := &MockTransactionManager{ctrl: ctrl}
+	mock.recorder = &MockTransactionManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionManager) EXPECT() *MockTransactionManagerMockRecorder {
+	return m.recorder
+}
+
+// WithTransaction mocks base method.
+func (m *MockTransactionManager) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTransaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTransaction indicates an expected call of WithTransaction.
+func (mr *MockTransactionManagerMockRecorder) WithTransaction(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTransaction", reflect.TypeOf((*MockTransactionManager)(nil).WithTransaction), ctx, fn)
+}
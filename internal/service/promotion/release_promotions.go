@@ -0,0 +1,20 @@
+package promotion
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// ReleasePromotions gives back the coupon redemptions of the discounts of an order that was
+// compensated, the automatic promotions are not counted so there is nothing to release.
+func (s *Service) ReleasePromotions(ctx context.Context, discounts []domain.OrderDiscount) error {
+	for _, discount := range discounts {
+		if discount.CouponCode == nil || discount.PromotionID == nil {
+			continue
+		}
+		if err := s.Storage.ReleasePromotion(ctx, *discount.PromotionID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package promotion
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+	"time"
+)
+
+//go:generate mockgen -source=service.go -destination=././mocks/promotion_repository_mock.go -package=mocks
+
+type StorageRepository interface {
+	CreatePromotion(ctx context.Context, promotion domain.Promotion) error
+	GetPromotionByID(ctx context.Context, id string) (*domain.Promotion, error)
+	GetPromotions(ctx context.Context) ([]domain.Promotion, error)
+	UpdatePromotion(ctx context.Context, promotion *domain.Promotion) error
+	DeletePromotion(ctx context.Context, id string) error
+	GetPromotionByCouponCode(ctx context.Context, code string) (*domain.Promotion, error)
+	GetAutomaticPromotions(ctx context.Context, productID string, at time.Time) ([]domain.Promotion, error)
+	RedeemPromotion(ctx context.Context, id string) error
+	ReleasePromotion(ctx context.Context, id string) error
+}
+
+type TransactionManager interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Service owns the promotions CRUD and the discounts engine used by the order service.
+// Now is the clock the validity windows are checked against.
+type Service struct {
+	Storage            StorageRepository
+	TransactionManager TransactionManager
+	Now                func() time.Time
+}
+
+func NewService(storage StorageRepository, transactionManager TransactionManager) *Service {
+	return &Service{
+		Storage:            storage,
+		TransactionManager: transactionManager,
+		Now:                time.Now,
+	}
+}
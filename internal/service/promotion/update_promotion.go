@@ -0,0 +1,50 @@
+package promotion
+
+import (
+	"context"
+	"microservice-products-catalog/internal/domain"
+)
+
+// UpdatePromotion changes the terms of a promotion, its type, product and coupon code are
+// fixed once created because orders already reference them.
+func (s *Service) UpdatePromotion(ctx context.Context, id string, update domain.PromotionUpdate) error {
+	return s.TransactionManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		promotion, err := s.Storage.GetPromotionByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		if update.Name != nil {
+			promotion.Name = *update.Name
+		}
+		if update.Percentage != nil {
+			promotion.Percentage = *update.Percentage
+		}
+		if update.Amount != nil {
+			promotion.Amount = update.Amount
+		}
+		if update.BuyQuantity != nil {
+			promotion.BuyQuantity = *update.BuyQuantity
+		}
+		if update.GetQuantity != nil {
+			promotion.GetQuantity = *update.GetQuantity
+		}
+		if update.UsageLimit != nil {
+			promotion.UsageLimit = update.UsageLimit
+		}
+		if update.StartsAt != nil {
+			promotion.StartsAt = update.StartsAt
+		}
+		if update.EndsAt != nil {
+			promotion.EndsAt = update.EndsAt
+		}
+		if update.Active != nil {
+			promotion.Active = *update.Active
+		}
+
+		if err := promotion.Validate(); err != nil {
+			return err
+		}
+		return s.Storage.UpdatePromotion(txCtx, promotion)
+	})
+}
@@ -0,0 +1,108 @@
+package promotion_test
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"microservice-products-catalog/internal/domain"
+	"microservice-products-catalog/internal/service/promotion"
+	"microservice-products-catalog/internal/service/promotion/mocks"
+	"testing"
+	"time"
+)
+
+func TestUpdatePromotion(t *testing.T) {
+	id := uuid.New().String()
+	startsAt := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	endsBefore := startsAt.Add(-time.Hour)
+
+	newPercentage := 25
+	inactive := false
+	invalidPercentage := 0
+
+	type testCase struct {
+		testName      string
+		update        domain.PromotionUpdate
+		setupMock     func(storage *mocks.MockStorageRepository)
+		expectedError error
+	}
+
+	existing := func() *domain.Promotion {
+		return &domain.Promotion{ID: id, Name: "Summer", Type: domain.PromotionPercentage, Percentage: 10, StartsAt: &startsAt, Active: true}
+	}
+
+	testCases := []testCase{
+		{
+			testName: "Success - Update percentage and deactivate",
+			update:   domain.PromotionUpdate{Percentage: &newPercentage, Active: &inactive},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetPromotionByID(gomock.Any(), id).Return(existing(), nil).Times(1)
+				storage.EXPECT().
+					UpdatePromotion(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *domain.Promotion) error {
+						assert.Equal(t, 25, p.Percentage)
+						assert.False(t, p.Active)
+						assert.Equal(t, "Summer", p.Name)
+						return nil
+					}).Times(1)
+			},
+		},
+		{
+			testName: "Failure - Invalid percentage",
+			update:   domain.PromotionUpdate{Percentage: &invalidPercentage},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetPromotionByID(gomock.Any(), id).Return(existing(), nil).Times(1)
+			},
+			expectedError: domain.ErrInvalidPromotion,
+		},
+		{
+			testName: "Failure - Window ends before it starts",
+			update:   domain.PromotionUpdate{EndsAt: &endsBefore},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetPromotionByID(gomock.Any(), id).Return(existing(), nil).Times(1)
+			},
+			expectedError: domain.ErrInvalidPromotion,
+		},
+		{
+			testName: "Failure - Promotion not found",
+			update:   domain.PromotionUpdate{Percentage: &newPercentage},
+			setupMock: func(storage *mocks.MockStorageRepository) {
+				storage.EXPECT().GetPromotionByID(gomock.Any(), id).Return(nil, domain.ErrPromotionNotFound).Times(1)
+			},
+			expectedError: domain.ErrPromotionNotFound,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.testName, func(t *testing.T) {
+			// Arrange
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageRepository(ctrl)
+			mockTransaction := mocks.NewMockTransactionManager(ctrl)
+			mockTransaction.EXPECT().
+				WithTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).Times(1)
+			tc.setupMock(mockStorage)
+
+			service := promotion.NewService(mockStorage, mockTransaction)
+
+			// Act
+			err := service.UpdatePromotion(context.Background(), id, tc.update)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}